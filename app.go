@@ -22,11 +22,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
-	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -68,32 +68,41 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v3/modules/core"
-	ibcconnectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	embank "github.com/e-money/em-ledger/hooks/bank"
 	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/upgrades"
 	"github.com/e-money/em-ledger/x/auth/ante"
 	"github.com/e-money/em-ledger/x/authority"
 	"github.com/e-money/em-ledger/x/buyback"
 	emdistr "github.com/e-money/em-ledger/x/distribution"
 	"github.com/e-money/em-ledger/x/inflation"
 	"github.com/e-money/em-ledger/x/issuer"
+	issueribc "github.com/e-money/em-ledger/x/issuer/ibc"
 	"github.com/e-money/em-ledger/x/liquidityprovider"
 	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
 	"github.com/e-money/em-ledger/x/market"
+	marketstream "github.com/e-money/em-ledger/x/market/stream"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
 	"github.com/e-money/em-ledger/x/queries"
 	queriestypes "github.com/e-money/em-ledger/x/queries/types"
 	emslashing "github.com/e-money/em-ledger/x/slashing"
 	"github.com/e-money/em-ledger/x/staking"
 	historykeeper "github.com/e-money/em-ledger/x/staking/keeper"
 	"github.com/e-money/em-ledger/x/upgrade"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
@@ -101,6 +110,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	db "github.com/tendermint/tm-db"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -129,6 +139,7 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		icaModuleBasic{},
 		vesting.AppModuleBasic{},
 		// em modules
 		inflation.AppModuleBasic{},
@@ -147,11 +158,13 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:            nil,
 		// em modules
 		inflation.ModuleName:         {authtypes.Minter},
 		emslashing.ModuleName:        nil, // TODO Remove this line?
 		liquidityprovider.ModuleName: {authtypes.Minter, authtypes.Burner},
 		buyback.ModuleName:           {authtypes.Burner},
+		market.ModuleName:            nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -165,6 +178,17 @@ var (
 	_ servertypes.Application = (*EMoneyApp)(nil)
 )
 
+// icaModuleBasic starts new chains with the interchain accounts host allowing the default messages.
+type icaModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+func (icaModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	gs := icatypes.DefaultGenesis()
+	gs.HostGenesisState.Params.AllowMessages = upgrades.ICAHostAllowMessages
+	return cdc.MustMarshalJSON(gs)
+}
+
 type EMoneyApp struct {
 	*baseapp.BaseApp
 	legacyAmino       *codec.LegacyAmino
@@ -175,6 +199,9 @@ type EMoneyApp struct {
 	database     db.DB
 	currentBatch db.Batch
 
+	// checkpoints the non-IAVL signed blocks window at state-sync snapshot heights
+	slashingSnapshotter *emslashing.Snapshotter
+
 	invCheckPeriod uint
 
 	// keys to access the substores
@@ -196,12 +223,14 @@ type EMoneyApp struct {
 	ibcKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	evidenceKeeper   evidencekeeper.Keeper
 	transferKeeper   ibctransferkeeper.Keeper
+	icaHostKeeper    icahostkeeper.Keeper
 	feeGrantKeeper   feegrantkeeper.Keeper
 	authzKeeper      authzkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	scopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	scopedTransferKeeper capabilitykeeper.ScopedKeeper
+	scopedICAHostKeeper  capabilitykeeper.ScopedKeeper
 
 	// custom modules
 	inflationKeeper inflation.Keeper
@@ -211,6 +240,9 @@ type EMoneyApp struct {
 	marketKeeper    *market.Keeper
 	buybackKeeper   buyback.Keeper
 
+	// node-local market data streaming, if enabled in app.toml
+	marketStream *marketstream.Service
+
 	// the module manager
 	mm *module.Manager
 
@@ -251,9 +283,10 @@ func NewApp(
 		lptypes.StoreKey, issuer.StoreKey, authority.StoreKey,
 		market.StoreKey, market.StoreKeyIdx, buyback.StoreKey,
 		inflation.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey,
+		icahosttypes.StoreKey,
 	)
 
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, market.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &EMoneyApp{
@@ -278,6 +311,7 @@ func NewApp(
 	app.capabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.capabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAHostKeeper := app.capabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 
 	// add keepers
 	app.accountKeeper = authkeeper.NewAccountKeeper(
@@ -296,7 +330,10 @@ func NewApp(
 
 	app.feeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.accountKeeper)
 
-	app.historykeeper = historykeeper.NewHistoryKeeper(appCodec, keys[historykeeper.StoreKey], stakingKeeper, app.database)
+	app.historykeeper = historykeeper.NewHistoryKeeper(
+		appCodec, keys[historykeeper.StoreKey], stakingKeeper, app.database,
+		cast.ToUint64(appOpts.Get(historykeeper.FlagRetainHeights)),
+	)
 
 	app.distrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.accountKeeper, app.bankKeeper,
@@ -311,97 +348,47 @@ func NewApp(
 	)
 	app.upgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
-	{
-		/*
-		 * This is a test v44 handler
-		 */
-		const upg44Plan = "v44-upg-test-sample"
-
-		app.upgradeKeeper.SetUpgradeHandler(
-			upg44Plan,
-			func(
-				ctx sdk.Context, _ upgradetypes.Plan, _ module.VersionMap,
-			) (module.VersionMap, error) {
-				// set max expected block time parameter. Replace the default with your expected value
-				// https://github.com/cosmos/ibc-go/blob/release/v1.0.x/docs/ibc/proto-docs.md#params-2
-				// set max block time to 30 seconds
-				app.ibcKeeper.ConnectionKeeper.SetParams(
-					// should 3-5 minutes in mainnet
-					ctx, ibcconnectiontypes.DefaultParams(),
-				)
-
-				fromVM := make(map[string]uint64)
-				for moduleName := range app.mm.Modules {
-					// v40 state is version 1
-					// v43 state is version 2
-					// v45 state is likely v3
-					fromVM[moduleName] = 1
-				}
-
-				// EXCEPT Auth needs to run _after_ staking (https://github.com/cosmos/cosmos-sdk/issues/10591),
-				// and it seems bank as well (https://github.com/provenance-io/provenance/blob/407c89a7d73854515894161e1526f9623a94c368/app/upgrades.go#L86-L122).
-				// So we do this by making auth run last.
-				// This is done by setting auth's consensus version to 2, running RunMigrations,
-				// then setting it back to 1, and then running migrations again.
-				fromVM[authtypes.ModuleName] = 2
-
-				// override versions for _new_ modules as to not skip InitGenesis
-				fromVM[authz.ModuleName] = 0
-				fromVM[feegrant.ModuleName] = 0
-
-				ctx.Logger().Info("Upgrading to " + upg44Plan)
-
-				newVM, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
-				if err != nil {
-					return nil, err
-				}
-
-				// now update auth version back to v1, to run auth migration last
-				newVM[authtypes.ModuleName] = 1
-
-				return app.mm.RunMigrations(ctx, app.configurator, newVM)
-			})
-
-		upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
-		if err != nil {
-			panic(err)
-		}
-
-		if upgradeInfo.Name == upg44Plan && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-			storeUpgrades := store.StoreUpgrades{
-				Added: []string{authz.ModuleName, feegrant.ModuleName},
-			}
-
-			// configure store loader that checks if version == upgradeHeight and applies store upgrades
-			app.SetStoreLoader(
-				upgradetypes.UpgradeStoreLoader(
-					upgradeInfo.Height, &storeUpgrades,
-				),
-			)
-		}
-	}
-
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
+	app.inflationKeeper = inflation.NewKeeper(app.appCodec, keys[inflation.StoreKey], app.bankKeeper, app.accountKeeper, app.stakingKeeper, app.distrKeeper, buyback.AccountName, authtypes.FeeCollectorName, []string{buyback.AccountName})
+	app.bankKeeper.SetInterestKeeper(app.inflationKeeper)
+	app.lpKeeper = liquidityprovider.NewKeeper(app.appCodec, keys[lptypes.StoreKey], app.bankKeeper)
+	app.issuerKeeper = issuer.NewKeeper(app.appCodec, keys[issuer.StoreKey], app.GetSubspace(issuer.ModuleName), app.lpKeeper, app.inflationKeeper, app.bankKeeper)
+
 	app.ibcKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName),
 		app.historykeeper, app.upgradeKeeper, scopedIBCKeeper)
 
-	// Create Transfer Keepers
+	// Create Transfer Keepers. Outbound transfers pass the transfer policies of the issuers before they are recorded in
+	// the flow telemetry.
+	transferICS4Wrapper := issueribc.NewICS4Wrapper(
+		issueribc.NewFlowICS4Wrapper(app.ibcKeeper.ChannelKeeper, app.issuerKeeper), app.issuerKeeper,
+	)
 	app.transferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ibcKeeper.ChannelKeeper, app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		transferICS4Wrapper, app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
 		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.transferKeeper)
 
+	app.icaHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		app.accountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(nil, &app.icaHostKeeper)
+
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	var transferStack porttypes.IBCModule = transfer.NewIBCModule(app.transferKeeper)
+	transferStack = issueribc.NewIBCMiddleware(transferStack, app.issuerKeeper)
+	transferStack = issueribc.NewFlowMiddleware(transferStack, app.issuerKeeper)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(app.icaHostKeeper))
 	app.ibcKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -411,11 +398,15 @@ func NewApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.inflationKeeper = inflation.NewKeeper(app.appCodec, keys[inflation.StoreKey], app.bankKeeper, app.accountKeeper, app.stakingKeeper, buyback.AccountName, authtypes.FeeCollectorName)
-	app.lpKeeper = liquidityprovider.NewKeeper(app.appCodec, keys[lptypes.StoreKey], app.bankKeeper)
-	app.issuerKeeper = issuer.NewKeeper(app.appCodec, keys[issuer.StoreKey], app.lpKeeper, app.inflationKeeper, app.bankKeeper)
-	app.authorityKeeper = authority.NewKeeper(app.appCodec, keys[authority.StoreKey], app.issuerKeeper, app.bankKeeper, app, &app.upgradeKeeper, app.paramsKeeper)
-	app.marketKeeper = market.NewKeeper(app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], app.accountKeeper, app.bankKeeper)
+	app.authorityKeeper = authority.NewKeeper(app.appCodec, keys[authority.StoreKey], app.issuerKeeper, app.bankKeeper, app, &app.upgradeKeeper, keys[upgradetypes.StoreKey], app.paramsKeeper)
+	app.marketKeeper = market.NewKeeper(
+		app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], tkeys[market.TStoreKey], app.GetSubspace(market.ModuleName),
+		app.accountKeeper, app.bankKeeper, app.authorityKeeper, app.database, cast.ToUint64(appOpts.Get(market.FlagOrderHistoryRetention)),
+	)
+	if cast.ToBool(appOpts.Get(marketstream.FlagEnable)) {
+		app.marketStream = marketstream.NewService(app.marketKeeper, logger, cast.ToInt(appOpts.Get(marketstream.FlagSubscriberBuffer)))
+		app.SetStreamingService(app.marketStream)
+	}
 	app.buybackKeeper = buyback.NewKeeper(app.appCodec, keys[buyback.StoreKey], app.marketKeeper, app.accountKeeper, app.stakingKeeper, app.bankKeeper)
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -431,7 +422,7 @@ func NewApp(
 		),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		embank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, interfaceRegistry),
@@ -443,14 +434,15 @@ func NewApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
-		emdistr.NewAppModule(distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper), app.distrKeeper, app.accountKeeper, app.bankKeeper, app.database),
+		icaModule,
+		emdistr.NewAppModule(distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper), app.distrKeeper, app.slashingKeeper, app.accountKeeper, app.bankKeeper, app.database),
 		liquidityprovider.NewAppModule(app.lpKeeper),
 		issuer.NewAppModule(app.issuerKeeper),
 		authority.NewAppModule(app.authorityKeeper),
 		market.NewAppModule(app.marketKeeper),
 		buyback.NewAppModule(app.buybackKeeper, app.bankKeeper),
 		inflation.NewAppModule(app.inflationKeeper),
-		queries.NewAppModule(app.accountKeeper, app.bankKeeper, app.slashingKeeper),
+		queries.NewAppModule(app.accountKeeper, app.bankKeeper, app.slashingKeeper, app.stakingKeeper, app.distrKeeper),
 	)
 
 	// NOTE: staking module is required if HistoricalEntries param > 0
@@ -461,6 +453,7 @@ func NewApp(
 		evidencetypes.ModuleName,
 		stakingtypes.ModuleName,
 		ibchost.ModuleName,
+		icatypes.ModuleName,
 
 		authority.ModuleName,
 		market.ModuleName,
@@ -494,6 +487,7 @@ func NewApp(
 		ibchost.ModuleName,
 		vestingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		market.ModuleName,
 		buyback.ModuleName,
 		capabilitytypes.ModuleName,
@@ -520,7 +514,7 @@ func NewApp(
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, emdistr.ModuleName, stakingtypes.ModuleName,
 		emslashing.ModuleName, crisistypes.ModuleName,
-		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
+		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 		inflation.ModuleName, issuer.ModuleName, authority.ModuleName, market.ModuleName, buyback.ModuleName,
 		liquidityprovider.ModuleName, feegrant.ModuleName, authz.ModuleName,
 
@@ -532,16 +526,9 @@ func NewApp(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	// TODO hack to initialize application
-	// from sdk.bank/module.go: AppModule RegisterServices
-	// 	m := keeper.NewMigrator(am.keeper.(keeper.BaseKeeper))
-	sdkBk := app.bankKeeper.GetBankKeeper()
-	bankMigModule := bank.NewAppModule(appCodec, *sdkBk, app.accountKeeper)
-	proxy := app.mm.Modules[banktypes.ModuleName]
-	app.mm.Modules[banktypes.ModuleName] = bankMigModule
 	app.mm.RegisterServices(app.configurator)
 
-	app.mm.Modules[banktypes.ModuleName] = proxy
+	app.registerUpgrades()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -570,6 +557,16 @@ func NewApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// Carry the non-IAVL signed blocks window through state-sync snapshots
+	if manager := app.SnapshotManager(); manager != nil {
+		app.slashingSnapshotter = emslashing.NewSnapshotter(
+			app.slashingKeeper, app.database, cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		)
+		if err := manager.RegisterExtensions(app.slashingSnapshotter); err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(fmt.Sprintf("failed to load latest version: %s", err))
@@ -578,10 +575,45 @@ func NewApp(
 
 	app.scopedIBCKeeper = scopedIBCKeeper
 	app.scopedTransferKeeper = scopedTransferKeeper
+	app.scopedICAHostKeeper = scopedICAHostKeeper
 
 	return app
 }
 
+// registerUpgrades sets the handlers of all declared upgrades and applies the store changes of a pending one.
+func (app *EMoneyApp) registerUpgrades() {
+	keepers := upgrades.Keepers{
+		ModuleManager: app.mm,
+		Configurator:  app.configurator,
+		IBCKeeper:     app.ibcKeeper,
+		ICAHostKeeper: &app.icaHostKeeper,
+	}
+
+	if err := upgrades.Validate(upgrades.Upgrades); err != nil {
+		panic(err)
+	}
+
+	for _, u := range upgrades.Upgrades {
+		name, handler := u.Name, u.CreateHandler(keepers)
+		app.upgradeKeeper.SetUpgradeHandler(
+			name,
+			func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				ctx.Logger().Info("Upgrading to " + name)
+				return handler(ctx, plan, fromVM)
+			})
+	}
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if u, found := upgrades.Get(upgradeInfo.Name); found && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &u.StoreUpgrades))
+	}
+}
+
 func createApplicationDatabase(rootDir string) db.DB {
 	datadirectory := filepath.Join(rootDir, "data")
 	emoneydb, err := sdk.NewLevelDB("emoney", datadirectory)
@@ -625,11 +657,19 @@ func (app *EMoneyApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci
 	proposerAddress := block.GetProposerAddress()
 	app.Logger(ctx).Info(fmt.Sprintf("Endblock: Block %v was proposed by %v", ctx.BlockHeight(), sdk.ValAddress(proposerAddress)))
 
+	ctx = apptypes.WithCurrentBatch(ctx, app.currentBatch)
 	response := app.mm.EndBlock(ctx, req)
 	err := app.currentBatch.Write() // Write non-IAVL state to database
 	if err != nil {                 // todo (reviewer): should we panic or ignore? panics are not handled downstream will cause a crash
 		panic(err)
 	}
+
+	if app.slashingSnapshotter != nil {
+		if err := app.slashingSnapshotter.Checkpoint(ctx.BlockHeight()); err != nil {
+			panic(err)
+		}
+	}
+
 	return response
 }
 
@@ -642,7 +682,17 @@ func (app *EMoneyApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) (r
 
 	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	// Modules restoring non-IAVL state from genesis write it to the application database
+	batch := app.database.NewBatch()
+	defer batch.Close()
+	ctx = apptypes.WithCurrentBatch(ctx, batch)
+
+	res = app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+
+	return res
 }
 
 func (app *EMoneyApp) ModuleAccountAddrs() map[string]bool {
@@ -716,6 +766,30 @@ func (app *EMoneyApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.API
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
+
+	if app.marketStream != nil {
+		app.marketStream.RegisterRoutes(apiSvr.Router, apiConfig.EnableUnsafeCORS)
+	}
+}
+
+// RegisterGRPCServer registers the gRPC query services and the market data stream, which is not a query service.
+func (app *EMoneyApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+
+	if app.marketStream != nil {
+		markettypes.RegisterStreamServer(server, app.marketStream)
+	}
+}
+
+// Commit commits the block and passes the committed state to the market data stream.
+func (app *EMoneyApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+
+	if app.marketStream != nil {
+		app.marketStream.Commit(app.NewUncachedContext(false, tmproto.Header{Height: app.LastBlockHeight()}))
+	}
+
+	return res
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -760,6 +834,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(market.ModuleName)
+	paramsKeeper.Subspace(issuer.ModuleName)
 
 	return paramsKeeper
 }
@@ -18,18 +18,22 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	typescli "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 
 	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/upgrades"
 	"github.com/e-money/em-ledger/x/authority"
 	authtypes "github.com/e-money/em-ledger/x/authority/types"
+	emslashingtypes "github.com/e-money/em-ledger/x/slashing/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -64,6 +68,38 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	)
 }
 
+// Slashing genesis states exported before the downtime penalties were introduced have no downtime penalty parameters.
+func TestImportSlashingGenesisWithoutDowntimePenaltyParams(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+
+	genesisState := ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	authorityState := authtypes.GenesisState{
+		AuthorityKey: mustGetAccAddress("cosmos1lagqmceycrfpkyu7y6ayrk6jyvru5mkrkp8vkn").String(), MinGasPrices: sdk.NewDecCoins(),
+	}
+	genesisState["authority"] = encCfg.Marshaler.MustMarshalJSON(&authorityState)
+	genesisState[sdkslashingtypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(sdkslashingtypes.DefaultGenesisState())
+	require.NotContains(t, string(genesisState[sdkslashingtypes.ModuleName]), "downtime_penalty_params")
+
+	require.NoError(t, ModuleBasics.ValidateGenesis(encCfg.Marshaler, encCfg.TxConfig, genesisState))
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	app := NewApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		map[int64]bool{}, t.TempDir(), 0, encCfg, EmptyAppOptions{},
+	)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		AppStateBytes:   stateBytes,
+		ConsensusParams: &abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 100}},
+	})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Equal(t, emslashingtypes.DefaultDowntimePenaltyParams(), app.slashingKeeper.GetDowntimePenaltyParams(ctx))
+}
+
 func mustGetEmApp(authorityAcc sdk.AccAddress) (
 	encCfg EncodingConfig, memDB *dbm.MemDB, eMoneyApp *EMoneyApp, homeFolder string,
 ) {
@@ -318,6 +354,7 @@ func Test_Upgrade(t *testing.T) {
 		name         string
 		plan         upgradetypes.Plan
 		setupUpgCond func(simApp emAppTests, plan *upgradetypes.Plan)
+		replace      bool
 		expSchedPass bool
 		qrySched     bool
 		qryApply     bool
@@ -373,6 +410,7 @@ func Test_Upgrade(t *testing.T) {
 				)
 				require.NoError(t, err)
 			},
+			replace:      true,
 			expSchedPass: true,
 		},
 		{
@@ -392,6 +430,7 @@ func Test_Upgrade(t *testing.T) {
 				)
 				require.NoError(t, err)
 			},
+			replace:      true,
 			expSchedPass: true,
 		},
 		{
@@ -411,6 +450,7 @@ func Test_Upgrade(t *testing.T) {
 				)
 				require.NoError(t, err)
 			},
+			replace:      true,
 			expSchedPass: true,
 		},
 		{
@@ -474,10 +514,11 @@ func Test_Upgrade(t *testing.T) {
 				tt.setupUpgCond(tt.suite, &tt.plan)
 
 				// schedule upgrade plan
-				var err error
-				_, err = tt.suite.app.authorityKeeper.ScheduleUpgrade(
-					tt.suite.ctx, tt.suite.authority, tt.plan,
-				)
+				schedule := tt.suite.app.authorityKeeper.ScheduleUpgrade
+				if tt.replace {
+					schedule = tt.suite.app.authorityKeeper.ReplaceUpgrade
+				}
+				_, err := schedule(tt.suite.ctx, tt.suite.authority, tt.plan)
 				schedPlan, hasPlan := tt.suite.app.authorityKeeper.GetUpgradePlan(tt.suite.ctx)
 
 				// validate plan side effect
@@ -861,3 +902,106 @@ func TestUpdatingBlockParams(t *testing.T) {
 	consensusParams = et.app.BaseApp.GetConsensusParams(et.ctx)
 	require.Equal(t, consensusParams.Block.String(), blockParams.String())
 }
+
+func TestUpdatingICAHostAllowlist(t *testing.T) {
+	configOnce.Do(apptypes.ConfigureSDK)
+
+	et := emAppTests{}.initEmApp(t)
+	header := tmproto.Header{Height: et.app.LastBlockHeight() + 1}
+	et.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	et.ctx = et.app.BaseApp.NewContext(false, header)
+
+	params := et.app.icaHostKeeper.GetParams(et.ctx)
+	require.True(t, params.HostEnabled)
+	require.Equal(t, upgrades.ICAHostAllowMessages, params.AllowMessages)
+	require.Contains(t, params.AllowMessages, "/cosmos.bank.v1beta1.MsgSend")
+	require.Contains(t, params.AllowMessages, "/em.market.v1.MsgAddLimitOrder")
+	require.Contains(t, params.AllowMessages, "/em.liquidityprovider.v1.MsgMintTokens")
+
+	paramChanges := []proposal.ParamChange{
+		{
+			Subspace: icahosttypes.SubModuleName,
+			Key:      string(icahosttypes.KeyAllowMessages),
+			Value:    `["/cosmos.bank.v1beta1.MsgSend"]`,
+		},
+	}
+	_, err := et.app.authorityKeeper.SetParams(et.ctx, sdk.AccAddress("anybody"), paramChanges)
+	require.Error(t, err)
+
+	_, err = et.app.authorityKeeper.SetParams(et.ctx, et.authority, paramChanges)
+	require.NoError(t, err)
+
+	params = et.app.icaHostKeeper.GetParams(et.ctx)
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, params.AllowMessages)
+}
+
+func TestUpgradesIdempotent(t *testing.T) {
+	configOnce.Do(apptypes.ConfigureSDK)
+
+	_, _, app, _ := mustGetEmApp(mustGetAccAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0"))
+	app.Commit()
+
+	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	checkUpgradesIdempotent(t, exported.AppState)
+}
+
+// checkUpgradesIdempotent starts a chain from the exported genesis and runs every registered upgrade twice, requiring
+// the second run to leave the state and module versions unchanged. Unversioned upgrades are skipped.
+func checkUpgradesIdempotent(t *testing.T, appState json.RawMessage) {
+	t.Helper()
+
+	encCfg := MakeEncodingConfig()
+	app := NewApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		map[int64]bool{}, t.TempDir(), 0, encCfg, EmptyAppOptions{},
+	)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		AppStateBytes:   appState,
+		ConsensusParams: &abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 100}},
+	})
+	app.Commit()
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	keepers := upgrades.Keepers{
+		ModuleManager: app.mm,
+		Configurator:  app.configurator,
+		IBCKeeper:     app.ibcKeeper,
+		ICAHostKeeper: &app.icaHostKeeper,
+	}
+
+	copyVM := func(vm module.VersionMap) module.VersionMap {
+		res := make(module.VersionMap)
+		for name, version := range vm {
+			res[name] = version
+		}
+		return res
+	}
+
+	for _, u := range upgrades.Upgrades {
+		t.Run(u.Name, func(t *testing.T) {
+			if u.Unversioned {
+				t.Skip("unversioned upgrades reset the module versions and are not run again")
+			}
+
+			ctx, _ := app.BaseApp.NewContext(false, header).CacheContext()
+
+			handler := u.CreateHandler(keepers)
+			plan := upgradetypes.Plan{Name: u.Name, Height: header.Height}
+
+			fromVM := app.upgradeKeeper.GetModuleVersionMap(ctx)
+			migratedVM, err := handler(ctx, plan, copyVM(fromVM))
+			require.NoError(t, err)
+			migrated := app.mm.ExportGenesis(ctx, encCfg.Marshaler)
+
+			againVM, err := handler(ctx, plan, copyVM(migratedVM))
+			require.NoError(t, err)
+			require.Equal(t, migratedVM, againVM)
+			require.Equal(t, migrated, app.mm.ExportGenesis(ctx, encCfg.Marshaler))
+		})
+	}
+}
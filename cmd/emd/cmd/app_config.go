@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	marketstream "github.com/e-money/em-ledger/x/market/stream"
+)
+
+// HistoryConfig defines the node-local retention of historical staking info in the application database.
+type HistoryConfig struct {
+	RetainHeights uint64 `mapstructure:"retain-heights"`
+}
+
+// MarketStreamConfig defines the node-local market data streaming service.
+type MarketStreamConfig struct {
+	Enable           bool `mapstructure:"enable"`
+	SubscriberBuffer int  `mapstructure:"subscriber-buffer"`
+}
+
+// MarketConfig defines the node-local settings of the market module.
+type MarketConfig struct {
+	OrderHistoryRetention uint64 `mapstructure:"order-history-retention"`
+}
+
+type AppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	History      HistoryConfig      `mapstructure:"history"`
+	Market       MarketConfig       `mapstructure:"market"`
+	MarketStream MarketStreamConfig `mapstructure:"market-stream"`
+}
+
+const historyConfigTemplate = `
+###############################################################################
+###                        Historical Info Configuration                    ###
+###############################################################################
+
+[history]
+
+# Number of recent heights of historical staking info retained in the application database.
+# IBC relies on the heights given by the historical_entries staking parameter, which are always
+# retained. Larger values keep more heights for the historical info query only.
+retain-heights = {{ .History.RetainHeights }}
+`
+
+const marketConfigTemplate = `
+###############################################################################
+###                          Market Configuration                           ###
+###############################################################################
+
+[market]
+
+# Number of orders that left the order book retained per account in the order history of this node,
+# as returned by the order and order history queries. Zero disables the order history.
+order-history-retention = {{ .Market.OrderHistoryRetention }}
+`
+
+const marketStreamConfigTemplate = `
+###############################################################################
+###                     Market Data Streaming Configuration                 ###
+###############################################################################
+
+[market-stream]
+
+# Enable maintains the order books of the market in memory and streams their updates
+# over gRPC (em.market.v1.Stream) and websocket (/e-money/market/v1/stream on the API server).
+enable = {{ .MarketStream.Enable }}
+
+# Number of updates buffered for each subscriber. Subscribers that fall further behind are
+# disconnected and have to resubscribe.
+subscriber-buffer = {{ .MarketStream.SubscriberBuffer }}
+`
+
+// initAppConfig extends the default app.toml with the settings specific to emd.
+func initAppConfig() (string, interface{}) {
+	appConfig := AppConfig{
+		Config:  *serverconfig.DefaultConfig(),
+		History: HistoryConfig{RetainHeights: 0},
+		Market:  MarketConfig{OrderHistoryRetention: 0},
+		MarketStream: MarketStreamConfig{
+			Enable:           false,
+			SubscriberBuffer: marketstream.DefaultSubscriberBuffer,
+		},
+	}
+
+	return serverconfig.DefaultConfigTemplate + historyConfigTemplate + marketConfigTemplate + marketStreamConfigTemplate, appConfig
+}
@@ -0,0 +1,439 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	authoritytypes "github.com/e-money/em-ledger/x/authority/types"
+	"github.com/e-money/em-ledger/x/buyback"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
+	issuertypes "github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+const (
+	flagDisplay     = "display"
+	flagExponent    = "exponent"
+	flagName        = "name"
+	flagSymbol      = "symbol"
+	flagDescription = "description"
+)
+
+// GenesisCmd returns the genesis command, which edits the genesis.json of a network that has not been started.
+func GenesisCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Edit the genesis file of a new network",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		genesisSetAuthorityCmd(mbm),
+		genesisAddIssuerCmd(mbm),
+		genesisAddLiquidityProviderCmd(mbm),
+		genesisSetInflationCmd(mbm),
+		genesisSetGasPricesCmd(mbm),
+		genesisSetBuybackIntervalCmd(mbm),
+	)
+
+	for _, c := range cmd.Commands() {
+		c.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+		c.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	}
+
+	return cmd
+}
+
+func genesisSetAuthorityCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-authority [address_or_key_name]",
+		Short:   "Set the authority of the chain",
+		Example: "emd genesis set-authority emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authority, err := getAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			return updateGenesis(cmd, mbm, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				var genesis authoritytypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, authoritytypes.ModuleName, &genesis); err != nil {
+					return err
+				}
+
+				genesis.AuthorityKey = authority.String()
+				return marshalModuleState(cdc, appState, authoritytypes.ModuleName, &genesis)
+			})
+		},
+	}
+}
+
+func genesisAddIssuerCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-issuer [address_or_key_name] [denom]",
+		Short: "Add an issuer of a denomination with its metadata",
+		Long: `Add an issuer of a denomination with its metadata. Run the command again to let an issuer control
+several denominations. The denomination is also added to the inflation module without inflation.`,
+		Example: `emd genesis add-issuer emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0 eeur --display eur --name "e-Money EUR" --symbol EEUR --description "e-Money EUR stablecoin"`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issuer, err := getAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			denom := args[1]
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+
+			metadata, err := denomMetadataFromFlags(cmd, denom)
+			if err != nil {
+				return err
+			}
+
+			return updateGenesis(cmd, mbm, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				var issuerGenesis issuertypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, issuertypes.ModuleName, &issuerGenesis); err != nil {
+					return err
+				}
+
+				found := false
+				for i, iss := range issuerGenesis.Issuers {
+					for _, d := range iss.Denoms {
+						if d == denom {
+							return fmt.Errorf("denomination %v is already issued by %v", denom, iss.Address)
+						}
+					}
+
+					if iss.Address == issuer.String() {
+						issuerGenesis.Issuers[i].Denoms = append(iss.Denoms, denom)
+						found = true
+					}
+				}
+
+				if !found {
+					issuerGenesis.Issuers = append(issuerGenesis.Issuers, issuertypes.NewIssuer(issuer, denom))
+				}
+
+				if err := marshalModuleState(cdc, appState, issuertypes.ModuleName, &issuerGenesis); err != nil {
+					return err
+				}
+
+				var bankGenesis banktypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, banktypes.ModuleName, &bankGenesis); err != nil {
+					return err
+				}
+
+				for _, m := range bankGenesis.DenomMetadata {
+					if m.Base == denom {
+						return fmt.Errorf("denomination %v already has metadata", denom)
+					}
+				}
+				bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, metadata)
+
+				if err := marshalModuleState(cdc, appState, banktypes.ModuleName, &bankGenesis); err != nil {
+					return err
+				}
+
+				var inflationGenesis inflationtypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, inflationtypes.ModuleName, &inflationGenesis); err != nil {
+					return err
+				}
+
+				if inflationGenesis.InflationState.FindByDenom(denom) == nil {
+					inflationGenesis.InflationState.InflationAssets = append(
+						inflationGenesis.InflationState.InflationAssets,
+						inflationtypes.InflationAsset{Denom: denom, Inflation: sdk.ZeroDec(), Accum: sdk.ZeroDec()},
+					)
+				}
+
+				return marshalModuleState(cdc, appState, inflationtypes.ModuleName, &inflationGenesis)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagDisplay, "", "denomination shown to users, defaults to the base denomination")
+	cmd.Flags().Uint32(flagExponent, 6, "decimal places of the display denomination")
+	cmd.Flags().String(flagName, "", "name of the token, defaults to the display denomination")
+	cmd.Flags().String(flagSymbol, "", "ticker symbol of the token, defaults to the upper case display denomination")
+	cmd.Flags().String(flagDescription, "", "description of the token")
+
+	return cmd
+}
+
+func denomMetadataFromFlags(cmd *cobra.Command, denom string) (banktypes.Metadata, error) {
+	display, _ := cmd.Flags().GetString(flagDisplay)
+	exponent, _ := cmd.Flags().GetUint32(flagExponent)
+	name, _ := cmd.Flags().GetString(flagName)
+	symbol, _ := cmd.Flags().GetString(flagSymbol)
+	description, _ := cmd.Flags().GetString(flagDescription)
+
+	if display == "" {
+		display = denom
+	}
+	if name == "" {
+		name = display
+	}
+	if symbol == "" {
+		symbol = strings.ToUpper(display)
+	}
+
+	units := []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}}
+	if display != denom {
+		units = append(units, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+	}
+
+	metadata := banktypes.Metadata{
+		Description: description,
+		DenomUnits:  units,
+		Base:        denom,
+		Display:     display,
+		Name:        name,
+		Symbol:      symbol,
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, fmt.Errorf("invalid metadata of %v: %w", denom, err)
+	}
+
+	return metadata, nil
+}
+
+func genesisAddLiquidityProviderCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "add-liquidity-provider [address_or_key_name] [mintable]",
+		Short:   "Add a liquidity provider that may mint the given amounts",
+		Example: "emd genesis add-liquidity-provider emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0 1000000000000eeur,1000000000000echf",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider, err := getAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			mintable, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse mintable amount: %w", err)
+			}
+
+			return updateGenesis(cmd, mbm, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				var genesis lptypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, lptypes.ModuleName, &genesis); err != nil {
+					return err
+				}
+
+				for _, acc := range genesis.Accounts {
+					if acc.Address == provider.String() {
+						return fmt.Errorf("%v is already a liquidity provider", provider)
+					}
+				}
+
+				genesis.Accounts = append(genesis.Accounts, lptypes.GenesisAcc{
+					Address:  provider.String(),
+					Mintable: mintable,
+				})
+
+				return marshalModuleState(cdc, appState, lptypes.ModuleName, &genesis)
+			})
+		},
+	}
+}
+
+func genesisSetInflationCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-inflation [denom] [rate]",
+		Short:   "Set the annual inflation rate of a denomination",
+		Example: "emd genesis set-inflation eeur 0.01",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse inflation rate: %w", err)
+			}
+
+			return updateGenesis(cmd, mbm, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				var genesis inflationtypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, inflationtypes.ModuleName, &genesis); err != nil {
+					return err
+				}
+
+				if asset := genesis.InflationState.FindByDenom(denom); asset != nil {
+					asset.Inflation = rate
+				} else {
+					genesis.InflationState.InflationAssets = append(
+						genesis.InflationState.InflationAssets,
+						inflationtypes.InflationAsset{Denom: denom, Inflation: rate, Accum: sdk.ZeroDec()},
+					)
+				}
+
+				return marshalModuleState(cdc, appState, inflationtypes.ModuleName, &genesis)
+			})
+		},
+	}
+}
+
+func genesisSetGasPricesCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-gas-prices [minimum_gas_prices]",
+		Short:   "Set the minimum gas prices of the chain",
+		Long:    "Set the minimum gas prices of the chain. Every denomination must be held by a genesis account.",
+		Example: "emd genesis set-gas-prices 0.0005eeur,0.0000001ejpy",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gasPrices, err := sdk.ParseDecCoins(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse gas prices: %w", err)
+			}
+
+			return updateGenesis(cmd, mbm, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				var bankGenesis banktypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, banktypes.ModuleName, &bankGenesis); err != nil {
+					return err
+				}
+
+				// the chain ignores gas prices in denominations without supply
+				supply := sdk.NewCoins()
+				for _, balance := range bankGenesis.Balances {
+					supply = supply.Add(balance.Coins...)
+				}
+				for _, gp := range gasPrices {
+					if supply.AmountOf(gp.Denom).IsZero() {
+						return fmt.Errorf("no genesis account holds %v", gp.Denom)
+					}
+				}
+
+				var genesis authoritytypes.GenesisState
+				if err := unmarshalModuleState(cdc, appState, authoritytypes.ModuleName, &genesis); err != nil {
+					return err
+				}
+
+				genesis.MinGasPrices = gasPrices
+				return marshalModuleState(cdc, appState, authoritytypes.ModuleName, &genesis)
+			})
+		},
+	}
+}
+
+func genesisSetBuybackIntervalCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "set-buyback-interval [interval]",
+		Short:   "Set how often the buyback module buys and burns staking tokens",
+		Example: "emd genesis set-buyback-interval 24h",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			interval, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse interval: %w", err)
+			}
+
+			return updateGenesis(cmd, mbm, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				genesis := buyback.NewGenesisState(interval)
+				return marshalModuleState(cdc, appState, buyback.ModuleName, genesis)
+			})
+		},
+	}
+}
+
+// updateGenesis applies the change to the genesis file and saves it, if the genesis state of every module is valid
+// afterwards.
+func updateGenesis(
+	cmd *cobra.Command, mbm module.BasicManager, change func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error,
+) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	cdc := clientCtx.JSONCodec
+
+	config := server.GetServerContextFromCmd(cmd).Config
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := change(cdc, appState); err != nil {
+		return err
+	}
+
+	if err := mbm.ValidateGenesis(cdc, clientCtx.TxConfig, appState); err != nil {
+		return fmt.Errorf("invalid genesis state: %w", err)
+	}
+
+	genDoc.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+func unmarshalModuleState(cdc codec.JSONCodec, appState map[string]json.RawMessage, module string, state codec.ProtoMarshaler) error {
+	bz, found := appState[module]
+	if !found {
+		return fmt.Errorf("genesis has no %v state", module)
+	}
+
+	if err := cdc.UnmarshalJSON(bz, state); err != nil {
+		return fmt.Errorf("failed to unmarshal %v genesis state: %w", module, err)
+	}
+
+	return nil
+}
+
+func marshalModuleState(cdc codec.JSONCodec, appState map[string]json.RawMessage, module string, state codec.ProtoMarshaler) error {
+	bz, err := cdc.MarshalJSON(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %v genesis state: %w", module, err)
+	}
+
+	appState[module] = bz
+	return nil
+}
+
+// getAddress parses the bech32 address or looks up the address of the key in the local keyring.
+func getAddress(cmd *cobra.Command, addressOrKey string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(addressOrKey)
+	if err == nil {
+		return addr, nil
+	}
+
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, inBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := kb.Key(addressOrKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from Keybase: %w", err)
+	}
+
+	return info.GetAddress(), nil
+}
@@ -0,0 +1,127 @@
+package cmd_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	emoney "github.com/e-money/em-ledger"
+	emcmd "github.com/e-money/em-ledger/cmd/emd/cmd"
+	authoritytypes "github.com/e-money/em-ledger/x/authority/types"
+	"github.com/e-money/em-ledger/x/buyback"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
+	issuertypes "github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+func TestGenesisCmd(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	encodingConfig := emoney.MakeEncodingConfig()
+	appCodec := encodingConfig.Marshaler
+	require.NoError(t, genutiltest.ExecInitCmd(emoney.ModuleBasics, home, appCodec))
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.
+		WithJSONCodec(appCodec).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	execute := func(args ...string) error {
+		cmd := emcmd.GenesisCmd(emoney.ModuleBasics, home)
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		return cmd.ExecuteContext(ctx)
+	}
+
+	specs := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"set authority", []string{"set-authority", addr1.String()}, false},
+		{"invalid authority", []string{"set-authority", "unknown"}, true},
+		{"add issuer", []string{"add-issuer", addr2.String(), "eeur", "--display=eur", "--name=e-Money EUR"}, false},
+		{"add denom to issuer", []string{"add-issuer", addr2.String(), "echf"}, false},
+		{"denom already issued", []string{"add-issuer", addr1.String(), "eeur"}, true},
+		{"invalid denom", []string{"add-issuer", addr2.String(), "1eur"}, true},
+		{"add liquidity provider", []string{"add-liquidity-provider", addr1.String(), "1000eeur,500echf"}, false},
+		{"duplicate liquidity provider", []string{"add-liquidity-provider", addr1.String(), "1000eeur"}, true},
+		{"set inflation", []string{"set-inflation", "eeur", "0.01"}, false},
+		{"new inflation asset", []string{"set-inflation", "ejpy", "0.02"}, false},
+		{"invalid inflation", []string{"set-inflation", "eeur", "x"}, true},
+		{"gas prices without supply", []string{"set-gas-prices", "0.0005eeur"}, true},
+		{"set buyback interval", []string{"set-buyback-interval", "2h"}, false},
+		{"negative buyback interval", []string{"set-buyback-interval", "-2h"}, true},
+	}
+
+	for _, spec := range specs {
+		err := execute(spec.args...)
+		if spec.expectErr {
+			require.Error(t, err, spec.name)
+		} else {
+			require.NoError(t, err, spec.name)
+		}
+	}
+
+	cmd := emcmd.AddGenesisAccountCmd(home)
+	cmd.SetArgs([]string{addr1.String(), "1000eeur", fmt.Sprintf("--%s=%s", flags.FlagHome, home)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.NoError(t, execute("set-gas-prices", "0.0005eeur"))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+	require.NoError(t, err)
+
+	var authorityGenesis authoritytypes.GenesisState
+	appCodec.MustUnmarshalJSON(appState[authoritytypes.ModuleName], &authorityGenesis)
+	require.Equal(t, addr1.String(), authorityGenesis.AuthorityKey)
+	require.Equal(t, "0.000500000000000000eeur", authorityGenesis.MinGasPrices.String())
+
+	var issuerGenesis issuertypes.GenesisState
+	appCodec.MustUnmarshalJSON(appState[issuertypes.ModuleName], &issuerGenesis)
+	require.Len(t, issuerGenesis.Issuers, 1)
+	require.Equal(t, []string{"eeur", "echf"}, issuerGenesis.Issuers[0].Denoms)
+
+	var bankGenesis banktypes.GenesisState
+	appCodec.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis)
+	require.Len(t, bankGenesis.DenomMetadata, 2)
+	require.Equal(t, "e-Money EUR", bankGenesis.DenomMetadata[0].Name)
+	require.Equal(t, "EUR", bankGenesis.DenomMetadata[0].Symbol)
+	require.Equal(t, "eur", bankGenesis.DenomMetadata[0].Display)
+
+	var lpGenesis lptypes.GenesisState
+	appCodec.MustUnmarshalJSON(appState[lptypes.ModuleName], &lpGenesis)
+	require.Len(t, lpGenesis.Accounts, 1)
+	require.Equal(t, "500echf,1000eeur", lpGenesis.Accounts[0].Mintable.String())
+
+	var inflationGenesis inflationtypes.GenesisState
+	appCodec.MustUnmarshalJSON(appState[inflationtypes.ModuleName], &inflationGenesis)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), inflationGenesis.InflationState.FindByDenom("eeur").Inflation)
+	require.True(t, inflationGenesis.InflationState.FindByDenom("echf").Inflation.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), inflationGenesis.InflationState.FindByDenom("ejpy").Inflation)
+
+	var buybackGenesis buyback.GenesisState
+	appCodec.MustUnmarshalJSON(appState[buyback.ModuleName], &buybackGenesis)
+	require.Equal(t, (2 * time.Hour).String(), buybackGenesis.Interval)
+}
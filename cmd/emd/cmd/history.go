@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	historykeeper "github.com/e-money/em-ledger/x/staking/keeper"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	flagKeepRecent = "keep-recent"
+	flagForce      = "force"
+)
+
+// historyCmd provides offline maintenance of the historical staking info kept in the application database.
+func historyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Maintain the historical info in the application database of a stopped node",
+	}
+
+	cmd.AddCommand(
+		historyPruneCmd(),
+		historyCompactCmd(),
+	)
+
+	return cmd
+}
+
+func historyPruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete all but the most recent heights of historical info",
+		Long: `Delete all but the most recent heights of historical info from the application database.
+The number of heights defaults to the history.retain-heights setting of app.toml.
+IBC relies on the heights given by the historical_entries staking parameter, so at least
+that many heights must be kept unless --force is given. The node must be stopped while pruning.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			keepRecent := serverCtx.Viper.GetInt64(historykeeper.FlagRetainHeights)
+			if cmd.Flags().Changed(flagKeepRecent) {
+				keepRecent, _ = cmd.Flags().GetInt64(flagKeepRecent)
+			}
+			if keepRecent < 1 {
+				return fmt.Errorf("number of heights to keep must be given with --%v or set in app.toml", flagKeepRecent)
+			}
+
+			var historicalEntries uint32
+			if force, _ := cmd.Flags().GetBool(flagForce); !force {
+				stateDB, err := openStateDatabase(serverCtx.Config.RootDir)
+				if err != nil {
+					return err
+				}
+
+				historicalEntries, err = historykeeper.HistoricalEntries(stateDB)
+				stateDB.Close()
+				if err != nil {
+					return err
+				}
+			}
+
+			database, err := openApplicationDatabase(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			pruned, err := historykeeper.PruneHistoricalInfo(database, keepRecent, historicalEntries)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Pruned historical info of %d heights\n", pruned)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagKeepRecent, 0, "Number of most recent heights to keep")
+	cmd.Flags().Bool(flagForce, false, "Keep fewer heights than the historical_entries staking parameter. The node may then process IBC messages differently from its peers")
+	return cmd
+}
+
+func historyCompactCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the application database to reclaim the disk space of deleted entries",
+		Long: `Compact the application database to reclaim the disk space of deleted entries.
+The node must be stopped while compacting.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			database, err := openApplicationDatabase(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			levelDB, ok := database.(*dbm.GoLevelDB)
+			if !ok {
+				return fmt.Errorf("compaction is not supported by database %T", database)
+			}
+
+			return levelDB.ForceCompact(nil, nil)
+		},
+	}
+}
+
+func openApplicationDatabase(rootDir string) (dbm.DB, error) {
+	return sdk.NewLevelDB("emoney", filepath.Join(rootDir, "data"))
+}
+
+// openStateDatabase opens the database holding the state committed by the multistore.
+func openStateDatabase(rootDir string) (dbm.DB, error) {
+	return sdk.NewLevelDB("application", filepath.Join(rootDir, "data"))
+}
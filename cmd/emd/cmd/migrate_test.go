@@ -0,0 +1,96 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	emoney "github.com/e-money/em-ledger"
+	migratecli "github.com/e-money/em-ledger/x/genutil/client/cli"
+	v09 "github.com/e-money/em-ledger/x/genutil/legacy/v090"
+	v100 "github.com/e-money/em-ledger/x/genutil/legacy/v100"
+	slashingtypes "github.com/e-money/em-ledger/x/slashing/types"
+)
+
+// v09GenesisState returns an app state in the format of the v0.9.x series, using the current default state for the
+// modules that are not migrated.
+func v09GenesisState(t *testing.T) genutiltypes.AppMap {
+	_, _, authorityKey := testdata.KeyTestPubAddr()
+
+	encodingConfig := emoney.MakeEncodingConfig()
+	appState := emoney.ModuleBasics.DefaultGenesis(encodingConfig.Marshaler)
+
+	legacyStates := map[string]string{
+		"auth": `{"params":{"max_memo_characters":"256","tx_sig_limit":"7","tx_size_cost_per_byte":"10",
+			"sig_verify_cost_ed25519":"590","sig_verify_cost_secp256k1":"1000"},"accounts":[]}`,
+		"bank":   `{"send_enabled":true}`,
+		"supply": `{"supply":[]}`,
+		"authority": fmt.Sprintf(`{"key":"%s","restricted_denoms":[],"min_gas_prices":[]}`,
+			authorityKey.String()),
+		"distribution": `{"params":{"community_tax":"0.000000000000000000",
+			"base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000",
+			"withdraw_addr_enabled":true},"fee_pool":{"community_pool":[]}}`,
+		"evidence": `{"params":{"max_evidence_age":"120000000000"},"evidence":[]}`,
+		"staking": `{"params":{"unbonding_time":"1814400000000000","max_validators":100,"max_entries":7,
+			"historical_entries":0,"bond_denom":"ungm"},"last_total_power":"0","exported":false}`,
+		"slashing": `{"params":{"max_evidence_age":"120000000000","signed_blocks_window_duration":"3600000000000",
+			"min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600000000000",
+			"slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"},
+			"signing_infos":{},"missed_blocks":{}}`,
+		"genutil": `{"gentxs":[]}`,
+		"buyback": `{"interval":"1h0m0s"}`,
+	}
+	for name, state := range legacyStates {
+		var bz bytes.Buffer
+		require.NoError(t, json.Compact(&bz, []byte(state)), name)
+		appState[name] = bz.Bytes()
+	}
+
+	return appState
+}
+
+func TestMigrateV09Genesis(t *testing.T) {
+	encodingConfig := emoney.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithJSONCodec(encodingConfig.Marshaler).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	appState := v09GenesisState(t)
+
+	bz, err := json.Marshal(appState)
+	require.NoError(t, err)
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:  "test-chain",
+		AppState: bz,
+	}
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genDoc.SaveAs(genesisFile))
+
+	cmd := migratecli.MigrateGenesisCmd(emoney.ModuleBasics)
+	cmd.SetArgs([]string{"v1.x", genesisFile, "--dry-run", "--no-consensus-upgrade"})
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "migration v0.9 -> v1.0\nmigration v1.0 -> v1.1\n")
+
+	// The slashing state gains the downtime penalty parameters introduced after v1.0
+	migrated, err := v09.Migrate(v09GenesisState(t), clientCtx)
+	require.NoError(t, err)
+	migrated, err = v100.Migrate(migrated, clientCtx)
+	require.NoError(t, err)
+
+	var slashingGenState slashingtypes.GenesisState
+	require.NoError(t, encodingConfig.Marshaler.UnmarshalJSON(migrated["slashing"], &slashingGenState))
+	require.Equal(t, slashingtypes.DefaultDowntimePenaltyParams(), slashingGenState.DowntimePenaltyParams)
+}
@@ -70,7 +70,8 @@ func NewRootCmd() (*cobra.Command, emoney.EncodingConfig) {
 				return err
 			}
 
-			if err := server.InterceptConfigsPreRunHandler(cmd, "", nil); err != nil {
+			customAppTemplate, customAppConfig := initAppConfig()
+			if err := server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig); err != nil {
 				return err
 			}
 
@@ -101,13 +102,15 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig emoney.EncodingConfig) {
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(emoney.ModuleBasics, emoney.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, emoney.DefaultNodeHome),
-		migratecli.MigrateGenesisCmd(),
+		migratecli.MigrateGenesisCmd(emoney.ModuleBasics),
 		genutilcli.GenTxCmd(emoney.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, emoney.DefaultNodeHome),
 		AddGenesisAccountCmd(emoney.DefaultNodeHome),
+		GenesisCmd(emoney.ModuleBasics, emoney.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(emoney.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 		config.Cmd(),
+		historyCmd(),
 	)
 
 	a := appCreator{encCfg: encodingConfig}
@@ -0,0 +1,171 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
+)
+
+// InterestKeeper stores the interest indexes of the denominations whose inflation accrues to their holders.
+type InterestKeeper interface {
+	GetInterestIndex(ctx sdk.Context, denom string) (inflationtypes.InterestIndex, bool)
+	GetInterestIndexes(ctx sdk.Context) []inflationtypes.InterestIndex
+	SetInterestIndex(ctx sdk.Context, index inflationtypes.InterestIndex)
+	GetAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Dec
+	SetAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress, index sdk.Dec)
+	DeleteAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress)
+	HasAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	IsIndexingHolders(ctx sdk.Context, denom string) bool
+}
+
+// SetInterestKeeper enables interest-bearing balances. Balances include the interest that has accrued since they were
+// last settled, and are settled before they change.
+func (pk *ProxyKeeper) SetInterestKeeper(ik InterestKeeper) {
+	pk.ik = ik
+}
+
+// earnsInterest reports whether an account holds interest-bearing balances. Module accounts do not earn interest, so
+// that their balances continue to match the records of their modules.
+func (pk ProxyKeeper) earnsInterest(addr sdk.AccAddress) bool {
+	return pk.ik != nil && !pk.bk.BlockedAddr(addr)
+}
+
+// accruedInterestOf returns the interest on the balance of denom that has not been paid out to the account.
+func (pk ProxyKeeper) accruedInterestOf(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Int {
+	if !pk.earnsInterest(addr) {
+		return sdk.ZeroInt()
+	}
+
+	index, found := pk.ik.GetInterestIndex(ctx, denom)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	balance := pk.bk.GetBalance(ctx, addr, denom).Amount
+	return index.AccruedInterest(balance, pk.ik.GetAccountInterestIndex(ctx, denom, addr))
+}
+
+// accruedInterest returns the interest on all balances that has not been paid out to the account.
+func (pk ProxyKeeper) accruedInterest(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if !pk.earnsInterest(addr) {
+		return nil
+	}
+
+	interest := sdk.NewCoins()
+	for _, index := range pk.ik.GetInterestIndexes(ctx) {
+		balance := pk.bk.GetBalance(ctx, addr, index.Denom).Amount
+		amount := index.AccruedInterest(balance, pk.ik.GetAccountInterestIndex(ctx, index.Denom, addr))
+		if amount.IsPositive() {
+			interest = interest.Add(sdk.NewCoin(index.Denom, amount))
+		}
+	}
+
+	return interest
+}
+
+// withInterest adds the accrued interest to the denominations present in coins.
+func withInterest(coins, interest sdk.Coins) sdk.Coins {
+	if interest.Empty() {
+		return coins
+	}
+
+	res := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		res[i] = coin.AddAmount(interest.AmountOf(coin.Denom))
+	}
+	return res
+}
+
+// settleInterest pays out the interest accrued by the accounts before fn changes their balances. The interest indexes
+// keep track of the holder balances afterwards.
+func (pk ProxyKeeper) settleInterest(ctx sdk.Context, accounts []sdk.AccAddress, fn func() error) error {
+	if pk.ik == nil {
+		return fn()
+	}
+
+	indexes := pk.ik.GetInterestIndexes(ctx)
+	if len(indexes) == 0 {
+		return fn()
+	}
+
+	holders := make([]sdk.AccAddress, 0, len(accounts))
+	for _, addr := range deduplicate(accounts) {
+		if pk.earnsInterest(addr) {
+			holders = append(holders, addr)
+		}
+	}
+
+	settled := make([][]sdk.Int, len(indexes))
+	for i := range indexes {
+		index := &indexes[i]
+
+		// Holders that the inflation module has not indexed yet are included in the holder supply on first touch
+		indexing := pk.ik.IsIndexingHolders(ctx, index.Denom)
+
+		settled[i] = make([]sdk.Int, len(holders))
+		for j, addr := range holders {
+			balance := pk.bk.GetBalance(ctx, addr, index.Denom).Amount
+			if indexing && !pk.ik.HasAccountInterestIndex(ctx, index.Denom, addr) {
+				index.Track(balance)
+			}
+			interest := index.Settle(balance, pk.ik.GetAccountInterestIndex(ctx, index.Denom, addr))
+			if interest.IsPositive() {
+				if err := pk.payInterest(ctx, addr, sdk.NewCoin(index.Denom, interest)); err != nil {
+					return err
+				}
+			}
+			settled[i][j] = balance.Add(interest)
+		}
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	for i := range indexes {
+		index := &indexes[i]
+
+		for j, addr := range holders {
+			balance := pk.bk.GetBalance(ctx, addr, index.Denom).Amount
+			index.Track(balance.Sub(settled[i][j]))
+
+			switch {
+			case balance.IsPositive():
+				pk.ik.SetAccountInterestIndex(ctx, index.Denom, addr, index.Index)
+			case settled[i][j].IsPositive():
+				pk.ik.DeleteAccountInterestIndex(ctx, index.Denom, addr)
+			}
+		}
+
+		pk.ik.SetInterestIndex(ctx, *index)
+	}
+
+	return nil
+}
+
+// payInterest mints the interest that was accrued by the inflation module.
+func (pk ProxyKeeper) payInterest(ctx sdk.Context, addr sdk.AccAddress, interest sdk.Coin) error {
+	coins := sdk.NewCoins(interest)
+	if err := pk.bk.MintCoins(ctx, inflationtypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := pk.bk.SendCoins(ctx, authtypes.NewModuleAddress(inflationtypes.ModuleName), addr, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			inflationtypes.EventTypeInterest,
+			sdk.NewAttribute(inflationtypes.AttributeKeyAction, "settle"),
+			sdk.NewAttribute(inflationtypes.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(inflationtypes.AttributeKeyRecipient, addr.String()),
+		),
+	)
+
+	return nil
+}
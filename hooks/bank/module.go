@@ -0,0 +1,40 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package bank
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule is the bank module with its message and query services served by the proxy keeper, so that transactions
+// and queries observe the same balances as the other modules.
+type AppModule struct {
+	bank.AppModule
+
+	keeper *ProxyKeeper
+}
+
+func NewAppModule(cdc codec.Codec, keeper *ProxyKeeper, accountKeeper banktypes.AccountKeeper) AppModule {
+	return AppModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.bk.(bankkeeper.BaseKeeper))
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
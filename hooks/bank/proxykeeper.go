@@ -17,6 +17,7 @@ var _ bankkeeper.Keeper = (*ProxyKeeper)(nil)
 
 type ProxyKeeper struct {
 	bk        bankkeeper.Keeper
+	ik        InterestKeeper
 	listeners []func(sdk.Context, []sdk.AccAddress)
 }
 
@@ -49,14 +50,9 @@ func deduplicate(accounts []sdk.AccAddress) []sdk.AccAddress {
 }
 
 func (pk ProxyKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
-	err := pk.bk.InputOutputCoins(ctx, inputs, outputs)
-	if err != nil {
-		return err
-	}
-
 	accounts := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
 	for _, a := range inputs {
-		// invalid addresses were handled before in the wrapped keeper
+		// invalid addresses are rejected by the wrapped keeper
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
 		accounts = append(accounts, addr)
 	}
@@ -65,12 +61,21 @@ func (pk ProxyKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input
 		accounts = append(accounts, addr)
 	}
 
+	err := pk.settleInterest(ctx, accounts, func() error {
+		return pk.bk.InputOutputCoins(ctx, inputs, outputs)
+	})
+	if err != nil {
+		return err
+	}
+
 	pk.notifyListeners(ctx, accounts...)
 	return nil
 }
 
 func (pk ProxyKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := pk.bk.SendCoins(ctx, fromAddr, toAddr, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{fromAddr, toAddr}, func() error {
+		return pk.bk.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -92,29 +97,34 @@ func (pk ProxyKeeper) ValidateBalance(ctx sdk.Context, addr sdk.AccAddress) erro
 }
 
 func (pk ProxyKeeper) HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool {
-	return pk.bk.HasBalance(ctx, addr, amt)
+	return pk.GetBalance(ctx, addr, amt.Denom).IsGTE(amt)
 }
 
+// GetAllBalances returns the balances of an account including accrued interest.
 func (pk ProxyKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
-	return pk.bk.GetAllBalances(ctx, addr)
+	return withInterest(pk.bk.GetAllBalances(ctx, addr), pk.accruedInterest(ctx, addr))
 }
 
 func (pk ProxyKeeper) GetAccountsBalances(ctx sdk.Context) []banktypes.Balance {
 	return pk.bk.GetAccountsBalances(ctx)
 }
 
+// GetBalance returns the balance of an account including accrued interest.
 func (pk ProxyKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
-	return pk.bk.GetBalance(ctx, addr, denom)
+	return pk.bk.GetBalance(ctx, addr, denom).AddAmount(pk.accruedInterestOf(ctx, addr, denom))
 }
 
 func (pk ProxyKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	return pk.bk.LockedCoins(ctx, addr)
 }
 
+// SpendableCoins returns the spendable balances of an account. Accrued interest is never locked.
 func (pk ProxyKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
-	return pk.bk.SpendableCoins(ctx, addr)
+	return pk.bk.SpendableCoins(ctx, addr).Add(pk.accruedInterest(ctx, addr)...)
 }
 
+// IterateAccountBalances iterates over the settled balances of an account, like the other functions that expose the
+// bank store.
 func (pk ProxyKeeper) IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool)) {
 	pk.bk.IterateAccountBalances(ctx, addr, cb)
 }
@@ -172,7 +182,14 @@ func (pk *ProxyKeeper) HasSupply(ctx sdk.Context, denom string) bool {
 }
 
 func (pk *ProxyKeeper) SpendableBalances(ctx context.Context, request *banktypes.QuerySpendableBalancesRequest) (*banktypes.QuerySpendableBalancesResponse, error) {
-	return pk.bk.SpendableBalances(ctx, request)
+	res, err := pk.bk.SpendableBalances(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, _ := sdk.AccAddressFromBech32(request.Address)
+	res.Balances = withInterest(res.Balances, pk.accruedInterest(sdk.UnwrapSDKContext(ctx), addr))
+	return res, nil
 }
 
 // GetAllDenomMetaData cloned here from the bank keeper as it is not exposed in
@@ -192,7 +209,9 @@ func (pk *ProxyKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(banktype
 }
 
 func (pk *ProxyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := pk.bk.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{recipientAddr}, func() error {
+		return pk.bk.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -205,7 +224,9 @@ func (pk *ProxyKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule
 }
 
 func (pk *ProxyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
-	err := pk.bk.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{senderAddr}, func() error {
+		return pk.bk.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -214,7 +235,9 @@ func (pk *ProxyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr
 }
 
 func (pk *ProxyKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
-	err := pk.bk.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{senderAddr}, func() error {
+		return pk.bk.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -223,7 +246,9 @@ func (pk *ProxyKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderA
 }
 
 func (pk *ProxyKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := pk.bk.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{recipientAddr}, func() error {
+		return pk.bk.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -236,7 +261,9 @@ func (pk *ProxyKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coi
 }
 
 func (pk *ProxyKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := pk.bk.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{delegatorAddr}, func() error {
+		return pk.bk.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -245,7 +272,9 @@ func (pk *ProxyKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAd
 }
 
 func (pk *ProxyKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := pk.bk.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+	err := pk.settleInterest(ctx, []sdk.AccAddress{delegatorAddr}, func() error {
+		return pk.bk.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+	})
 	if err != nil {
 		return err
 	}
@@ -254,11 +283,29 @@ func (pk *ProxyKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegator
 }
 
 func (pk *ProxyKeeper) Balance(ctx context.Context, request *banktypes.QueryBalanceRequest) (*banktypes.QueryBalanceResponse, error) {
-	return pk.bk.Balance(ctx, request)
+	res, err := pk.bk.Balance(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, _ := sdk.AccAddressFromBech32(request.Address)
+	interest := pk.accruedInterestOf(sdk.UnwrapSDKContext(ctx), addr, request.Denom)
+	if interest.IsPositive() {
+		balance := res.Balance.AddAmount(interest)
+		res.Balance = &balance
+	}
+	return res, nil
 }
 
 func (pk *ProxyKeeper) AllBalances(ctx context.Context, request *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
-	return pk.bk.AllBalances(ctx, request)
+	res, err := pk.bk.AllBalances(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, _ := sdk.AccAddressFromBech32(request.Address)
+	res.Balances = withInterest(res.Balances, pk.accruedInterest(sdk.UnwrapSDKContext(ctx), addr))
+	return res, nil
 }
 
 func (pk *ProxyKeeper) TotalSupply(ctx context.Context, request *banktypes.QueryTotalSupplyRequest) (*banktypes.QueryTotalSupplyResponse, error) {
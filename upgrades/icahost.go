@@ -0,0 +1,49 @@
+package upgrades
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
+)
+
+// ICAHostAllowMessages are the messages that interchain accounts controlled from other chains may execute, unless
+// changed by the authority.
+var ICAHostAllowMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&markettypes.MsgAddLimitOrder{}),
+	sdk.MsgTypeURL(&markettypes.MsgAddMarketOrder{}),
+	sdk.MsgTypeURL(&markettypes.MsgCancelOrder{}),
+	sdk.MsgTypeURL(&markettypes.MsgCancelReplaceLimitOrder{}),
+	sdk.MsgTypeURL(&markettypes.MsgCancelReplaceMarketOrder{}),
+	sdk.MsgTypeURL(&lptypes.MsgMintTokens{}),
+	sdk.MsgTypeURL(&lptypes.MsgBurnTokens{}),
+}
+
+// ICAHost adds the interchain accounts host, allowing accounts on this chain to be controlled from other chains.
+var ICAHost = Upgrade{
+	Name: "ica-host",
+	StoreUpgrades: types.StoreUpgrades{
+		Added: []string{icahosttypes.StoreKey},
+	},
+	CreateHandler: func(keepers Keepers) upgradetypes.UpgradeHandler {
+		return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			if _, found := fromVM[icatypes.ModuleName]; !found {
+				// set the parameters and bind the host port in place of InitGenesis
+				icaModule := ica.NewAppModule(nil, keepers.ICAHostKeeper)
+				fromVM[icatypes.ModuleName] = icaModule.ConsensusVersion()
+				icaModule.InitModule(ctx, icacontrollertypes.Params{}, icahosttypes.NewParams(true, ICAHostAllowMessages))
+			}
+
+			return keepers.ModuleManager.RunMigrations(ctx, keepers.Configurator, fromVM)
+		}
+	},
+}
@@ -0,0 +1,114 @@
+// Package upgrades declares the software upgrades of em-ledger. Every upgrade lists the stores it adds, renames and
+// deletes together with its migration, and the application registers all of them on start.
+package upgrades
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+)
+
+// Keepers gives the migrations access to the application.
+type Keepers struct {
+	ModuleManager *module.Manager
+	Configurator  module.Configurator
+	IBCKeeper     *ibckeeper.Keeper
+	ICAHostKeeper *icahostkeeper.Keeper
+}
+
+// Upgrade declares a software upgrade.
+type Upgrade struct {
+	// Name of the upgrade plan scheduled by the authority.
+	Name string
+	// Stores added, renamed and deleted at the upgrade height.
+	StoreUpgrades types.StoreUpgrades
+	// Unversioned marks upgrades from SDK versions that did not keep module versions. Their handlers ignore the
+	// version map they are given and are not required to be safe to run again.
+	Unversioned bool
+	// CreateHandler returns the migration. It must be safe to run again on migrated state, unless Unversioned is set.
+	CreateHandler func(keepers Keepers) upgradetypes.UpgradeHandler
+}
+
+// Upgrades are registered with the upgrade keeper in this order.
+var Upgrades = []Upgrade{
+	V44Sample,
+	ICAHost,
+}
+
+// Get returns the registered upgrade of the given plan name.
+func Get(name string) (Upgrade, bool) {
+	for _, u := range Upgrades {
+		if u.Name == name {
+			return u, true
+		}
+	}
+
+	return Upgrade{}, false
+}
+
+// Validate checks that the upgrade has a name and a handler, and changes every store at most once.
+func (u Upgrade) Validate() error {
+	if u.Name == "" {
+		return fmt.Errorf("upgrade name must not be empty")
+	}
+
+	if u.CreateHandler == nil {
+		return fmt.Errorf("upgrade %v has no handler", u.Name)
+	}
+
+	stores := make(map[string]bool)
+	check := func(name string) error {
+		if name == "" {
+			return fmt.Errorf("upgrade %v has an empty store name", u.Name)
+		}
+		if stores[name] {
+			return fmt.Errorf("upgrade %v changes store %v more than once", u.Name, name)
+		}
+		stores[name] = true
+		return nil
+	}
+
+	for _, name := range u.StoreUpgrades.Added {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+
+	for _, rename := range u.StoreUpgrades.Renamed {
+		if err := check(rename.OldKey); err != nil {
+			return err
+		}
+		if err := check(rename.NewKey); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range u.StoreUpgrades.Deleted {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the given upgrades and that their names are unique.
+func Validate(upgrades []Upgrade) error {
+	names := make(map[string]bool)
+	for _, u := range upgrades {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+
+		if names[u.Name] {
+			return fmt.Errorf("duplicate upgrade %v", u.Name)
+		}
+		names[u.Name] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package upgrades
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredUpgrades(t *testing.T) {
+	require.NoError(t, Validate(Upgrades))
+
+	u, found := Get(ICAHost.Name)
+	require.True(t, found)
+	require.Equal(t, ICAHost.StoreUpgrades, u.StoreUpgrades)
+
+	_, found = Get("unknown")
+	require.False(t, found)
+}
+
+func TestValidate(t *testing.T) {
+	noop := func(Keepers) upgradetypes.UpgradeHandler {
+		return func(_ sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return fromVM, nil
+		}
+	}
+
+	tests := []struct {
+		name     string
+		upgrades []Upgrade
+		valid    bool
+	}{
+		{
+			name:     "empty",
+			upgrades: nil,
+			valid:    true,
+		},
+		{
+			name: "store changes",
+			upgrades: []Upgrade{
+				{
+					Name: "first",
+					StoreUpgrades: types.StoreUpgrades{
+						Added:   []string{"a"},
+						Renamed: []types.StoreRename{{OldKey: "b", NewKey: "c"}},
+						Deleted: []string{"d"},
+					},
+					CreateHandler: noop,
+				},
+				{Name: "second", StoreUpgrades: types.StoreUpgrades{Added: []string{"a"}}, CreateHandler: noop},
+			},
+			valid: true,
+		},
+		{
+			name:     "no name",
+			upgrades: []Upgrade{{CreateHandler: noop}},
+		},
+		{
+			name:     "no handler",
+			upgrades: []Upgrade{{Name: "first"}},
+		},
+		{
+			name: "duplicate name",
+			upgrades: []Upgrade{
+				{Name: "first", CreateHandler: noop},
+				{Name: "first", CreateHandler: noop},
+			},
+		},
+		{
+			name: "empty store name",
+			upgrades: []Upgrade{
+				{Name: "first", StoreUpgrades: types.StoreUpgrades{Added: []string{""}}, CreateHandler: noop},
+			},
+		},
+		{
+			name: "store added and deleted",
+			upgrades: []Upgrade{
+				{
+					Name:          "first",
+					StoreUpgrades: types.StoreUpgrades{Added: []string{"a"}, Deleted: []string{"a"}},
+					CreateHandler: noop,
+				},
+			},
+		},
+		{
+			name: "store renamed onto added store",
+			upgrades: []Upgrade{
+				{
+					Name: "first",
+					StoreUpgrades: types.StoreUpgrades{
+						Added:   []string{"a"},
+						Renamed: []types.StoreRename{{OldKey: "b", NewKey: "a"}},
+					},
+					CreateHandler: noop,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.upgrades)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
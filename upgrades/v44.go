@@ -0,0 +1,65 @@
+package upgrades
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+)
+
+const upg44Plan = "v44-upg-test-sample"
+
+// V44Sample is a test upgrade from the v0.40 SDK, which did not keep module versions.
+var V44Sample = Upgrade{
+	Name: upg44Plan,
+	StoreUpgrades: types.StoreUpgrades{
+		Added: []string{authz.ModuleName, feegrant.ModuleName},
+	},
+	Unversioned: true,
+	CreateHandler: func(keepers Keepers) upgradetypes.UpgradeHandler {
+		return func(ctx sdk.Context, _ upgradetypes.Plan, _ module.VersionMap) (module.VersionMap, error) {
+			// set max expected block time parameter. Replace the default with your expected value
+			// https://github.com/cosmos/ibc-go/blob/release/v1.0.x/docs/ibc/proto-docs.md#params-2
+			// set max block time to 30 seconds
+			keepers.IBCKeeper.ConnectionKeeper.SetParams(
+				// should 3-5 minutes in mainnet
+				ctx, ibcconnectiontypes.DefaultParams(),
+			)
+
+			fromVM := make(map[string]uint64)
+			for moduleName := range keepers.ModuleManager.Modules {
+				// v40 state is version 1
+				// v43 state is version 2
+				// v45 state is likely v3
+				fromVM[moduleName] = 1
+			}
+
+			// EXCEPT Auth needs to run _after_ staking (https://github.com/cosmos/cosmos-sdk/issues/10591),
+			// and it seems bank as well (https://github.com/provenance-io/provenance/blob/407c89a7d73854515894161e1526f9623a94c368/app/upgrades.go#L86-L122).
+			// So we do this by making auth run last.
+			// This is done by setting auth's consensus version to 2, running RunMigrations,
+			// then setting it back to 1, and then running migrations again.
+			fromVM[authtypes.ModuleName] = 2
+
+			// override versions for _new_ modules as to not skip InitGenesis
+			fromVM[authz.ModuleName] = 0
+			fromVM[feegrant.ModuleName] = 0
+
+			ctx.Logger().Info("Upgrading to " + upg44Plan)
+
+			newVM, err := keepers.ModuleManager.RunMigrations(ctx, keepers.Configurator, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			// now update auth version back to v1, to run auth migration last
+			newVM[authtypes.ModuleName] = 1
+
+			return keepers.ModuleManager.RunMigrations(ctx, keepers.Configurator, newVM)
+		}
+	},
+}
@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetGasPricesCmd(),
 		GetUpgradePlanCmd(),
+		GetAppliedUpgradesCmd(),
 	)
 
 	return cmd
@@ -75,3 +76,27 @@ func GetUpgradePlanCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetAppliedUpgradesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "applied-upgrades",
+		Short: "Query the completed upgrades and their heights",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AppliedUpgrades(cmd.Context(), &types.QueryAppliedUpgradesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -37,6 +37,7 @@ func GetTxCmd() *cobra.Command {
 		getCmdSetGasPrices(),
 		GetCmdReplaceAuthority(),
 		GetCmdScheduleUpgrade(),
+		GetCmdCancelUpgrade(),
 		getCmdSetParameters(),
 	)
 
@@ -195,25 +196,29 @@ For a 24-hour grace period the former authority key is equivalent to the new one
 }
 
 const (
-	UpgHeight = "upgrade-height"
-	UpgInfo   = "upgrade-info"
+	UpgHeight  = "upgrade-height"
+	UpgInfo    = "upgrade-info"
+	UpgReplace = "replace"
 )
 
 func GetCmdScheduleUpgrade() *cobra.Command {
 	var (
-		upgHeightVal int64
-		upgInfoVal   string
+		upgHeightVal  int64
+		upgInfoVal    string
+		upgReplaceVal bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "schedule-upgrade [authority_key_or_address] plan_name",
 		Short: "Schedule a software upgrade",
 		Example: `emd tx authority schedule-upgrade someplan --upgrade-height 2001 --from emoney1xue7fm6es84jze49grm4slhlmr4ffz8a3u7g3t 0.43
-emd tx authority schedule-upgrade sdk-v0.43.0 --upgrade-height 2001 --from emoney1xue7fm6es84jze49grm4slhlmr4ffz8a3u7g3t --upgrade-info '{"binaries":{"linux/amd64":"http://localhost:8765/test-upg-0.2.0/emd.zip?checksum=sha256:cadd5b52fe90a04e20b2cbb93291b0d1d0204f17b64b2215eb09f5dc78a127f1"}}'`,
+emd tx authority schedule-upgrade sdk-v0.43.0 --upgrade-height 2001 --from emoney1xue7fm6es84jze49grm4slhlmr4ffz8a3u7g3t --upgrade-info '{"binaries":{"linux/amd64":"http://localhost:8765/test-upg-0.2.0/emd.zip?checksum=sha256:cadd5b52fe90a04e20b2cbb93291b0d1d0204f17b64b2215eb09f5dc78a127f1"}}'
+emd tx authority schedule-upgrade sdk-v0.43.0 --upgrade-height 3001 --replace --from emoney1xue7fm6es84jze49grm4slhlmr4ffz8a3u7g3t`,
 		Long: `Schedule a software upgrade by submitting a unique plan name that
  has not been used before with either an absolute block height or block time. An
 upgrade handler should be defined at the upgraded binary. Optionally If you set DAEMON_ALLOW_DOWNLOAD_BINARIES=on pass
-the upgraded binary download url with the --upgrade-info flag i.e., --upgrade-info '{"binaries":{"linux/amd64":"http://localhost:8765/test-upg-0.2.0/emd.zip?checksum=sha256:cadd5b52fe90a04e20b2cbb93291b0d1d0204f17b64b2215eb09f5dc78a127f1"}}'`,
+the upgraded binary download url with the --upgrade-info flag i.e., --upgrade-info '{"binaries":{"linux/amd64":"http://localhost:8765/test-upg-0.2.0/emd.zip?checksum=sha256:cadd5b52fe90a04e20b2cbb93291b0d1d0204f17b64b2215eb09f5dc78a127f1"}}'
+Every binary url must carry a sha256 or sha512 checksum. An already scheduled upgrade is only replaced with the --replace flag.`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := cmd.Flags().Set(flags.FlagFrom, args[0])
@@ -237,6 +242,7 @@ the upgraded binary download url with the --upgrade-info flag i.e., --upgrade-in
 					Height: upgHeightVal,
 					Info:   upgInfoVal,
 				},
+				Replace: upgReplaceVal,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -251,11 +257,44 @@ the upgraded binary download url with the --upgrade-info flag i.e., --upgrade-in
 	f.StringVarP(
 		&upgInfoVal, UpgInfo, "i", "", "Upgrade info",
 	)
+	f.BoolVar(&upgReplaceVal, UpgReplace, false, "Replace the currently scheduled upgrade")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+func GetCmdCancelUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-upgrade [authority_key_or_address]",
+		Short:   "Cancel the scheduled software upgrade",
+		Example: "emd tx authority cancel-upgrade masterkey",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelUpgrade{
+				Authority: clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func validateUpgFlags(upgHeight string, upgHeightVal int64) error {
 	if upgHeightVal == 0 {
 		return sdkerrors.Wrapf(
@@ -302,6 +341,9 @@ Where proposal.json contains:
 
 -- OR JSON fragment e.g. [{"subspace":"staking","key":"MaxValidators","value":10}]
 
+Values that are arrays or objects are given as a JSON encoded string, e.g.
+[{"subspace":"icahost","key":"AllowMessages","value":"[\"/cosmos.bank.v1beta1.MsgSend\"]"}]
+
 `),
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -367,7 +409,25 @@ func parseParamChangesJSON(cdc *codec.LegacyAmino, jsonFile string) (utils.Param
 
 func getParsedParams(cdc *codec.LegacyAmino, contents []byte) (utils.ParamChangesJSON, error) {
 	var params utils.ParamChangesJSON
-	err := json.Unmarshal(contents, &params)
+	if err := json.Unmarshal(contents, &params); err != nil {
+		return nil, err
+	}
+
+	// ParamChange.Value is a string, so arrays and objects may be given as their JSON encoding
+	for i, change := range params {
+		var value string
+		if err := json.Unmarshal(change.Value, &value); err != nil {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(value)
+		if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+			if !json.Valid([]byte(trimmed)) {
+				return nil, fmt.Errorf("value of %v/%v is not valid JSON", change.Subspace, change.Key)
+			}
+			params[i].Value = json.RawMessage(trimmed)
+		}
+	}
 
-	return params, err
+	return params, nil
 }
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetParsedParams(t *testing.T) {
+	params, err := getParsedParams(codec.NewLegacyAmino(), []byte(`[
+		{"subspace":"staking","key":"MaxValidators","value":10},
+		{"subspace":"icahost","key":"AllowMessages","value":"[\"/cosmos.bank.v1beta1.MsgSend\"]"},
+		{"subspace":"icahost","key":"AllowMessages","value":["/cosmos.bank.v1beta1.MsgSend"]},
+		{"subspace":"staking","key":"BondDenom","value":"ungm"}
+	]`))
+	require.NoError(t, err)
+
+	changes := params.ToParamChanges()
+	require.Equal(t, "10", changes[0].Value)
+	require.Equal(t, `["/cosmos.bank.v1beta1.MsgSend"]`, changes[1].Value)
+	require.Equal(t, `["/cosmos.bank.v1beta1.MsgSend"]`, changes[2].Value)
+	require.Equal(t, `"ungm"`, changes[3].Value)
+
+	_, err = getParsedParams(codec.NewLegacyAmino(), []byte(`[{"subspace":"icahost","key":"AllowMessages","value":"[\"unterminated"}]`))
+	require.Error(t, err)
+}
@@ -21,6 +21,21 @@ func DefaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{}
 }
 
+// ValidateGenesis checks the authority key, which may still be unset, and the gas prices.
+func ValidateGenesis(state types.GenesisState) error {
+	if state.AuthorityKey != "" {
+		if _, err := sdk.AccAddressFromBech32(state.AuthorityKey); err != nil {
+			return sdkerrors.Wrap(err, "authority key")
+		}
+	}
+
+	if err := state.MinGasPrices.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidGasPrices, err.Error())
+	}
+
+	return nil
+}
+
 func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) error {
 	authKey, err := sdk.AccAddressFromBech32(state.AuthorityKey)
 	if err != nil {
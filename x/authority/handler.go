@@ -40,6 +40,10 @@ func newHandler(k Keeper) sdk.Handler {
 			res, err := msgServer.ScheduleUpgrade(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCancelUpgrade:
+			res, err := msgServer.CancelUpgrade(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSetParameters:
 			res, err := msgServer.SetParameters(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -30,3 +30,13 @@ func (k Keeper) UpgradePlan(c context.Context, req *types.QueryUpgradePlanReques
 
 	return &types.QueryUpgradePlanResponse{Plan: plan}, nil
 }
+
+func (k Keeper) AppliedUpgrades(c context.Context, req *types.QueryAppliedUpgradesRequest) (*types.QueryAppliedUpgradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAppliedUpgradesResponse{Upgrades: k.GetAppliedUpgrades(ctx)}, nil
+}
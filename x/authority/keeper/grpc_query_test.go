@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/e-money/em-ledger/x/authority/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,3 +80,23 @@ func TestQueryUpgradePlan(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryAppliedUpgrades(t *testing.T) {
+	encConfig := MakeTestEncodingConfig()
+	ctx, keeper, _, _ := createTestComponentWithEncodingConfig(t, encConfig)
+
+	plan := upgradetypes.Plan{Name: "applied", Height: 10}
+	keeper.upgradeKeeper.SetUpgradeHandler(plan.Name, func(_ sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	keeper.upgradeKeeper.ApplyUpgrade(ctx.WithBlockHeight(plan.Height), plan)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encConfig.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	gotRsp, gotErr := queryClient.AppliedUpgrades(sdk.WrapSDKContext(ctx), &types.QueryAppliedUpgradesRequest{})
+	require.NoError(t, gotErr)
+	require.NotNil(t, gotRsp)
+	assert.Equal(t, []types.AppliedUpgrade{{Name: "applied", Height: 10}}, gotRsp.Upgrades)
+}
@@ -5,11 +5,15 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -38,6 +42,7 @@ type Keeper struct {
 	ik            issuer.Keeper
 	bankKeeper    types.BankKeeper
 	upgradeKeeper types.UpgradeKeeper
+	upgradeKey    sdk.StoreKey
 	paramsKeeper  types.ParamsKeeper
 	gpk           types.GasPricesKeeper
 
@@ -47,7 +52,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec, storeKey sdk.StoreKey,
 	issuerKeeper issuer.Keeper, bankKeeper types.BankKeeper,
-	gasPricesKeeper types.GasPricesKeeper, upgradeKeeper types.UpgradeKeeper,
+	gasPricesKeeper types.GasPricesKeeper, upgradeKeeper types.UpgradeKeeper, upgradeKey sdk.StoreKey,
 	paramsKeeper types.ParamsKeeper,
 ) Keeper {
 	return Keeper{
@@ -57,6 +62,7 @@ func NewKeeper(
 		gpk:           gasPricesKeeper,
 		storeKey:      storeKey,
 		upgradeKeeper: upgradeKeeper,
+		upgradeKey:    upgradeKey,
 		paramsKeeper:  paramsKeeper,
 
 		gasPricesInit: new(sync.Once),
@@ -231,6 +237,7 @@ func (k Keeper) replaceAuthority(ctx sdk.Context, authority, newAuthority sdk.Ac
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// ScheduleUpgrade schedules the plan if no other upgrade is scheduled.
 func (k Keeper) ScheduleUpgrade(
 	ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan,
 ) (*sdk.Result, error) {
@@ -238,6 +245,35 @@ func (k Keeper) ScheduleUpgrade(
 		return nil, err
 	}
 
+	if scheduled, found := k.upgradeKeeper.GetUpgradePlan(ctx); found {
+		return nil, sdkerrors.Wrapf(types.ErrUpgradeAlreadyPlanned, "%v at height %d", scheduled.Name, scheduled.Height)
+	}
+
+	return k.scheduleUpgrade(ctx, plan)
+}
+
+// ReplaceUpgrade schedules the plan in place of the scheduled upgrade.
+func (k Keeper) ReplaceUpgrade(
+	ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan,
+) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.upgradeKeeper.GetUpgradePlan(ctx); !found {
+		return nil, types.ErrNoUpgradePlan
+	}
+
+	return k.scheduleUpgrade(ctx, plan)
+}
+
+func (k Keeper) scheduleUpgrade(ctx sdk.Context, plan upgradetypes.Plan) (*sdk.Result, error) {
+	if plan.Height <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "upgrade height %d must be after the current height %d", plan.Height, ctx.BlockHeight(),
+		)
+	}
+
 	if err := k.upgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
 		return nil, err
 	}
@@ -245,10 +281,46 @@ func (k Keeper) ScheduleUpgrade(
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// CancelUpgrade removes the scheduled upgrade.
+func (k Keeper) CancelUpgrade(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.upgradeKeeper.GetUpgradePlan(ctx); !found {
+		return nil, types.ErrNoUpgradePlan
+	}
+
+	k.upgradeKeeper.ClearUpgradePlan(ctx)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool) {
 	return k.upgradeKeeper.GetUpgradePlan(ctx)
 }
 
+// GetAppliedUpgrades returns the completed upgrades ordered by height, read from the done markers of the upgrade module.
+func (k Keeper) GetAppliedUpgrades(ctx sdk.Context) []types.AppliedUpgrade {
+	store := prefix.NewStore(ctx.KVStore(k.upgradeKey), []byte{upgradetypes.DoneByte})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	applied := make([]types.AppliedUpgrade, 0)
+	for ; iter.Valid(); iter.Next() {
+		applied = append(applied, types.AppliedUpgrade{
+			Name:   string(iter.Key()),
+			Height: int64(binary.BigEndian.Uint64(iter.Value())),
+		})
+	}
+
+	sort.SliceStable(applied, func(i, j int) bool {
+		return applied[i].Height < applied[j].Height
+	})
+
+	return applied
+}
+
 func (k Keeper) SetParams(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error) {
 	if err := k.ValidateAuthority(ctx, authority); err != nil {
 		return nil, err
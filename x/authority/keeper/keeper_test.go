@@ -30,6 +30,7 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer"
 	"github.com/e-money/em-ledger/x/liquidityprovider"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -288,6 +289,76 @@ func TestManageGasPrices1(t *testing.T) {
 	require.True(t, types.ErrUnknownDenom.Is(err))
 }
 
+func TestScheduleReplaceAndCancelUpgrade(t *testing.T) {
+	ctx, keeper, _, _ := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	var (
+		accAuthority = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		accRandom    = mustParseAddress("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+	)
+
+	keeper.BootstrapAuthority(ctx, accAuthority)
+
+	first := upgradetypes.Plan{Name: "first", Height: 200}
+	second := upgradetypes.Plan{Name: "second", Height: 300}
+
+	_, err := keeper.ReplaceUpgrade(ctx, accAuthority, first)
+	require.True(t, types.ErrNoUpgradePlan.Is(err))
+	_, err = keeper.CancelUpgrade(ctx, accAuthority)
+	require.True(t, types.ErrNoUpgradePlan.Is(err))
+
+	// the upgrade must be after the current block
+	_, err = keeper.ScheduleUpgrade(ctx, accAuthority, upgradetypes.Plan{Name: "now", Height: 100})
+	require.Error(t, err)
+
+	_, err = keeper.ScheduleUpgrade(ctx, accRandom, first)
+	require.True(t, types.ErrNotAuthority.Is(err))
+
+	_, err = keeper.ScheduleUpgrade(ctx, accAuthority, first)
+	require.NoError(t, err)
+
+	_, err = keeper.ScheduleUpgrade(ctx, accAuthority, second)
+	require.True(t, types.ErrUpgradeAlreadyPlanned.Is(err))
+
+	_, err = keeper.ReplaceUpgrade(ctx, accRandom, second)
+	require.True(t, types.ErrNotAuthority.Is(err))
+
+	_, err = keeper.ReplaceUpgrade(ctx, accAuthority, second)
+	require.NoError(t, err)
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, second, plan)
+
+	_, err = keeper.CancelUpgrade(ctx, accRandom)
+	require.True(t, types.ErrNotAuthority.Is(err))
+
+	_, err = keeper.CancelUpgrade(ctx, accAuthority)
+	require.NoError(t, err)
+
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestGetAppliedUpgrades(t *testing.T) {
+	ctx, keeper, _, _ := createTestComponents(t)
+	require.Empty(t, keeper.GetAppliedUpgrades(ctx))
+
+	for _, plan := range []upgradetypes.Plan{{Name: "second", Height: 20}, {Name: "first", Height: 10}} {
+		keeper.upgradeKeeper.SetUpgradeHandler(plan.Name, func(_ sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return vm, nil
+		})
+		keeper.upgradeKeeper.ApplyUpgrade(ctx.WithBlockHeight(plan.Height), plan)
+	}
+
+	expUpgrades := []types.AppliedUpgrade{
+		{Name: "first", Height: 10},
+		{Name: "second", Height: 20},
+	}
+	require.Equal(t, expUpgrades, keeper.GetAppliedUpgrades(ctx))
+}
+
 func TestReplaceAuthority(t *testing.T) {
 	ctx, keeper, _, _ := createTestComponents(t)
 
@@ -431,7 +502,7 @@ func createTestComponentWithEncodingConfig(t *testing.T, encConfig simappparams.
 			encConfig.Marshaler, bankKey, ak, pk.Subspace(banktypes.ModuleName), blockedAddr,
 		)
 		lpk = liquidityprovider.NewKeeper(encConfig.Marshaler, keyLp, bk)
-		ik  = issuer.NewKeeper(encConfig.Marshaler, keyIssuer, lpk, mockInflationKeeper{}, bk)
+		ik  = issuer.NewKeeper(encConfig.Marshaler, keyIssuer, pk.Subspace(issuer.ModuleName), lpk, mockInflationKeeper{}, bk)
 
 		app  = simapp.Setup(false)
 		upgK = upgradekeeper.NewKeeper(map[int64]bool{}, keyUpg, encConfig.Marshaler, t.TempDir(), app)
@@ -442,7 +513,7 @@ func createTestComponentWithEncodingConfig(t *testing.T, encConfig simappparams.
 		sdk.NewCoin("eeur", sdk.NewInt(5000))))
 
 	gpk := new(mockGasPricesKeeper)
-	keeper := NewKeeper(encConfig.Marshaler, authKey, ik, bk, gpk, upgK, pk)
+	keeper := NewKeeper(encConfig.Marshaler, authKey, ik, bk, gpk, upgK, keyUpg, pk)
 
 	return ctx, keeper, ik, gpk
 }
@@ -471,6 +542,10 @@ func (m mockInflationKeeper) AddDenoms(sdk.Context, []string) (_ *sdk.Result, _
 	return
 }
 
+func (m mockInflationKeeper) SetDistribution(sdk.Context, string, []inflationtypes.InflationRecipient) (_ *sdk.Result, _ error) {
+	return
+}
+
 var encodingConfig simappparams.EncodingConfig
 
 func MakeTestEncodingConfig() simappparams.EncodingConfig {
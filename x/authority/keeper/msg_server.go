@@ -21,6 +21,8 @@ type authorityKeeper interface {
 	replaceAuthority(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
 	SetGasPrices(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
 	ScheduleUpgrade(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	ReplaceUpgrade(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	CancelUpgrade(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error)
 	GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
 	SetParams(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
 }
@@ -133,7 +135,12 @@ func (m msgServer) ScheduleUpgrade(
 		)
 	}
 
-	result, err := m.k.ScheduleUpgrade(ctx, authority, msg.Plan)
+	schedule := m.k.ScheduleUpgrade
+	if msg.Replace {
+		schedule = m.k.ReplaceUpgrade
+	}
+
+	result, err := schedule(ctx, authority, msg.Plan)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +152,28 @@ func (m msgServer) ScheduleUpgrade(
 	return &types.MsgScheduleUpgradeResponse{}, nil
 }
 
+func (m msgServer) CancelUpgrade(
+	goCtx context.Context, msg *types.MsgCancelUpgrade,
+) (*types.MsgCancelUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.CancelUpgrade(ctx, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
 func (m msgServer) SetParameters(goCtx context.Context, msg *types.MsgSetParameters) (*types.MsgSetParametersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
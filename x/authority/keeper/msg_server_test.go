@@ -302,6 +302,7 @@ func TestScheduleUpgrade(t *testing.T) {
 	specs := map[string]struct {
 		req       *types.MsgScheduleUpgrade
 		mockFn    func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+		replaceFn func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
 		expErr    bool
 		expEvents sdk.Events
 	}{
@@ -327,6 +328,36 @@ func TestScheduleUpgrade(t *testing.T) {
 				Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
 			}},
 		},
+		"replace": {
+			req: &types.MsgScheduleUpgrade{
+				Authority: authorityAddr.String(),
+				Plan: upgradetypes.Plan{
+					Name:   "plan9",
+					Height: 200,
+					Info:   `{"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=sha256:cadd5b52fe90a04e20b2cbb93291b0d1d0204f17b64b2215eb09f5dc78a127f1"}}`,
+				},
+				Replace: true,
+			},
+			replaceFn: func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error) {
+				gotAuthority, gotPlan = authority, plan
+				return &sdk.Result{}, nil
+			},
+			expEvents: sdk.Events{},
+		},
+		"invalid upgrade info": {
+			req: &types.MsgScheduleUpgrade{
+				Authority: authorityAddr.String(),
+				Plan: upgradetypes.Plan{
+					Name:   "test1",
+					Height: 100,
+					Info:   "some text here",
+				},
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error) {
+				return &sdk.Result{}, nil
+			},
+			expErr: true,
+		},
 		"authority missing": {
 			req: &types.MsgScheduleUpgrade{
 				Plan: upgradetypes.Plan{
@@ -401,7 +432,7 @@ func TestScheduleUpgrade(t *testing.T) {
 
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			keeper.scheduleUpgradefn = spec.mockFn
+			keeper.scheduleUpgradefn, keeper.replaceUpgradefn = spec.mockFn, spec.replaceFn
 			eventManager := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
 			_, gotErr := svr.ScheduleUpgrade(sdk.WrapSDKContext(ctx), spec.req)
@@ -417,6 +448,67 @@ func TestScheduleUpgrade(t *testing.T) {
 	}
 }
 
+func TestCancelUpgrade(t *testing.T) {
+	var (
+		authorityAddr = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		gotAuthority  sdk.AccAddress
+	)
+
+	keeper := authorityKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req       *types.MsgCancelUpgrade
+		mockFn    func(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error)
+		expErr    bool
+		expEvents sdk.Events
+	}{
+		"all good": {
+			req: &types.MsgCancelUpgrade{Authority: authorityAddr.String()},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error) {
+				gotAuthority = authority
+				return &sdk.Result{
+					Events: []abcitypes.Event{{
+						Type:       "testing",
+						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+					}},
+				}, nil
+			},
+			expEvents: sdk.Events{{
+				Type:       "testing",
+				Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+			}},
+		},
+		"authority invalid": {
+			req:    &types.MsgCancelUpgrade{Authority: "invalid"},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgCancelUpgrade{Authority: authorityAddr.String()},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error) {
+				return nil, types.ErrNoUpgradePlan
+			},
+			expErr: true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.cancelUpgradefn = spec.mockFn
+			eventManager := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
+			_, gotErr := svr.CancelUpgrade(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEvents, eventManager.Events())
+			assert.Equal(t, spec.req.Authority, gotAuthority.String())
+		})
+	}
+}
+
 func TestReplaceAuth(t *testing.T) {
 	var (
 		authorityAddr                 = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
@@ -613,6 +705,8 @@ type authorityKeeperMock struct {
 	SetGasPricesfn     func(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
 	replaceAuthorityfn func(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
 	scheduleUpgradefn  func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	replaceUpgradefn   func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	cancelUpgradefn    func(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error)
 	getUpgradePlanfn   func(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
 	applyUpgradefn     func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
 	setParamsfn        func(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
@@ -667,6 +761,24 @@ func (a authorityKeeperMock) ScheduleUpgrade(
 	return a.scheduleUpgradefn(ctx, authority, plan)
 }
 
+func (a authorityKeeperMock) ReplaceUpgrade(
+	ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan,
+) (*sdk.Result, error) {
+	if a.replaceUpgradefn == nil {
+		panic("not expected to be called")
+	}
+
+	return a.replaceUpgradefn(ctx, authority, plan)
+}
+
+func (a authorityKeeperMock) CancelUpgrade(ctx sdk.Context, authority sdk.AccAddress) (*sdk.Result, error) {
+	if a.cancelUpgradefn == nil {
+		panic("not expected to be called")
+	}
+
+	return a.cancelUpgradefn(ctx, authority)
+}
+
 func (a authorityKeeperMock) GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool) {
 	if a.getUpgradePlanfn == nil {
 		panic("not expected to be called")
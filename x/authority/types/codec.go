@@ -27,6 +27,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetGasPrices{}, "e-money/MsgSetGasPrices", nil)
 	cdc.RegisterConcrete(&MsgReplaceAuthority{}, "e-money/MsgReplaceAuthority", nil)
 	cdc.RegisterConcrete(&MsgScheduleUpgrade{}, "e-money/MsgScheduleUpgrade", nil)
+	cdc.RegisterConcrete(&MsgCancelUpgrade{}, "e-money/MsgCancelUpgrade", nil)
 	cdc.RegisterConcrete(&MsgSetParameters{}, "e-money/MsgSetParameters", nil)
 }
 
@@ -37,6 +38,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetGasPrices{},
 		&MsgReplaceAuthority{},
 		&MsgScheduleUpgrade{},
+		&MsgCancelUpgrade{},
 		&MsgSetParameters{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
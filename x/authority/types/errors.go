@@ -18,5 +18,8 @@ var (
 	ErrMissingFlag           = sdkerrors.Register(ModuleName, 7, "missing flag")
 	ErrGetTotalSupply        = sdkerrors.Register(ModuleName, 8, "GetPaginatedSupply() erred")
 	//	ErrPlanTimeIsSet         = sdkerrors.Register(ModuleName, 8, "upgrade plan cannot set time")
-	ErrNoParams = sdkerrors.Register(ModuleName, 9, "no parameter changes specified")
+	ErrNoParams              = sdkerrors.Register(ModuleName, 9, "no parameter changes specified")
+	ErrInvalidUpgradeInfo    = sdkerrors.Register(ModuleName, 10, "invalid upgrade info")
+	ErrUpgradeAlreadyPlanned = sdkerrors.Register(ModuleName, 11, "an upgrade is already scheduled")
+	ErrNoUpgradePlan         = sdkerrors.Register(ModuleName, 12, "no upgrade is scheduled")
 )
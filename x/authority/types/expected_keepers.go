@@ -22,6 +22,7 @@ type (
 
 	UpgradeKeeper interface {
 		ApplyUpgrade(ctx sdk.Context, plan types.Plan)
+		ClearUpgradePlan(ctx sdk.Context)
 		GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool)
 		HasHandler(name string) bool
 		ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error
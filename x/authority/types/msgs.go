@@ -16,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgSetGasPrices{}
 	_ sdk.Msg = &MsgReplaceAuthority{}
 	_ sdk.Msg = &MsgScheduleUpgrade{}
+	_ sdk.Msg = &MsgCancelUpgrade{}
 	_ sdk.Msg = &MsgSetParameters{}
 )
 
@@ -29,6 +30,8 @@ func (msg MsgReplaceAuthority) Type() string { return "replace_authority" }
 
 func (msg MsgScheduleUpgrade) Type() string { return "schedule_upgrade" }
 
+func (msg MsgCancelUpgrade) Type() string { return "cancel_upgrade" }
+
 func (msg MsgSetParameters) Type() string { return "set_parameters" }
 
 func (msg MsgDestroyIssuer) ValidateBasic() error {
@@ -92,6 +95,18 @@ func (msg MsgScheduleUpgrade) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateUpgradeInfo(msg.Plan.Info); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgCancelUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
 	return nil
 }
 
@@ -151,6 +166,14 @@ func (msg MsgScheduleUpgrade) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func (msg MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (msg MsgSetParameters) GetSigners() []sdk.AccAddress {
 	from, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
@@ -179,6 +202,10 @@ func (msg MsgScheduleUpgrade) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
+func (msg MsgCancelUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
 func (msg MsgSetParameters) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
@@ -193,4 +220,6 @@ func (msg MsgReplaceAuthority) Route() string { return ModuleName }
 
 func (msg MsgScheduleUpgrade) Route() string { return ModuleName }
 
+func (msg MsgCancelUpgrade) Route() string { return ModuleName }
+
 func (msg MsgSetParameters) Route() string { return ModuleName }
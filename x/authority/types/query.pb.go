@@ -192,48 +192,191 @@ func (m *QueryUpgradePlanResponse) GetPlan() types1.Plan {
 	return types1.Plan{}
 }
 
+type QueryAppliedUpgradesRequest struct {
+}
+
+func (m *QueryAppliedUpgradesRequest) Reset()         { *m = QueryAppliedUpgradesRequest{} }
+func (m *QueryAppliedUpgradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppliedUpgradesRequest) ProtoMessage()    {}
+func (*QueryAppliedUpgradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{4}
+}
+func (m *QueryAppliedUpgradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppliedUpgradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppliedUpgradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAppliedUpgradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppliedUpgradesRequest.Merge(m, src)
+}
+func (m *QueryAppliedUpgradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppliedUpgradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppliedUpgradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppliedUpgradesRequest proto.InternalMessageInfo
+
+type QueryAppliedUpgradesResponse struct {
+	Upgrades []AppliedUpgrade `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades" yaml:"upgrades"`
+}
+
+func (m *QueryAppliedUpgradesResponse) Reset()         { *m = QueryAppliedUpgradesResponse{} }
+func (m *QueryAppliedUpgradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppliedUpgradesResponse) ProtoMessage()    {}
+func (*QueryAppliedUpgradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{5}
+}
+func (m *QueryAppliedUpgradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppliedUpgradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppliedUpgradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAppliedUpgradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppliedUpgradesResponse.Merge(m, src)
+}
+func (m *QueryAppliedUpgradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppliedUpgradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppliedUpgradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppliedUpgradesResponse proto.InternalMessageInfo
+
+func (m *QueryAppliedUpgradesResponse) GetUpgrades() []AppliedUpgrade {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
+type AppliedUpgrade struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *AppliedUpgrade) Reset()         { *m = AppliedUpgrade{} }
+func (m *AppliedUpgrade) String() string { return proto.CompactTextString(m) }
+func (*AppliedUpgrade) ProtoMessage()    {}
+func (*AppliedUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d766145e8bc7b365, []int{6}
+}
+func (m *AppliedUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppliedUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppliedUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppliedUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedUpgrade.Merge(m, src)
+}
+func (m *AppliedUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppliedUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedUpgrade proto.InternalMessageInfo
+
+func (m *AppliedUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppliedUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGasPricesRequest)(nil), "em.authority.v1.QueryGasPricesRequest")
 	proto.RegisterType((*QueryGasPricesResponse)(nil), "em.authority.v1.QueryGasPricesResponse")
 	proto.RegisterType((*QueryUpgradePlanRequest)(nil), "em.authority.v1.QueryUpgradePlanRequest")
 	proto.RegisterType((*QueryUpgradePlanResponse)(nil), "em.authority.v1.QueryUpgradePlanResponse")
+	proto.RegisterType((*QueryAppliedUpgradesRequest)(nil), "em.authority.v1.QueryAppliedUpgradesRequest")
+	proto.RegisterType((*QueryAppliedUpgradesResponse)(nil), "em.authority.v1.QueryAppliedUpgradesResponse")
+	proto.RegisterType((*AppliedUpgrade)(nil), "em.authority.v1.AppliedUpgrade")
 }
 
 func init() { proto.RegisterFile("em/authority/v1/query.proto", fileDescriptor_d766145e8bc7b365) }
 
 var fileDescriptor_d766145e8bc7b365 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x95, 0x82, 0xc4, 0x05, 0x81, 0x64, 0x28, 0x0d, 0xa1, 0xb2, 0x8b, 0x55, 0xd1, 0x02,
-	0xca, 0x9d, 0x52, 0xb6, 0x8e, 0x01, 0x04, 0x03, 0x43, 0x89, 0xc4, 0xc2, 0x12, 0x5d, 0x9c, 0x27,
-	0xf7, 0x54, 0xdf, 0x9d, 0xeb, 0x3b, 0x47, 0x78, 0x45, 0x62, 0x45, 0x95, 0x58, 0x18, 0x3b, 0xb3,
-	0xf1, 0x2d, 0x3a, 0x56, 0x62, 0x61, 0x2a, 0x28, 0xe1, 0x13, 0xf0, 0x09, 0x90, 0xed, 0x73, 0x48,
-	0x93, 0x48, 0x74, 0x4a, 0x4e, 0xbf, 0xf7, 0x7e, 0xff, 0xfc, 0xf0, 0x7d, 0x10, 0x94, 0x65, 0xe6,
-	0x40, 0xa5, 0xdc, 0xe4, 0x74, 0xd4, 0xa1, 0x47, 0x19, 0xa4, 0x39, 0x49, 0x52, 0x65, 0x94, 0x7b,
-	0x0b, 0x04, 0x99, 0x82, 0x64, 0xd4, 0x69, 0xdd, 0x89, 0x54, 0xa4, 0x4a, 0x8c, 0x16, 0xff, 0xaa,
-	0xb1, 0x96, 0x17, 0x2a, 0x2d, 0x94, 0xa6, 0x03, 0xa6, 0x81, 0x8e, 0x3a, 0x03, 0x30, 0xac, 0x43,
-	0x43, 0xc5, 0xa5, 0xc5, 0x37, 0x22, 0xa5, 0xa2, 0x18, 0x28, 0x4b, 0x38, 0x65, 0x52, 0x2a, 0xc3,
-	0x0c, 0x57, 0x52, 0x5b, 0x74, 0xcb, 0x6e, 0x67, 0x49, 0x94, 0xb2, 0xe1, 0x3f, 0x02, 0xfb, 0x5e,
-	0xd0, 0x90, 0x87, 0xd3, 0x91, 0xe2, 0x51, 0xe1, 0xc1, 0x3a, 0x5e, 0x7b, 0x53, 0x38, 0x7f, 0xc9,
-	0xf4, 0x7e, 0xca, 0x43, 0xd0, 0x3d, 0x38, 0xca, 0x40, 0x9b, 0xe0, 0x1b, 0xc2, 0x77, 0xe7, 0x11,
-	0x9d, 0x28, 0xa9, 0xc1, 0x3d, 0x46, 0xf8, 0xa6, 0xe0, 0xb2, 0x1f, 0x31, 0xdd, 0x4f, 0x4a, 0xa8,
-	0x89, 0x36, 0xaf, 0xec, 0x34, 0x76, 0x37, 0x48, 0xa5, 0x46, 0x8a, 0x44, 0xc4, 0xaa, 0x91, 0xe7,
-	0x10, 0x3e, 0x53, 0x5c, 0x76, 0x5f, 0x9f, 0x9e, 0xfb, 0xce, 0x9f, 0x73, 0x7f, 0x2d, 0x67, 0x22,
-	0xde, 0x0b, 0x2e, 0x32, 0x04, 0x5f, 0x7f, 0xfa, 0x4f, 0x22, 0x6e, 0x0e, 0xb2, 0x01, 0x09, 0x95,
-	0xa0, 0xd6, 0x76, 0xf5, 0xd3, 0xd6, 0xc3, 0x43, 0x6a, 0xf2, 0x04, 0x74, 0x4d, 0xa6, 0x7b, 0x37,
-	0x04, 0x97, 0x53, 0x6b, 0x7b, 0xab, 0x5f, 0x4e, 0x7c, 0x27, 0xb8, 0x87, 0xd7, 0x4b, 0xcb, 0x6f,
-	0xab, 0x0a, 0xf6, 0x63, 0x26, 0xeb, 0x38, 0x0c, 0x37, 0x17, 0x21, 0x9b, 0xe7, 0x05, 0x5e, 0x4d,
-	0x62, 0x26, 0x9b, 0x68, 0x13, 0xcd, 0x86, 0xa8, 0x8b, 0xac, 0x73, 0x14, 0x3b, 0xdd, 0xdb, 0x36,
-	0x44, 0xa3, 0x0a, 0x51, 0xec, 0x05, 0xbd, 0x72, 0x7d, 0xf7, 0x64, 0x05, 0x5f, 0x2d, 0x35, 0xdc,
-	0x8f, 0x08, 0x5f, 0x9f, 0x7a, 0x73, 0x1f, 0x92, 0xb9, 0x73, 0x20, 0x4b, 0x1b, 0x6f, 0x6d, 0xff,
-	0x77, 0xae, 0xf2, 0x1b, 0x6c, 0x7f, 0xf8, 0xfe, 0xfb, 0xf3, 0xca, 0x03, 0xd7, 0xa7, 0xd0, 0x16,
-	0x4a, 0x42, 0x7e, 0xf1, 0x12, 0x23, 0xa6, 0xab, 0x4e, 0xdd, 0x4f, 0x08, 0x37, 0x66, 0x02, 0xbb,
-	0x3b, 0xcb, 0x15, 0x16, 0xeb, 0x6a, 0x3d, 0xba, 0xc4, 0xa4, 0x75, 0xf3, 0xb8, 0x74, 0xb3, 0xe5,
-	0x06, 0xcb, 0xdd, 0xd8, 0x16, 0xfb, 0x45, 0x45, 0xdd, 0x57, 0xa7, 0x63, 0x0f, 0x9d, 0x8d, 0x3d,
-	0xf4, 0x6b, 0xec, 0xa1, 0xe3, 0x89, 0xe7, 0x9c, 0x4d, 0x3c, 0xe7, 0xc7, 0xc4, 0x73, 0xde, 0x91,
-	0x99, 0x6f, 0x5f, 0xf3, 0x80, 0x68, 0xc7, 0x30, 0x8c, 0x20, 0xa5, 0xef, 0x67, 0x38, 0xcb, 0x3b,
-	0x18, 0x5c, 0x2b, 0xcf, 0xf7, 0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0x9c, 0xc9, 0x98,
-	0x88, 0x03, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0xdb, 0x52, 0x51, 0x17, 0x1a, 0x61, 0x28, 0x0d, 0x69, 0xb9, 0x2b, 0xa6, 0x6a, 0x53,
+	0x20, 0xb6, 0x52, 0xb6, 0x6e, 0x04, 0x10, 0x0c, 0x0c, 0x25, 0x82, 0x85, 0x25, 0x38, 0x89, 0x75,
+	0x39, 0x35, 0x67, 0x5f, 0xcf, 0x97, 0x8a, 0xac, 0x48, 0xac, 0xa8, 0x12, 0x0b, 0x13, 0x62, 0x66,
+	0xe3, 0x4f, 0x60, 0xeb, 0x58, 0x89, 0x85, 0xa9, 0xa0, 0x96, 0xbf, 0xa0, 0x7f, 0x01, 0x3a, 0xdb,
+	0x77, 0xcd, 0x8f, 0x93, 0xe8, 0x94, 0xdc, 0xbd, 0xef, 0x7d, 0xef, 0xbd, 0xcf, 0x9f, 0x0f, 0x2e,
+	0xf3, 0x80, 0xb2, 0x7e, 0xdc, 0x95, 0x91, 0x1f, 0x0f, 0xe8, 0x7e, 0x8d, 0xee, 0xf5, 0x79, 0x34,
+	0x20, 0x61, 0x24, 0x63, 0x89, 0x8a, 0x3c, 0x20, 0x19, 0x48, 0xf6, 0x6b, 0xe5, 0x1b, 0x9e, 0xf4,
+	0xa4, 0xc6, 0x68, 0xf2, 0xcf, 0x94, 0x95, 0x9d, 0xb6, 0x54, 0x81, 0x54, 0xb4, 0xc5, 0x14, 0xa7,
+	0xfb, 0xb5, 0x16, 0x8f, 0x59, 0x8d, 0xb6, 0xa5, 0x2f, 0x2c, 0xbe, 0xe2, 0x49, 0xe9, 0xf5, 0x38,
+	0x65, 0xa1, 0x4f, 0x99, 0x10, 0x32, 0x66, 0xb1, 0x2f, 0x85, 0xb2, 0xe8, 0x9a, 0x65, 0xf7, 0x43,
+	0x2f, 0x62, 0x9d, 0xf3, 0x06, 0xf6, 0x79, 0x42, 0x43, 0xec, 0x66, 0x25, 0xc9, 0x83, 0xc1, 0xf1,
+	0x12, 0x5c, 0x7c, 0x99, 0x38, 0x7f, 0xc6, 0xd4, 0x4e, 0xe4, 0xb7, 0xb9, 0x6a, 0xf0, 0xbd, 0x3e,
+	0x57, 0x31, 0xfe, 0x0e, 0xe0, 0xcd, 0x71, 0x44, 0x85, 0x52, 0x28, 0x8e, 0x0e, 0x00, 0x5c, 0x08,
+	0x7c, 0xd1, 0xf4, 0x98, 0x6a, 0x86, 0x1a, 0x2a, 0x81, 0xd5, 0xe9, 0xca, 0xfc, 0xd6, 0x0a, 0x31,
+	0x6a, 0x24, 0x49, 0x44, 0xac, 0x1a, 0x79, 0xc2, 0xdb, 0x8f, 0xa5, 0x2f, 0xea, 0x2f, 0x0e, 0x8f,
+	0xdd, 0xc2, 0xd9, 0xb1, 0xbb, 0x38, 0x60, 0x41, 0x6f, 0x1b, 0x8f, 0x76, 0xc0, 0xdf, 0x7e, 0xbb,
+	0xf7, 0x3d, 0x3f, 0xee, 0xf6, 0x5b, 0xa4, 0x2d, 0x03, 0x6a, 0x6d, 0x9b, 0x9f, 0xaa, 0xea, 0xec,
+	0xd2, 0x78, 0x10, 0x72, 0x95, 0x36, 0x53, 0x8d, 0x2b, 0x81, 0x2f, 0x32, 0x6b, 0xdb, 0x33, 0x9f,
+	0xbf, 0xba, 0x05, 0x7c, 0x0b, 0x2e, 0x69, 0xcb, 0xaf, 0xcd, 0x08, 0x76, 0x7a, 0x4c, 0xa4, 0x71,
+	0x18, 0x2c, 0x4d, 0x42, 0x36, 0xcf, 0x53, 0x38, 0x13, 0xf6, 0x98, 0x28, 0x81, 0x55, 0x30, 0x1c,
+	0x22, 0x1d, 0x64, 0x9a, 0x23, 0xe1, 0xd4, 0xaf, 0xdb, 0x10, 0xf3, 0x26, 0x44, 0xc2, 0xc3, 0x0d,
+	0x4d, 0xc7, 0xb7, 0xe1, 0xb2, 0x96, 0x78, 0x14, 0x86, 0x3d, 0x9f, 0x77, 0xac, 0x52, 0x36, 0xd0,
+	0x18, 0xae, 0xe4, 0xc3, 0xd6, 0xc5, 0x2b, 0x78, 0xd9, 0x2a, 0xa6, 0xe3, 0x74, 0xc9, 0xd8, 0x1e,
+	0x91, 0x51, 0x6e, 0x7d, 0xc9, 0x9a, 0x29, 0x1a, 0x33, 0x29, 0x1d, 0x37, 0xb2, 0x4e, 0xf8, 0x2d,
+	0x5c, 0x18, 0x25, 0xa1, 0xbb, 0x70, 0x46, 0xb0, 0x80, 0xeb, 0xb4, 0x73, 0xf5, 0xe2, 0x79, 0x96,
+	0xe4, 0x2d, 0x6e, 0x68, 0x10, 0x6d, 0xc2, 0xd9, 0x2e, 0xf7, 0xbd, 0x6e, 0x5c, 0x9a, 0x5a, 0x05,
+	0x95, 0xe9, 0xfa, 0xb5, 0xb3, 0x63, 0xf7, 0xaa, 0x29, 0x33, 0xef, 0x71, 0xc3, 0x16, 0x6c, 0xfd,
+	0x98, 0x86, 0x97, 0x74, 0x30, 0xf4, 0x01, 0xc0, 0xb9, 0xec, 0x48, 0xd0, 0xfa, 0x84, 0xfb, 0xdc,
+	0x45, 0x2b, 0x6f, 0xfc, 0xb7, 0xce, 0x0c, 0x08, 0x6f, 0xbc, 0xff, 0xf9, 0xf7, 0xd3, 0xd4, 0x1d,
+	0xe4, 0x52, 0x5e, 0x0d, 0xa4, 0xe0, 0x83, 0xd1, 0x0b, 0xe8, 0x31, 0x65, 0x56, 0x09, 0x7d, 0x04,
+	0x70, 0x7e, 0xe8, 0x9c, 0x51, 0x25, 0x5f, 0x61, 0x72, 0x4b, 0xca, 0x9b, 0x17, 0xa8, 0xb4, 0x6e,
+	0xee, 0x69, 0x37, 0x6b, 0x08, 0xe7, 0xbb, 0xb1, 0x07, 0xd0, 0x4c, 0x36, 0x03, 0x7d, 0x01, 0xb0,
+	0x38, 0x76, 0xec, 0xe8, 0x41, 0xbe, 0x54, 0xfe, 0xf2, 0x94, 0xab, 0x17, 0xac, 0xb6, 0xe6, 0x88,
+	0x36, 0x57, 0x41, 0xeb, 0xf9, 0xe6, 0x98, 0xa1, 0x35, 0xd3, 0x2d, 0xa9, 0x3f, 0x3f, 0x3c, 0x71,
+	0xc0, 0xd1, 0x89, 0x03, 0xfe, 0x9c, 0x38, 0xe0, 0xe0, 0xd4, 0x29, 0x1c, 0x9d, 0x3a, 0x85, 0x5f,
+	0xa7, 0x4e, 0xe1, 0x0d, 0x19, 0xba, 0x93, 0x69, 0x2f, 0x1e, 0x54, 0x7b, 0xbc, 0xe3, 0xf1, 0x88,
+	0xbe, 0x1b, 0xea, 0xab, 0xef, 0x67, 0x6b, 0x56, 0x7f, 0x56, 0x1e, 0xfe, 0x1b, 0x00, 0xf4, 0x4a,
+	0x88, 0x5a, 0x20, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -250,6 +393,7 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
 	UpgradePlan(ctx context.Context, in *QueryUpgradePlanRequest, opts ...grpc.CallOption) (*QueryUpgradePlanResponse, error)
+	AppliedUpgrades(ctx context.Context, in *QueryAppliedUpgradesRequest, opts ...grpc.CallOption) (*QueryAppliedUpgradesResponse, error)
 }
 
 type queryClient struct {
@@ -278,10 +422,20 @@ func (c *queryClient) UpgradePlan(ctx context.Context, in *QueryUpgradePlanReque
 	return out, nil
 }
 
+func (c *queryClient) AppliedUpgrades(ctx context.Context, in *QueryAppliedUpgradesRequest, opts ...grpc.CallOption) (*QueryAppliedUpgradesResponse, error) {
+	out := new(QueryAppliedUpgradesResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Query/AppliedUpgrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
 	UpgradePlan(context.Context, *QueryUpgradePlanRequest) (*QueryUpgradePlanResponse, error)
+	AppliedUpgrades(context.Context, *QueryAppliedUpgradesRequest) (*QueryAppliedUpgradesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -294,6 +448,9 @@ func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *QueryGasPri
 func (*UnimplementedQueryServer) UpgradePlan(ctx context.Context, req *QueryUpgradePlanRequest) (*QueryUpgradePlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlan not implemented")
 }
+func (*UnimplementedQueryServer) AppliedUpgrades(ctx context.Context, req *QueryAppliedUpgradesRequest) (*QueryAppliedUpgradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppliedUpgrades not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -335,6 +492,24 @@ func _Query_UpgradePlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AppliedUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppliedUpgradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppliedUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Query/AppliedUpgrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppliedUpgrades(ctx, req.(*QueryAppliedUpgradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.authority.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -347,6 +522,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradePlan",
 			Handler:    _Query_UpgradePlan_Handler,
 		},
+		{
+			MethodName: "AppliedUpgrades",
+			Handler:    _Query_AppliedUpgrades_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/authority/v1/query.proto",
@@ -468,6 +647,101 @@ func (m *QueryUpgradePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAppliedUpgradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppliedUpgradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppliedUpgradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppliedUpgradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppliedUpgradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppliedUpgradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppliedUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppliedUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppliedUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -523,6 +797,46 @@ func (m *QueryUpgradePlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAppliedUpgradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAppliedUpgradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppliedUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -796,6 +1110,241 @@ func (m *QueryUpgradePlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAppliedUpgradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppliedUpgradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppliedUpgradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppliedUpgradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppliedUpgradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppliedUpgradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upgrades = append(m.Upgrades, AppliedUpgrade{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppliedUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
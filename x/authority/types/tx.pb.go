@@ -466,6 +466,8 @@ func (m *MsgReplaceAuthorityResponse) GetNewAuthorityAddress() string {
 type MsgScheduleUpgrade struct {
 	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
 	Plan      types1.Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan" yaml:"plan"`
+	// replace the currently scheduled plan instead of failing when one exists
+	Replace bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty" yaml:"replace"`
 }
 
 func (m *MsgScheduleUpgrade) Reset()         { *m = MsgScheduleUpgrade{} }
@@ -515,6 +517,13 @@ func (m *MsgScheduleUpgrade) GetPlan() types1.Plan {
 	return types1.Plan{}
 }
 
+func (m *MsgScheduleUpgrade) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
 type MsgScheduleUpgradeResponse struct {
 }
 
@@ -551,6 +560,86 @@ func (m *MsgScheduleUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgScheduleUpgradeResponse proto.InternalMessageInfo
 
+type MsgCancelUpgrade struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+}
+
+func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
+func (m *MsgCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgrade) ProtoMessage()    {}
+func (*MsgCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{11}
+}
+func (m *MsgCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgrade.Merge(m, src)
+}
+func (m *MsgCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+type MsgCancelUpgradeResponse struct {
+}
+
+func (m *MsgCancelUpgradeResponse) Reset()         { *m = MsgCancelUpgradeResponse{} }
+func (m *MsgCancelUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{12}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
+
 type MsgSetParameters struct {
 	Authority string                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
 	Changes   []proposal.ParamChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
@@ -560,7 +649,7 @@ func (m *MsgSetParameters) Reset()         { *m = MsgSetParameters{} }
 func (m *MsgSetParameters) String() string { return proto.CompactTextString(m) }
 func (*MsgSetParameters) ProtoMessage()    {}
 func (*MsgSetParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1601f633ca5d263c, []int{11}
+	return fileDescriptor_1601f633ca5d263c, []int{13}
 }
 func (m *MsgSetParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +699,7 @@ func (m *MsgSetParametersResponse) Reset()         { *m = MsgSetParametersRespon
 func (m *MsgSetParametersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetParametersResponse) ProtoMessage()    {}
 func (*MsgSetParametersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1601f633ca5d263c, []int{12}
+	return fileDescriptor_1601f633ca5d263c, []int{14}
 }
 func (m *MsgSetParametersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,6 +740,8 @@ func init() {
 	proto.RegisterType((*MsgReplaceAuthorityResponse)(nil), "em.authority.v1.MsgReplaceAuthorityResponse")
 	proto.RegisterType((*MsgScheduleUpgrade)(nil), "em.authority.v1.MsgScheduleUpgrade")
 	proto.RegisterType((*MsgScheduleUpgradeResponse)(nil), "em.authority.v1.MsgScheduleUpgradeResponse")
+	proto.RegisterType((*MsgCancelUpgrade)(nil), "em.authority.v1.MsgCancelUpgrade")
+	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "em.authority.v1.MsgCancelUpgradeResponse")
 	proto.RegisterType((*MsgSetParameters)(nil), "em.authority.v1.MsgSetParameters")
 	proto.RegisterType((*MsgSetParametersResponse)(nil), "em.authority.v1.MsgSetParametersResponse")
 }
@@ -658,56 +749,60 @@ func init() {
 func init() { proto.RegisterFile("em/authority/v1/tx.proto", fileDescriptor_1601f633ca5d263c) }
 
 var fileDescriptor_1601f633ca5d263c = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0x8e, 0x49, 0x04, 0x62, 0x49, 0x04, 0x18, 0xaa, 0xba, 0x6e, 0x9a, 0x84, 0x2d, 0x07, 0x10,
-	0xc5, 0x56, 0xe8, 0xad, 0x52, 0x0f, 0x04, 0xaa, 0xd2, 0x43, 0x24, 0x64, 0xda, 0x0b, 0x52, 0x8b,
-	0x36, 0xf6, 0xd4, 0xb1, 0x1a, 0xff, 0xd4, 0xeb, 0x00, 0x79, 0x80, 0x4a, 0x55, 0x2f, 0xed, 0x73,
-	0xf4, 0x49, 0xb8, 0x54, 0x42, 0xea, 0xa5, 0xa7, 0xb4, 0x82, 0x37, 0xc8, 0x13, 0x54, 0xf6, 0xae,
-	0x1d, 0x3b, 0xb8, 0x02, 0xe5, 0xd0, 0x13, 0xde, 0x9d, 0xef, 0x9b, 0xfd, 0x76, 0x66, 0xf6, 0x23,
-	0x48, 0x02, 0x5b, 0x25, 0xfd, 0xa0, 0xeb, 0xfa, 0x56, 0x30, 0x50, 0x4f, 0x9b, 0x6a, 0x70, 0xae,
-	0x78, 0xbe, 0x1b, 0xb8, 0xe2, 0x22, 0xd8, 0x4a, 0x12, 0x51, 0x4e, 0x9b, 0xf2, 0xaa, 0xe9, 0x9a,
-	0x6e, 0x14, 0x53, 0xc3, 0x2f, 0x06, 0x93, 0x6b, 0xba, 0x4b, 0x6d, 0x97, 0xaa, 0x1d, 0x42, 0x41,
-	0x3d, 0x6d, 0x76, 0x20, 0x20, 0x4d, 0x55, 0x77, 0x2d, 0x87, 0xc7, 0xd7, 0x79, 0xbc, 0xef, 0x99,
-	0x3e, 0x31, 0xc6, 0x10, 0xbe, 0xe6, 0x28, 0xcc, 0x51, 0x1e, 0xf1, 0x89, 0x4d, 0x13, 0x10, 0x5b,
-	0x32, 0x0c, 0xfe, 0x29, 0xa0, 0xc5, 0x36, 0x35, 0xf7, 0x7c, 0x20, 0x01, 0xbc, 0xa2, 0xb4, 0x0f,
-	0xbe, 0xb8, 0x83, 0xe6, 0x13, 0x8d, 0x92, 0xd0, 0x10, 0x36, 0xe6, 0x5b, 0xab, 0xa3, 0x61, 0x7d,
-	0x69, 0x40, 0xec, 0xde, 0x33, 0x9c, 0x84, 0xb0, 0x36, 0x86, 0x89, 0x9b, 0x68, 0xd6, 0x8a, 0xd8,
-	0xd2, 0x4c, 0x44, 0x58, 0x1e, 0x0d, 0xeb, 0x15, 0x46, 0x60, 0xfb, 0x58, 0xe3, 0x00, 0x91, 0xa0,
-	0x8a, 0x01, 0x8e, 0x6b, 0x5b, 0x0e, 0x09, 0x2c, 0xd7, 0xa1, 0x52, 0xb1, 0x51, 0xdc, 0x58, 0xd8,
-	0x79, 0xa4, 0x4c, 0xd4, 0x46, 0xd9, 0x4f, 0xa1, 0x5a, 0xd5, 0x8b, 0x61, 0xbd, 0x30, 0x1a, 0xd6,
-	0x57, 0x59, 0xd2, 0x4c, 0x06, 0xac, 0x65, 0x33, 0xe2, 0x77, 0xa8, 0x9c, 0x26, 0x8b, 0x22, 0x2a,
-	0x85, 0xa5, 0x64, 0x97, 0xd1, 0xa2, 0x6f, 0x51, 0x42, 0x73, 0x86, 0x45, 0xbd, 0x1e, 0x19, 0x30,
-	0xc9, 0x5a, 0xbc, 0x14, 0x1b, 0x68, 0xc1, 0x00, 0xaa, 0xfb, 0x96, 0x17, 0x92, 0xa5, 0x62, 0x14,
-	0x4d, 0x6f, 0xe1, 0x07, 0xe8, 0xfe, 0x44, 0xd1, 0x34, 0xa0, 0x9e, 0xeb, 0x50, 0xc0, 0x1f, 0xd1,
-	0x52, 0x9b, 0x9a, 0xfb, 0x40, 0x03, 0xdf, 0x1d, 0xfc, 0x97, 0x82, 0x62, 0x19, 0x49, 0x93, 0x47,
-	0x26, 0x72, 0x7e, 0xb0, 0xfe, 0x1e, 0x41, 0xf0, 0x92, 0xd0, 0x43, 0xdf, 0xd2, 0x81, 0x4e, 0x25,
-	0xe7, 0x93, 0x80, 0x90, 0x49, 0xe8, 0x89, 0x17, 0xa5, 0x90, 0x66, 0xa2, 0x96, 0x55, 0x15, 0x36,
-	0x61, 0x4a, 0x58, 0x50, 0x85, 0xcf, 0x97, 0xb2, 0x0f, 0xfa, 0x9e, 0x6b, 0x39, 0xad, 0x03, 0xde,
-	0xb1, 0x65, 0x96, 0x77, 0xcc, 0xc6, 0xdf, 0x7f, 0xd7, 0xb7, 0x4c, 0x2b, 0xe8, 0xf6, 0x3b, 0x8a,
-	0xee, 0xda, 0x2a, 0x1f, 0x53, 0xf6, 0x67, 0x9b, 0x1a, 0x1f, 0xd4, 0x60, 0xe0, 0x01, 0x8d, 0x13,
-	0x51, 0x6d, 0xde, 0x8c, 0xb5, 0xf3, 0xca, 0xa7, 0xaf, 0x93, 0x5c, 0xf5, 0xb3, 0x80, 0x56, 0xda,
-	0xd4, 0xd4, 0xc0, 0xeb, 0x11, 0x1d, 0x76, 0x13, 0xe9, 0xd3, 0x5c, 0xf7, 0x39, 0xaa, 0x38, 0x70,
-	0x76, 0x32, 0xe6, 0xb1, 0x26, 0x48, 0xe3, 0x01, 0xcc, 0x84, 0xb1, 0x56, 0x76, 0xe0, 0x2c, 0x39,
-	0x12, 0x53, 0xf4, 0x30, 0x47, 0x49, 0xac, 0x54, 0x7c, 0x8d, 0xee, 0x65, 0xe8, 0x27, 0xc4, 0x30,
-	0x7c, 0xa0, 0x94, 0xab, 0x6b, 0x8c, 0x86, 0xf5, 0x6a, 0xce, 0x29, 0x31, 0x0c, 0x6b, 0x2b, 0xe9,
-	0xd3, 0x76, 0xf9, 0xee, 0x57, 0x01, 0x89, 0x61, 0x6d, 0xf4, 0x2e, 0x18, 0xfd, 0x1e, 0xbc, 0x61,
-	0x5e, 0x30, 0xd5, 0xf5, 0x5f, 0xa0, 0x92, 0xd7, 0x23, 0x4e, 0x74, 0xeb, 0x54, 0x9b, 0x63, 0x7b,
-	0x89, 0x3b, 0x7d, 0xd8, 0x23, 0x4e, 0x6b, 0x85, 0xb7, 0x79, 0x81, 0x25, 0x0c, 0x79, 0x58, 0x8b,
-	0xe8, 0xb8, 0x8a, 0xe4, 0x9b, 0x82, 0x92, 0x7e, 0x7d, 0x11, 0xa2, 0xa7, 0x72, 0x04, 0xc1, 0x61,
-	0xe8, 0x48, 0x10, 0x80, 0x3f, 0xdd, 0x6c, 0xb6, 0xd0, 0x9c, 0xde, 0x25, 0x8e, 0x99, 0xcc, 0x25,
-	0x8e, 0x05, 0x73, 0xab, 0x4b, 0xf4, 0x86, 0xcb, 0xbd, 0x08, 0xda, 0x2a, 0x85, 0xb2, 0xb5, 0x98,
-	0xc8, 0xdf, 0x50, 0x46, 0x4b, 0x2c, 0x74, 0xe7, 0xa2, 0x84, 0x8a, 0x6d, 0x6a, 0x8a, 0xc7, 0xa8,
-	0x9c, 0xf1, 0xc9, 0xc6, 0x0d, 0xc7, 0x9a, 0x30, 0x05, 0x79, 0xe3, 0x36, 0x44, 0x32, 0x12, 0x6f,
-	0x51, 0x25, 0xeb, 0x19, 0x6b, 0x79, 0xd4, 0x0c, 0x44, 0xde, 0xbc, 0x15, 0x92, 0xa4, 0x3f, 0x46,
-	0xe5, 0x8c, 0x05, 0xe4, 0x4a, 0x4f, 0x23, 0xf2, 0xa5, 0xe7, 0xbd, 0x3b, 0xf1, 0x3d, 0x5a, 0xba,
-	0xf1, 0xe6, 0xd6, 0xf3, 0xd8, 0x93, 0x28, 0xf9, 0xc9, 0x5d, 0x50, 0xc9, 0x39, 0x3a, 0x5a, 0x9c,
-	0x9c, 0xed, 0xc7, 0xb9, 0x22, 0xb3, 0x20, 0x79, 0xeb, 0x0e, 0xa0, 0x74, 0x1f, 0xb2, 0x03, 0xb9,
-	0xf6, 0x8f, 0x3a, 0x8c, 0x21, 0xf9, 0x7d, 0xc8, 0x1d, 0xa5, 0xd6, 0xc1, 0xc5, 0x55, 0x4d, 0xb8,
-	0xbc, 0xaa, 0x09, 0x7f, 0xae, 0x6a, 0xc2, 0xb7, 0xeb, 0x5a, 0xe1, 0xf2, 0xba, 0x56, 0xf8, 0x75,
-	0x5d, 0x2b, 0x1c, 0x2b, 0x29, 0x43, 0x84, 0x6d, 0xdb, 0x75, 0x60, 0xa0, 0x82, 0xbd, 0xdd, 0x03,
-	0xc3, 0x04, 0x5f, 0x3d, 0x4f, 0xfd, 0x9e, 0x88, 0xcc, 0xb1, 0x33, 0x1b, 0xfd, 0xff, 0x7e, 0xfa,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xb4, 0x26, 0x2c, 0x6c, 0x08, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0x6f, 0xca, 0x2d, 0x9d, 0x26, 0xb4, 0xd7, 0x2d, 0xc2, 0x98, 0x90, 0xe4, 0x0e, 0x77,
+	0x91, 0xe8, 0xb6, 0xb6, 0x52, 0x76, 0x48, 0x2c, 0xea, 0x14, 0x28, 0x8b, 0x48, 0x95, 0x0b, 0x9b,
+	0x4a, 0x50, 0x4d, 0xec, 0x83, 0x63, 0xe1, 0x3f, 0x3c, 0x4e, 0xdb, 0x3c, 0x00, 0x12, 0x62, 0xc5,
+	0x5b, 0x20, 0xf1, 0x0e, 0xec, 0xbb, 0x41, 0xaa, 0xc4, 0x86, 0x55, 0x40, 0xed, 0x1b, 0xe4, 0x09,
+	0x90, 0x3d, 0xe3, 0x89, 0x9d, 0x06, 0xa5, 0x0a, 0xd2, 0x5d, 0x25, 0xe3, 0xf3, 0x7d, 0xe7, 0x7c,
+	0xe7, 0x67, 0x8e, 0x8d, 0x14, 0xf0, 0x75, 0x32, 0x4e, 0x46, 0x61, 0xec, 0x26, 0x13, 0xfd, 0xaa,
+	0xa7, 0x27, 0x37, 0x5a, 0x14, 0x87, 0x49, 0x28, 0xef, 0x80, 0xaf, 0x09, 0x8b, 0x76, 0xd5, 0x53,
+	0xf7, 0x9d, 0xd0, 0x09, 0x33, 0x9b, 0x9e, 0xfe, 0x63, 0x30, 0xb5, 0x69, 0x85, 0xd4, 0x0f, 0xa9,
+	0x3e, 0x24, 0x14, 0xf4, 0xab, 0xde, 0x10, 0x12, 0xd2, 0xd3, 0xad, 0xd0, 0x0d, 0xb8, 0xfd, 0x15,
+	0xb7, 0x8f, 0x23, 0x27, 0x26, 0xf6, 0x1c, 0xc2, 0xcf, 0x1c, 0x85, 0x39, 0x2a, 0x22, 0x31, 0xf1,
+	0xa9, 0x00, 0xb1, 0x23, 0xc3, 0xe0, 0x3f, 0x25, 0xb4, 0x33, 0xa0, 0x4e, 0x3f, 0x06, 0x92, 0xc0,
+	0x97, 0x94, 0x8e, 0x21, 0x96, 0x8f, 0xd0, 0x96, 0xd0, 0xa8, 0x48, 0x6d, 0xa9, 0xb3, 0x65, 0xec,
+	0xcf, 0xa6, 0xad, 0xdd, 0x09, 0xf1, 0xbd, 0x4f, 0xb0, 0x30, 0x61, 0x73, 0x0e, 0x93, 0xbb, 0xe8,
+	0xb9, 0x9b, 0xb1, 0x95, 0x67, 0x19, 0xe1, 0xc5, 0x6c, 0xda, 0xaa, 0x33, 0x02, 0x7b, 0x8e, 0x4d,
+	0x0e, 0x90, 0x09, 0xaa, 0xdb, 0x10, 0x84, 0xbe, 0x1b, 0x90, 0xc4, 0x0d, 0x03, 0xaa, 0x54, 0xdb,
+	0xd5, 0xce, 0xf6, 0xd1, 0x87, 0xda, 0x42, 0x6d, 0xb4, 0x93, 0x02, 0xca, 0x68, 0xdc, 0x4e, 0x5b,
+	0x95, 0xd9, 0xb4, 0xb5, 0xcf, 0x9c, 0x96, 0x3c, 0x60, 0xb3, 0xec, 0x11, 0x7f, 0x8b, 0x6a, 0x45,
+	0xb2, 0x2c, 0xa3, 0x8d, 0xb4, 0x94, 0x2c, 0x19, 0x33, 0xfb, 0x2f, 0x2b, 0x68, 0xd3, 0x76, 0x69,
+	0xe4, 0x91, 0x09, 0x93, 0x6c, 0xe6, 0x47, 0xb9, 0x8d, 0xb6, 0x6d, 0xa0, 0x56, 0xec, 0x46, 0x29,
+	0x59, 0xa9, 0x66, 0xd6, 0xe2, 0x23, 0xfc, 0x3e, 0x7a, 0x6f, 0xa1, 0x68, 0x26, 0xd0, 0x28, 0x0c,
+	0x28, 0xe0, 0x1f, 0xd0, 0xee, 0x80, 0x3a, 0x27, 0x40, 0x93, 0x38, 0x9c, 0xbc, 0x91, 0x82, 0x62,
+	0x15, 0x29, 0x8b, 0x21, 0x85, 0x9c, 0x3f, 0x58, 0x7f, 0xcf, 0x21, 0xf9, 0x82, 0xd0, 0xb3, 0xd8,
+	0xb5, 0x80, 0xae, 0x25, 0xe7, 0x47, 0x09, 0x21, 0x87, 0xd0, 0xcb, 0x28, 0x73, 0xa1, 0x3c, 0xcb,
+	0x5a, 0xd6, 0xd0, 0xd8, 0x84, 0x69, 0x69, 0x41, 0x35, 0x3e, 0x5f, 0xda, 0x09, 0x58, 0xfd, 0xd0,
+	0x0d, 0x8c, 0x53, 0xde, 0xb1, 0x17, 0xcc, 0xef, 0x9c, 0x8d, 0x7f, 0xfb, 0xbb, 0xf5, 0xda, 0x71,
+	0x93, 0xd1, 0x78, 0xa8, 0x59, 0xa1, 0xaf, 0xf3, 0x31, 0x65, 0x3f, 0x87, 0xd4, 0xfe, 0x5e, 0x4f,
+	0x26, 0x11, 0xd0, 0xdc, 0x11, 0x35, 0xb7, 0x9c, 0x5c, 0x3b, 0xaf, 0x7c, 0x31, 0x1d, 0x91, 0xea,
+	0x4f, 0x12, 0xda, 0x1b, 0x50, 0xc7, 0x84, 0xc8, 0x23, 0x16, 0x1c, 0x0b, 0xe9, 0xeb, 0xa4, 0xfb,
+	0x29, 0xaa, 0x07, 0x70, 0x7d, 0x39, 0xe7, 0xb1, 0x26, 0x28, 0xf3, 0x01, 0x2c, 0x99, 0xb1, 0x59,
+	0x0b, 0xe0, 0x5a, 0x84, 0xc4, 0x14, 0x7d, 0xb0, 0x44, 0x49, 0xae, 0x54, 0xfe, 0x0a, 0xbd, 0x5b,
+	0xa2, 0x5f, 0x12, 0xdb, 0x8e, 0x81, 0x52, 0xae, 0xae, 0x3d, 0x9b, 0xb6, 0x1a, 0x4b, 0xa2, 0xe4,
+	0x30, 0x6c, 0xee, 0x15, 0xa3, 0x1d, 0xf3, 0xa7, 0xbf, 0x4b, 0x48, 0x4e, 0x6b, 0x63, 0x8d, 0xc0,
+	0x1e, 0x7b, 0xf0, 0x35, 0xdb, 0x05, 0x6b, 0xa5, 0xff, 0x19, 0xda, 0x88, 0x3c, 0x12, 0x64, 0x59,
+	0x17, 0xda, 0x9c, 0xaf, 0x97, 0xbc, 0xd3, 0x67, 0x1e, 0x09, 0x8c, 0x3d, 0xde, 0xe6, 0x6d, 0xe6,
+	0x30, 0xe5, 0x61, 0x33, 0xa3, 0xcb, 0x07, 0x68, 0x33, 0x66, 0x35, 0xc8, 0x2e, 0xd1, 0xdb, 0x86,
+	0x3c, 0x9b, 0xb6, 0xde, 0x61, 0x38, 0x6e, 0xc0, 0x66, 0x0e, 0xc1, 0x0d, 0xa4, 0x3e, 0x96, 0x2f,
+	0xba, 0xfb, 0x79, 0x76, 0xaf, 0xfa, 0x24, 0xb0, 0xc0, 0xfb, 0x1f, 0xa9, 0xf1, 0xcb, 0x52, 0xf2,
+	0x23, 0x62, 0xfc, 0x2c, 0x65, 0x41, 0xce, 0x21, 0x39, 0x4b, 0x77, 0x24, 0x24, 0x10, 0xaf, 0x77,
+	0x5b, 0x0c, 0xb4, 0x69, 0x8d, 0x48, 0xe0, 0x88, 0x9b, 0x82, 0xf3, 0x12, 0xf2, 0xe5, 0x2b, 0x2a,
+	0x98, 0x1e, 0xfb, 0x19, 0xd4, 0xd8, 0x48, 0x0b, 0x69, 0xe6, 0x44, 0x2e, 0xb4, 0xa4, 0x25, 0x17,
+	0x7a, 0xf4, 0xeb, 0x5b, 0xa8, 0x3a, 0xa0, 0x8e, 0x7c, 0x81, 0x6a, 0xa5, 0xcd, 0xdd, 0x7e, 0xb4,
+	0x43, 0x17, 0xd6, 0x94, 0xda, 0x59, 0x85, 0x10, 0x43, 0xfa, 0x0d, 0xaa, 0x97, 0xb7, 0xd8, 0xcb,
+	0x65, 0xd4, 0x12, 0x44, 0xed, 0xae, 0x84, 0x08, 0xf7, 0x17, 0xa8, 0x56, 0x5a, 0x4a, 0x4b, 0xa5,
+	0x17, 0x11, 0x6a, 0x67, 0x15, 0x42, 0xf8, 0xfe, 0x0e, 0xed, 0x3e, 0xda, 0x02, 0xaf, 0x96, 0xb1,
+	0x17, 0x51, 0xea, 0xc1, 0x53, 0x50, 0x22, 0x8e, 0x85, 0x76, 0x16, 0x6f, 0xdb, 0x47, 0x4b, 0x45,
+	0x96, 0x41, 0xea, 0xeb, 0x27, 0x80, 0x8a, 0x7d, 0x28, 0x4f, 0xfd, 0xd2, 0x3e, 0x94, 0x20, 0x6a,
+	0x77, 0x25, 0xa4, 0xe8, 0xbe, 0x3c, 0xef, 0x2f, 0xff, 0xa3, 0xcc, 0x73, 0x88, 0xda, 0x5d, 0x09,
+	0xc9, 0xdd, 0x1b, 0xa7, 0xb7, 0xf7, 0x4d, 0xe9, 0xee, 0xbe, 0x29, 0xfd, 0x73, 0xdf, 0x94, 0x7e,
+	0x79, 0x68, 0x56, 0xee, 0x1e, 0x9a, 0x95, 0xbf, 0x1e, 0x9a, 0x95, 0x0b, 0xad, 0xf0, 0x06, 0x80,
+	0x43, 0x3f, 0x0c, 0x60, 0xa2, 0x83, 0x7f, 0xe8, 0x81, 0xed, 0x40, 0xac, 0xdf, 0x14, 0x3e, 0xa0,
+	0xb2, 0xb7, 0xc1, 0xf0, 0x79, 0xf6, 0xc1, 0xf2, 0xf1, 0xbf, 0x03, 0x00, 0xb7, 0x67, 0x4f, 0x4f,
+	0x5d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -727,6 +822,7 @@ type MsgClient interface {
 	SetGasPrices(ctx context.Context, in *MsgSetGasPrices, opts ...grpc.CallOption) (*MsgSetGasPricesResponse, error)
 	ReplaceAuthority(ctx context.Context, in *MsgReplaceAuthority, opts ...grpc.CallOption) (*MsgReplaceAuthorityResponse, error)
 	ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error)
+	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
 	SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error)
 }
 
@@ -783,6 +879,15 @@ func (c *msgClient) ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade,
 	return out, nil
 }
 
+func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error) {
+	out := new(MsgCancelUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/CancelUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error) {
 	out := new(MsgSetParametersResponse)
 	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetParameters", in, out, opts...)
@@ -799,6 +904,7 @@ type MsgServer interface {
 	SetGasPrices(context.Context, *MsgSetGasPrices) (*MsgSetGasPricesResponse, error)
 	ReplaceAuthority(context.Context, *MsgReplaceAuthority) (*MsgReplaceAuthorityResponse, error)
 	ScheduleUpgrade(context.Context, *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error)
+	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
 	SetParameters(context.Context, *MsgSetParameters) (*MsgSetParametersResponse, error)
 }
 
@@ -821,6 +927,9 @@ func (*UnimplementedMsgServer) ReplaceAuthority(ctx context.Context, req *MsgRep
 func (*UnimplementedMsgServer) ScheduleUpgrade(ctx context.Context, req *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
 func (*UnimplementedMsgServer) SetParameters(ctx context.Context, req *MsgSetParameters) (*MsgSetParametersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetParameters not implemented")
 }
@@ -919,6 +1028,24 @@ func _Msg_ScheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/CancelUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpgrade(ctx, req.(*MsgCancelUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetParameters)
 	if err := dec(in); err != nil {
@@ -961,6 +1088,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScheduleUpgrade",
 			Handler:    _Msg_ScheduleUpgrade_Handler,
 		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _Msg_CancelUpgrade_Handler,
+		},
 		{
 			MethodName: "SetParameters",
 			Handler:    _Msg_SetParameters_Handler,
@@ -1302,6 +1433,16 @@ func (m *MsgScheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1345,6 +1486,59 @@ func (m *MsgScheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSetParameters) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1572,6 +1766,9 @@ func (m *MsgScheduleUpgrade) Size() (n int) {
 	}
 	l = m.Plan.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Replace {
+		n += 2
+	}
 	return n
 }
 
@@ -1584,6 +1781,28 @@ func (m *MsgScheduleUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSetParameters) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2582,6 +2801,26 @@ func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2653,6 +2892,138 @@ func (m *MsgScheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,96 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// checksumLengths are the hex digest lengths of the checksum algorithms accepted for binary downloads.
+var checksumLengths = map[string]int{
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// platformRegex matches the os/arch keys of the binaries, i.e. linux/amd64, or any for a platform independent binary.
+var platformRegex = regexp.MustCompile(`^(any|[a-z0-9]+/[a-z0-9]+)$`)
+
+// UpgradeInfo is the plan info read by cosmovisor to download the upgraded binaries.
+type UpgradeInfo struct {
+	Binaries map[string]string `json:"binaries"`
+}
+
+// ValidateUpgradeInfo checks that the plan info, when set, is JSON listing a download URL with a checksum for every
+// platform, i.e. {"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=sha256:<hex digest>"}}.
+func ValidateUpgradeInfo(info string) error {
+	if info == "" {
+		return nil
+	}
+
+	var upgradeInfo UpgradeInfo
+	decoder := json.NewDecoder(strings.NewReader(info))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&upgradeInfo); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidUpgradeInfo, "info is not valid json: %v", err)
+	}
+
+	if len(upgradeInfo.Binaries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeInfo, "no binaries listed")
+	}
+
+	for platform, binary := range upgradeInfo.Binaries {
+		if !platformRegex.MatchString(platform) {
+			return sdkerrors.Wrapf(ErrInvalidUpgradeInfo, "invalid platform %q", platform)
+		}
+
+		if err := validateBinaryURL(binary); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidUpgradeInfo, "binary for %v: %v", platform, err)
+		}
+	}
+
+	return nil
+}
+
+func validateBinaryURL(binary string) error {
+	u, err := url.Parse(binary)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("url scheme must be http or https: %v", binary)
+	}
+
+	if u.Host == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("url has no host: %v", binary)
+	}
+
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("url has no checksum: %v", binary)
+	}
+
+	algo, digest, found := strings.Cut(checksum, ":")
+	if !found {
+		return sdkerrors.ErrInvalidRequest.Wrapf("checksum must be of the form <algorithm>:<digest>: %v", checksum)
+	}
+
+	length, supported := checksumLengths[algo]
+	if !supported {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unsupported checksum algorithm %q", algo)
+	}
+
+	if _, err := hex.DecodeString(digest); err != nil || len(digest) != length {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid %v digest %q", algo, digest)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUpgradeInfo(t *testing.T) {
+	const digest = "cadd5b52fe90a04e20b2cbb93291b0d1d0204f17b64b2215eb09f5dc78a127f1"
+
+	specs := map[string]struct {
+		info  string
+		valid bool
+	}{
+		"empty": {
+			info:  "",
+			valid: true,
+		},
+		"single binary": {
+			info:  `{"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=sha256:` + digest + `"}}`,
+			valid: true,
+		},
+		"several binaries": {
+			info: `{"binaries":{
+				"linux/amd64":"http://localhost:8765/emd.zip?checksum=sha256:` + digest + `",
+				"darwin/arm64":"https://example.com/emd.zip?checksum=sha512:` + digest + digest + `"}}`,
+			valid: true,
+		},
+		"any platform": {
+			info:  `{"binaries":{"any":"https://example.com/emd.zip?checksum=sha256:` + digest + `"}}`,
+			valid: true,
+		},
+		"not json": {
+			info: "some text here",
+		},
+		"unknown field": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=sha256:` + digest + `"},"other":1}`,
+		},
+		"no binaries": {
+			info: `{"binaries":{}}`,
+		},
+		"invalid platform": {
+			info: `{"binaries":{"linux":"https://example.com/emd.zip?checksum=sha256:` + digest + `"}}`,
+		},
+		"missing checksum": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/emd.zip"}}`,
+		},
+		"unsupported checksum": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=md5:d41d8cd98f00b204e9800998ecf8427e"}}`,
+		},
+		"short digest": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=sha256:cadd5b52"}}`,
+		},
+		"checksum without algorithm": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/emd.zip?checksum=` + digest + `"}}`,
+		},
+		"unsupported scheme": {
+			info: `{"binaries":{"linux/amd64":"ftp://example.com/emd.zip?checksum=sha256:` + digest + `"}}`,
+		},
+		"missing host": {
+			info: `{"binaries":{"linux/amd64":"https:///emd.zip?checksum=sha256:` + digest + `"}}`,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateUpgradeInfo(spec.info)
+			if spec.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, ErrInvalidUpgradeInfo.Is(err), err)
+		})
+	}
+}
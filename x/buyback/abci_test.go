@@ -198,6 +198,8 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, *market.Kee
 	ms.MountStoreWithDB(keyIndices, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(buybackKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -215,7 +217,7 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, *market.Kee
 	initialSupply := coins(fmt.Sprintf("1000000eur,1000000usd,1000000chf,1000000jpy,1000000gbp,1000000%v,500000000pesos", stakingDenom))
 	mintBalance(t, ctx, bk, initialSupply)
 
-	marketKeeper := market.NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, ak, bk)
+	marketKeeper := market.NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, nil, pk.Subspace(markettypes.ModuleName), ak, bk, nil, nil, 0)
 
 	k := NewKeeper(encConfig.Marshaler, buybackKey, marketKeeper, ak, mockStakingKeeper{}, bk)
 	k.SetUpdateInterval(ctx, time.Hour)
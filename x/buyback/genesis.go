@@ -1,6 +1,7 @@
 package buyback
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +21,20 @@ func defaultGenesisState() *types.GenesisState {
 	}
 }
 
+// ValidateGenesis checks that the buyback interval is a positive duration.
+func ValidateGenesis(state types.GenesisState) error {
+	interval, err := time.ParseDuration(state.Interval)
+	if err != nil {
+		return fmt.Errorf("invalid buyback interval: %w", err)
+	}
+
+	if interval <= 0 {
+		return fmt.Errorf("buyback interval must be positive: %v", state.Interval)
+	}
+
+	return nil
+}
+
 func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) error {
 	updateInterval, err := time.ParseDuration(state.Interval)
 	if err != nil {
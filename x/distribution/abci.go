@@ -32,12 +32,17 @@ type DistributionKeeper interface {
 	)
 }
 
+// RewardsKeeper determines the share of the block rewards a validator is eligible for, e.g. when penalised for downtime.
+type RewardsKeeper interface {
+	RewardFraction(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.Dec
+}
+
 // Adapted from cosmos-sdk/x/distribution/abci.go
 // A custom version was needed to keep the address of the previousProposer out of the consensus-state.
 
 // set the proposer for determining distribution during endblock
 // and distribute rewards for the previous block
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k DistributionKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) {
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k DistributionKeeper, rk RewardsKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) {
 	defer telemetry.ModuleMeasureSince(ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	batch := apptypes.GetCurrentBatch(ctx)
@@ -67,9 +72,26 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k DistributionKee
 
 		// Only call AllocateTokens if there are in fact tokens to allocate.
 		if !coins.IsZero() {
-			k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
+			votes := adjustVotingPowers(ctx, rk, req.LastCommitInfo.GetVotes())
+			k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, votes)
 		}
 	}
 
 	batch.Set(previousProposerKey, req.Header.ProposerAddress)
 }
+
+// adjustVotingPowers scales the power of each vote by the reward fraction of its validator. As the total powers are left
+// untouched, the rewards withheld from penalised validators are allocated to the community pool.
+func adjustVotingPowers(ctx sdk.Context, rk RewardsKeeper, votes []abci.VoteInfo) []abci.VoteInfo {
+	adjusted := make([]abci.VoteInfo, len(votes))
+	for i, vote := range votes {
+		adjusted[i] = vote
+
+		fraction := rk.RewardFraction(ctx, vote.Validator.Address)
+		if fraction.LT(sdk.OneDec()) {
+			adjusted[i].Validator.Power = fraction.MulInt64(vote.Validator.Power).TruncateInt64()
+		}
+	}
+
+	return adjusted
+}
@@ -28,15 +28,17 @@ type AppModuleBasic struct {
 type AppModule struct {
 	distr.AppModule
 	k  DistributionKeeper
+	rk RewardsKeeper
 	ak AccountKeeper
 	bk bankkeeper.ViewKeeper
 	db db.DB
 }
 
-func NewAppModule(nested distr.AppModule, k DistributionKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) AppModule {
+func NewAppModule(nested distr.AppModule, k DistributionKeeper, rk RewardsKeeper, ak AccountKeeper, bk bankkeeper.ViewKeeper, db db.DB) AppModule {
 	return AppModule{
 		AppModule: nested,
 		k:         k,
+		rk:        rk,
 		ak:        ak,
 		bk:        bk,
 		db:        db,
@@ -44,7 +46,7 @@ func NewAppModule(nested distr.AppModule, k DistributionKeeper, ak AccountKeeper
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, req, am.k, am.ak, am.bk, am.db)
+	BeginBlocker(ctx, req, am.k, am.rk, am.ak, am.bk, am.db)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the distribution module.
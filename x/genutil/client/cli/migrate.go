@@ -3,8 +3,6 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
-	"sort"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -14,54 +12,38 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
-	v09 "github.com/e-money/em-ledger/x/genutil/legacy/v090"
 )
 
 const (
 	flagGenesisTime        = "genesis-time"
 	flagNoConsensusUpgrade = "no-consensus-upgrade"
+	flagFromVersion        = "from-version"
+	flagDryRun             = "dry-run"
+	flagChecksums          = "checksums"
 )
 
-// Allow applications to extend and modify the migration process.
-//
-// Ref: https://github.com/cosmos/cosmos-sdk/issues/5041
-var migrationMap = types.MigrationMap{
-	"v0.9": v09.Migrate,
-}
-
-// GetMigrationCallback returns a MigrationCallback for a given version.
-func GetMigrationCallback(version string) types.MigrationCallback {
-	return migrationMap[version]
-}
-
-// GetMigrationVersions get all migration version in a sorted slice.
-func GetMigrationVersions() []string {
-	versions := make([]string, len(migrationMap))
-
-	var i int
-
-	for version := range migrationMap {
-		versions[i] = version
-		i++
-	}
-
-	sort.Strings(versions)
-
-	return versions
-}
-
 // MigrateGenesisCmd returns a command to execute genesis state migration.
-func MigrateGenesisCmd() *cobra.Command {
+func MigrateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate [target-version] [genesis-file]",
 		Short: "Migrate genesis to a specified target version",
 		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
 
+All migrations between the source version given by --%s and the target version are applied in order. A
+target like v1.x migrates to the latest version with that prefix. The migrated state of every module is validated.
+Without --%s, a target naming a source version, i.e. v0.9, migrates from that version to the next.
+
+With --%s only the modules changed by the migration are reported, together with the SHA-256 checksums of their
+migrated state. Pass these checksums in a JSON file with --%s to make sure that the migration produces the same
+state as elsewhere, i.e. {"bank":"<checksum>"}.
+
 Example:
-$ %s migrate v0.9 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=2019-04-22T17:00:00Z
-`, version.AppName),
+$ %s migrate v1.x /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=2019-04-22T17:00:00Z
+$ %s migrate v1.x /path/to/genesis.json --dry-run
+`, flagFromVersion, flagFromVersion, flagDryRun, flagChecksums, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -71,6 +53,17 @@ $ %s migrate v0.9 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=20
 			target := args[0]
 			importGenesis := args[1]
 
+			source, _ := cmd.Flags().GetString(flagFromVersion)
+			if to, ok := legacyMigrationTarget(target); ok && !cmd.Flags().Changed(flagFromVersion) {
+				// The target used to name the version migrated from
+				source, target = target, to
+			}
+
+			path, err := MigrationPath(source, target)
+			if err != nil {
+				return err
+			}
+
 			genDoc, err := validateGenDoc(importGenesis)
 			if err != nil {
 				return err
@@ -81,20 +74,16 @@ $ %s migrate v0.9 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=20
 				return errors.Wrap(err, "failed to JSON unmarshal initial genesis state")
 			}
 
-			migrationFunc := GetMigrationCallback(target)
-			if migrationFunc == nil {
-				migrations := make([]string, len(migrationMap))
-				for k := range migrationMap {
-					migrations = append(migrations, k)
+			newGenState := copyAppMap(initialState)
+			// Only the state produced by the last hop is validated, as intermediate states are in the format of an
+			// earlier version that the modules of this one need not accept
+			for _, m := range path {
+				newGenState, err = m.Migrate(newGenState, clientCtx)
+				if err != nil {
+					return errors.Wrapf(err, "failed to migrate genesis state from %s to %s", m.From, m.To)
 				}
-
-				avail := fmt.Sprintf("Available migrations:%v", strings.Join(migrations, " "))
-				return fmt.Errorf("unknown migration function for version: %s\n%v", target, avail)
 			}
 
-			// TODO: handler error from migrationFunc call
-			newGenState := migrationFunc(initialState, clientCtx)
-
 			noUpgrade, _ := cmd.Flags().GetBool(flagNoConsensusUpgrade)
 			if !noUpgrade {
 				genDoc.ConsensusParams.Evidence.MaxBytes = 4194304
@@ -102,6 +91,29 @@ $ %s migrate v0.9 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=20
 				upgradeModuleParams(clientCtx.JSONCodec, newGenState)
 			}
 
+			if err := mbm.ValidateGenesis(clientCtx.JSONCodec, clientCtx.TxConfig, newGenState); err != nil {
+				return errors.Wrap(err, "invalid migrated genesis state")
+			}
+
+			if checksumFile, _ := cmd.Flags().GetString(flagChecksums); checksumFile != "" {
+				if err := verifyChecksums(newGenState, checksumFile); err != nil {
+					return err
+				}
+			}
+
+			if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+				changes, err := diffModuleStates(initialState, newGenState)
+				if err != nil {
+					return err
+				}
+
+				for _, m := range path {
+					fmt.Fprintf(cmd.OutOrStdout(), "migration %s -> %s\n", m.From, m.To)
+				}
+				printModuleChanges(cmd.OutOrStdout(), changes)
+				return nil
+			}
+
 			genDoc.AppState, err = json.Marshal(newGenState)
 			if err != nil {
 				return errors.Wrap(err, "failed to JSON marshal migrated genesis state")
@@ -142,6 +154,19 @@ $ %s migrate v0.9 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=20
 	cmd.Flags().String(flagGenesisTime, "", "override genesis_time with this flag")
 	cmd.Flags().String(flags.FlagChainID, "", "override chain_id with this flag")
 	cmd.Flags().Bool(flagNoConsensusUpgrade, false, "do not apply planned changes to consensus parameters")
+	cmd.Flags().String(flagFromVersion, migrations[0].From, "version of the source genesis")
+	cmd.Flags().Bool(flagDryRun, false, "report the changed module states instead of printing the migrated genesis")
+	cmd.Flags().String(flagChecksums, "", "JSON file with the expected SHA-256 checksums of the migrated module states")
 
 	return cmd
 }
+
+// copyAppMap copies the module states, as migrations modify the map they are given.
+func copyAppMap(appState types.AppMap) types.AppMap {
+	res := make(types.AppMap, len(appState))
+	for name, state := range appState {
+		res[name] = state
+	}
+
+	return res
+}
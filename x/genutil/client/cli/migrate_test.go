@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func testMigrations(hops ...[2]string) []Migration {
+	res := make([]Migration, len(hops))
+	for i, hop := range hops {
+		to := hop[1]
+		res[i] = Migration{
+			From: hop[0],
+			To:   to,
+			Migrate: func(appState types.AppMap, _ client.Context) (types.AppMap, error) {
+				appState["version"] = json.RawMessage(`"` + to + `"`)
+				return appState, nil
+			},
+		}
+	}
+	return res
+}
+
+func TestMigrationPath(t *testing.T) {
+	hops := testMigrations(
+		[2]string{"v0.9", "v1.0"},
+		[2]string{"v1.0", "v1.1"},
+		[2]string{"v1.1", "v2.0"},
+	)
+
+	specs := map[string]struct {
+		source, target string
+		expPath        []string
+		expErr         bool
+	}{
+		"single hop": {
+			source: "v0.9", target: "v1.0",
+			expPath: []string{"v1.0"},
+		},
+		"chained hops": {
+			source: "v0.9", target: "v2.0",
+			expPath: []string{"v1.0", "v1.1", "v2.0"},
+		},
+		"wildcard target": {
+			source: "v0.9", target: "v1.x",
+			expPath: []string{"v1.0", "v1.1"},
+		},
+		"intermediate source": {
+			source: "v1.0", target: "v2",
+			expPath: []string{"v1.1", "v2.0"},
+		},
+		"patch versions are equal to zero": {
+			source: "v1.0.0", target: "v1.1.0",
+			expPath: []string{"v1.1"},
+		},
+		"unknown target": {
+			source: "v0.9", target: "v1.5",
+			expErr: true,
+		},
+		"target beyond last hop": {
+			source: "v0.9", target: "v3.x",
+			expErr: true,
+		},
+		"target before source": {
+			source: "v1.1", target: "v1.0",
+			expErr: true,
+		},
+		"unknown source": {
+			source: "v0.8", target: "v1.0",
+			expErr: true,
+		},
+		"invalid target": {
+			source: "v0.9", target: "1.0",
+			expErr: true,
+		},
+		"wildcard source": {
+			source: "v1.x", target: "v2.0",
+			expErr: true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			path, err := migrationPath(hops, spec.source, spec.target)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			got := make([]string, len(path))
+			for i, m := range path {
+				got[i] = m.To
+			}
+			require.Equal(t, spec.expPath, got)
+		})
+	}
+}
+
+func TestDiffModuleStates(t *testing.T) {
+	before := types.AppMap{
+		"bank":    json.RawMessage(`{"a":1,"b":2}`),
+		"staking": json.RawMessage(`{"a":1}`),
+		"legacy":  json.RawMessage(`{}`),
+	}
+	after := types.AppMap{
+		"bank":    json.RawMessage(`{"b":2,"a":1}`),
+		"staking": json.RawMessage(`{"a":2}`),
+		"ibc":     json.RawMessage(`{}`),
+	}
+
+	changes, err := diffModuleStates(before, after)
+	require.NoError(t, err)
+
+	statuses := make(map[string]string)
+	for _, c := range changes {
+		statuses[c.Module] = c.Status
+	}
+	require.Equal(t, map[string]string{
+		"bank":    moduleUnchanged,
+		"staking": moduleChanged,
+		"ibc":     moduleAdded,
+		"legacy":  moduleRemoved,
+	}, statuses)
+
+	// checksums do not depend on the order of keys
+	require.Equal(t, "bank", changes[0].Module)
+	bankChecksum, err := moduleChecksum(before["bank"])
+	require.NoError(t, err)
+	require.Equal(t, bankChecksum, changes[0].Checksum)
+	require.Equal(t, "legacy", changes[2].Module)
+	require.Empty(t, changes[2].Checksum)
+}
+
+func TestVerifyChecksums(t *testing.T) {
+	appState := types.AppMap{
+		"bank": json.RawMessage(`{"a":1}`),
+	}
+	checksum, err := moduleChecksum(appState["bank"])
+	require.NoError(t, err)
+
+	writeChecksums := func(checksums map[string]string) string {
+		bz, err := json.Marshal(checksums)
+		require.NoError(t, err)
+
+		file := filepath.Join(t.TempDir(), "checksums.json")
+		require.NoError(t, os.WriteFile(file, bz, 0o600))
+		return file
+	}
+
+	require.NoError(t, verifyChecksums(appState, writeChecksums(map[string]string{"bank": checksum})))
+	require.Error(t, verifyChecksums(appState, writeChecksums(map[string]string{"bank": "00"})))
+	require.Error(t, verifyChecksums(appState, writeChecksums(map[string]string{"staking": checksum})))
+}
+
+func TestMigrateGenesisDryRun(t *testing.T) {
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+	migrations = testMigrations([2]string{"v0.9", "v1.0"}, [2]string{"v1.0", "v1.1"})
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:  "test-chain",
+		AppState: json.RawMessage(`{"version":"v0.9","bank":{}}`),
+	}
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genDoc.SaveAs(genesisFile))
+
+	cmd := MigrateGenesisCmd(module.NewBasicManager())
+	cmd.SetArgs([]string{"v1.x", genesisFile, "--dry-run", "--no-consensus-upgrade"})
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	checksum, err := moduleChecksum(json.RawMessage(`"v1.1"`))
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "migration v0.9 -> v1.0\nmigration v1.0 -> v1.1\n")
+	require.Contains(t, out.String(), "bank")
+	require.Contains(t, out.String(), moduleUnchanged)
+	require.Contains(t, out.String(), "sha256:"+checksum)
+}
+
+func TestMigrateGenesisLegacyTarget(t *testing.T) {
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+	migrations = testMigrations([2]string{"v0.9", "v1.0"}, [2]string{"v1.0", "v1.1"})
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:  "test-chain",
+		AppState: json.RawMessage(`{"version":"v0.9"}`),
+	}
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genDoc.SaveAs(genesisFile))
+
+	// The target used to name the version migrated from
+	cmd := MigrateGenesisCmd(module.NewBasicManager())
+	cmd.SetArgs([]string{"v0.9", genesisFile, "--dry-run", "--no-consensus-upgrade"})
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "migration v0.9 -> v1.0\n")
+	require.NotContains(t, out.String(), "v1.1")
+}
+
+func TestMigrateGenesisError(t *testing.T) {
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+	migrations = testMigrations([2]string{"v0.9", "v1.0"}, [2]string{"v1.0", "v1.1"})
+	migrations[1].Migrate = func(types.AppMap, client.Context) (types.AppMap, error) {
+		return nil, errors.New("invalid state")
+	}
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:  "test-chain",
+		AppState: json.RawMessage(`{"version":"v0.9"}`),
+	}
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genDoc.SaveAs(genesisFile))
+
+	cmd := MigrateGenesisCmd(module.NewBasicManager())
+	cmd.SetArgs([]string{"v1.x", genesisFile, "--dry-run", "--no-consensus-upgrade"})
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	err := cmd.ExecuteContext(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "from v1.0 to v1.1")
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	v09 "github.com/e-money/em-ledger/x/genutil/legacy/v090"
+	v100 "github.com/e-money/em-ledger/x/genutil/legacy/v100"
+)
+
+// MigrationCallback converts the genesis state of a version into the next.
+type MigrationCallback func(types.AppMap, client.Context) (types.AppMap, error)
+
+// Migration is a single hop that converts the genesis state of one version into the next.
+type Migration struct {
+	From    string
+	To      string
+	Migrate MigrationCallback
+}
+
+// Allow applications to extend and modify the migration process. Every hop starts from the version the previous one
+// produced, so that a genesis file can be carried across several versions at once.
+//
+// Ref: https://github.com/cosmos/cosmos-sdk/issues/5041
+var migrations = []Migration{
+	{From: "v0.9", To: "v1.0", Migrate: v09.Migrate},
+	{From: "v1.0", To: "v1.1", Migrate: v100.Migrate},
+}
+
+// GetMigrationVersions returns the versions a genesis file can be migrated from or to, in order.
+func GetMigrationVersions() []string {
+	return migrationVersions(migrations)
+}
+
+func migrationVersions(migrations []Migration) []string {
+	versions := make([]string, 0, len(migrations)+1)
+	for i, m := range migrations {
+		if i == 0 {
+			versions = append(versions, m.From)
+		}
+		versions = append(versions, m.To)
+	}
+
+	return versions
+}
+
+// legacyMigrationTarget returns the version migrated to when the target names the source version, as accepted before
+// migrations could be chained, i.e. v0.9 in emd migrate v0.9 genesis.json.
+func legacyMigrationTarget(target string) (string, bool) {
+	for _, m := range migrations {
+		if m.From == target {
+			return m.To, true
+		}
+	}
+
+	return "", false
+}
+
+// MigrationPath returns the hops that migrate a genesis of the source version up to the target version. The target
+// may end in .x, i.e. v1.x, to migrate to the latest version with that prefix.
+func MigrationPath(source, target string) ([]Migration, error) {
+	return migrationPath(migrations, source, target)
+}
+
+func migrationPath(migrations []Migration, source, target string) ([]Migration, error) {
+	if _, err := parseVersion(source); err != nil {
+		return nil, err
+	}
+	if _, err := parseVersion(target); err != nil {
+		return nil, err
+	}
+
+	var (
+		path    []Migration
+		current = source
+	)
+	for _, m := range migrations {
+		if !sameVersion(m.From, current) || !versionWithin(m.To, target) {
+			continue
+		}
+
+		path = append(path, m)
+		current = m.To
+	}
+
+	if len(path) == 0 || !versionMatches(current, target) {
+		return nil, fmt.Errorf(
+			"no migration path from %s to %s, available versions: %v",
+			source, target, strings.Join(migrationVersions(migrations), " "),
+		)
+	}
+
+	return path, nil
+}
+
+// parseVersion splits a version like v1.2 into its numbers. A trailing x is returned as -1.
+func parseVersion(version string) ([]int, error) {
+	if !strings.HasPrefix(version, "v") || len(version) == 1 {
+		return nil, fmt.Errorf("invalid version %q, expected a version like v1.0", version)
+	}
+
+	parts := strings.Split(version[1:], ".")
+	res := make([]int, len(parts))
+	for i, part := range parts {
+		if part == "x" && i == len(parts)-1 && i > 0 {
+			res[i] = -1
+			continue
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q, expected a version like v1.0", version)
+		}
+		res[i] = n
+	}
+
+	return res, nil
+}
+
+// compareVersions orders versions, treating missing numbers as zero. The wildcard of b matches any remainder of a.
+func compareVersions(a, b string) int {
+	va, _ := parseVersion(a)
+	vb, _ := parseVersion(b)
+
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		var na, nb int
+		if i < len(va) {
+			na = va[i]
+		}
+		if i < len(vb) {
+			nb = vb[i]
+		}
+
+		if nb == -1 {
+			return 0
+		}
+
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func sameVersion(a, b string) bool {
+	return compareVersions(a, b) == 0 && compareVersions(b, a) == 0
+}
+
+// versionWithin reports whether the version does not exceed the target.
+func versionWithin(version, target string) bool {
+	return compareVersions(version, target) <= 0
+}
+
+// versionMatches reports whether the version is the target, or has the prefix of a wildcard target.
+func versionMatches(version, target string) bool {
+	return compareVersions(version, target) == 0
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	moduleAdded     = "added"
+	moduleRemoved   = "removed"
+	moduleChanged   = "changed"
+	moduleUnchanged = "unchanged"
+)
+
+// ModuleChange is the effect of a migration on the genesis state of a module.
+type ModuleChange struct {
+	Module   string
+	Status   string
+	Checksum string
+}
+
+// diffModuleStates compares the module states before and after a migration, ignoring the order of JSON keys.
+func diffModuleStates(before, after types.AppMap) ([]ModuleChange, error) {
+	modules := make(map[string]bool)
+	for name := range before {
+		modules[name] = true
+	}
+	for name := range after {
+		modules[name] = true
+	}
+
+	changes := make([]ModuleChange, 0, len(modules))
+	for name := range modules {
+		change := ModuleChange{Module: name}
+
+		old, existed := before[name]
+		state, exists := after[name]
+		switch {
+		case !exists:
+			change.Status = moduleRemoved
+		case !existed:
+			change.Status = moduleAdded
+		default:
+			equal, err := equalJSON(old, state)
+			if err != nil {
+				return nil, fmt.Errorf("module %s: %w", name, err)
+			}
+
+			change.Status = moduleChanged
+			if equal {
+				change.Status = moduleUnchanged
+			}
+		}
+
+		if exists {
+			checksum, err := moduleChecksum(state)
+			if err != nil {
+				return nil, fmt.Errorf("module %s: %w", name, err)
+			}
+			change.Checksum = checksum
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Module < changes[j].Module
+	})
+
+	return changes, nil
+}
+
+func printModuleChanges(w io.Writer, changes []ModuleChange) {
+	for _, c := range changes {
+		if c.Checksum == "" {
+			fmt.Fprintf(w, "%-20s %-10s\n", c.Module, c.Status)
+			continue
+		}
+		fmt.Fprintf(w, "%-20s %-10s sha256:%s\n", c.Module, c.Status, c.Checksum)
+	}
+}
+
+func equalJSON(a, b json.RawMessage) (bool, error) {
+	sortedA, err := sdk.SortJSON(a)
+	if err != nil {
+		return false, err
+	}
+
+	sortedB, err := sdk.SortJSON(b)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(sortedA, sortedB), nil
+}
+
+// moduleChecksum hashes the module state with sorted keys, so that it does not depend on the JSON encoder.
+func moduleChecksum(state json.RawMessage) (string, error) {
+	sorted, err := sdk.SortJSON(state)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(sorted)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// verifyChecksums compares the module states with the expected checksums read from a JSON file mapping module names
+// to hex encoded SHA-256 hashes.
+func verifyChecksums(appState types.AppMap, checksumFile string) error {
+	bz, err := os.ReadFile(checksumFile)
+	if err != nil {
+		return err
+	}
+
+	var expected map[string]string
+	if err := json.Unmarshal(bz, &expected); err != nil {
+		return fmt.Errorf("failed to read checksums from %s: %w", checksumFile, err)
+	}
+
+	modules := make([]string, 0, len(expected))
+	for name := range expected {
+		modules = append(modules, name)
+	}
+	sort.Strings(modules)
+
+	for _, name := range modules {
+		state, found := appState[name]
+		if !found {
+			return fmt.Errorf("module %s has a checksum but no genesis state", name)
+		}
+
+		checksum, err := moduleChecksum(state)
+		if err != nil {
+			return fmt.Errorf("module %s: %w", name, err)
+		}
+
+		if checksum != expected[name] {
+			return fmt.Errorf("checksum mismatch for module %s: expected %s, got %s", name, expected[name], checksum)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package v040
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -45,12 +46,14 @@ func migrateGenutil(oldGenState v039genutil.GenesisState) *types.GenesisState {
 }
 
 // Migrate migrates exported state from v0.9.x to a v1.0.x genesis state.
-func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
+func Migrate(appState types.AppMap, clientCtx client.Context) (types.AppMap, error) {
 	v09Codec := codec.NewLegacyAmino()
 	v039auth.RegisterLegacyAminoCodec(v09Codec)
 	v036distr.RegisterLegacyAminoCodec(v09Codec)
 	v036params.RegisterLegacyAminoCodec(v09Codec)
 	v038upgrade.RegisterLegacyAminoCodec(v09Codec)
+	v09Codec.RegisterInterface((*v038evidence.Evidence)(nil), nil)
+	v09Codec.RegisterConcrete(v038evidence.Equivocation{}, "cosmos-sdk/Equivocation", nil)
 
 	v09liquidityprovider.RegisterLegacyAminoCodec(v09Codec)
 
@@ -59,15 +62,21 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v038bank.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var bankGenState v038bank.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v038bank.ModuleName], &bankGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v038bank.ModuleName], &bankGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v038bank.ModuleName, err)
+		}
 
 		// unmarshal x/auth genesis state to retrieve all account balances
 		var authGenState v039auth.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v039auth.ModuleName], &authGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v039auth.ModuleName], &authGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v039auth.ModuleName, err)
+		}
 
 		// unmarshal x/supply genesis state to retrieve total supply
 		var supplyGenState v036supply.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v036supply.ModuleName], &supplyGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v036supply.ModuleName], &supplyGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v036supply.ModuleName, err)
+		}
 
 		// delete deprecated x/bank genesis state
 		delete(appState, v038bank.ModuleName)
@@ -95,7 +104,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v039auth.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var authGenState v039auth.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v039auth.ModuleName], &authGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v039auth.ModuleName], &authGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v039auth.ModuleName, err)
+		}
 
 		// delete deprecated x/auth genesis state
 		delete(appState, v039auth.ModuleName)
@@ -116,7 +127,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	// Migrate x/authority
 	if appState[authority.ModuleName] != nil {
 		var authorityGenState v09authority.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[authority.ModuleName], &authorityGenState)
+		if err := v09Codec.UnmarshalJSON(appState[authority.ModuleName], &authorityGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", authority.ModuleName, err)
+		}
 
 		delete(appState, authority.ModuleName)
 
@@ -127,7 +140,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v039crisis.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var crisisGenState v039crisis.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v039crisis.ModuleName], &crisisGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v039crisis.ModuleName], &crisisGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v039crisis.ModuleName, err)
+		}
 
 		// delete deprecated x/crisis genesis state
 		delete(appState, v039crisis.ModuleName)
@@ -141,7 +156,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v038distr.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var distributionGenState v038distr.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v038distr.ModuleName], &distributionGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v038distr.ModuleName], &distributionGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v038distr.ModuleName, err)
+		}
 
 		// delete deprecated x/distribution genesis state
 		delete(appState, v038distr.ModuleName)
@@ -155,7 +172,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v038evidence.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var evidenceGenState v038evidence.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v038bank.ModuleName], &evidenceGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v038evidence.ModuleName], &evidenceGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v038evidence.ModuleName, err)
+		}
 
 		// delete deprecated x/evidence genesis state
 		delete(appState, v038evidence.ModuleName)
@@ -169,7 +188,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v09slashing.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var slashingGenState v09slashing.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v09slashing.ModuleName], &slashingGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v09slashing.ModuleName], &slashingGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v09slashing.ModuleName, err)
+		}
 
 		// delete deprecated x/slashing genesis state
 		delete(appState, v09slashing.ModuleName)
@@ -183,7 +204,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v038staking.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var stakingGenState v038staking.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v038staking.ModuleName], &stakingGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v038staking.ModuleName], &stakingGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v038staking.ModuleName, err)
+		}
 
 		// delete deprecated x/staking genesis state
 		delete(appState, v038staking.ModuleName)
@@ -199,7 +222,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 	if appState[v039genutil.ModuleName] != nil {
 		// unmarshal relative source genesis application state
 		var genutilGenState v039genutil.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[v039genutil.ModuleName], &genutilGenState)
+		if err := v09Codec.UnmarshalJSON(appState[v039genutil.ModuleName], &genutilGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", v039genutil.ModuleName, err)
+		}
 
 		// delete deprecated x/genutil genesis state
 		delete(appState, v039genutil.ModuleName)
@@ -211,7 +236,9 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 
 	if appState[buyback.ModuleName] != nil {
 		var buybackGenState buyback.GenesisState
-		v09Codec.MustUnmarshalJSON(appState[buyback.ModuleName], &buybackGenState)
+		if err := v09Codec.UnmarshalJSON(appState[buyback.ModuleName], &buybackGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", buyback.ModuleName, err)
+		}
 
 		// Genesis format for the buyback module has not changed, but may be missing.
 		// Set a default value if that is the case.
@@ -240,5 +267,5 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 		appState[module.Name()] = module.DefaultGenesis(v040Codec)
 	}
 
-	return appState
+	return appState, nil
 }
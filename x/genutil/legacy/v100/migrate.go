@@ -0,0 +1,99 @@
+package v100
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	slashingtypes "github.com/e-money/em-ledger/x/slashing/types"
+)
+
+// Migrate migrates exported state from v1.0.x to a v1.1.x genesis state.
+func Migrate(appState types.AppMap, clientCtx client.Context) (types.AppMap, error) {
+	cdc := clientCtx.JSONCodec
+
+	// Migrate x/bank
+	if appState[banktypes.ModuleName] != nil {
+		var bankGenState banktypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+		}
+
+		for i, metadata := range bankGenState.DenomMetadata {
+			bankGenState.DenomMetadata[i] = migrateDenomMetadata(metadata)
+		}
+
+		bz, err := cdc.MarshalJSON(&bankGenState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s genesis state: %w", banktypes.ModuleName, err)
+		}
+		appState[banktypes.ModuleName] = bz
+	}
+
+	// Migrate x/slashing
+	if appState[sdkslashingtypes.ModuleName] != nil {
+		var slashingGenState slashingtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[sdkslashingtypes.ModuleName], &slashingGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", sdkslashingtypes.ModuleName, err)
+		}
+
+		// Graduated downtime penalties did not exist in v1.0.x
+		slashingGenState.SetDefaultDowntimePenaltyParams()
+
+		bz, err := cdc.MarshalJSON(&slashingGenState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s genesis state: %w", sdkslashingtypes.ModuleName, err)
+		}
+		appState[sdkslashingtypes.ModuleName] = bz
+	}
+
+	return appState, nil
+}
+
+// migrateDenomMetadata makes denomination metadata of v1.0.x valid for Cosmos SDK v0.43 onwards, which requires a name
+// and symbol, a base unit with exponent 0 and a unit for the display denomination. The v1.0.x genesis put the exponent
+// of the display unit on the base unit instead.
+func migrateDenomMetadata(metadata banktypes.Metadata) banktypes.Metadata {
+	if strings.TrimSpace(metadata.Name) == "" {
+		metadata.Name = metadata.Description
+		if strings.TrimSpace(metadata.Name) == "" {
+			metadata.Name = metadata.Display
+		}
+	}
+	if strings.TrimSpace(metadata.Symbol) == "" {
+		metadata.Symbol = metadata.Display
+	}
+
+	var (
+		units           = make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits)+1)
+		displayExponent uint32
+		hasDisplay      bool
+	)
+	for _, unit := range metadata.DenomUnits {
+		unit := *unit
+		if unit.Denom == metadata.Base && unit.Exponent != 0 {
+			displayExponent, unit.Exponent = unit.Exponent, 0
+		}
+		if unit.Denom == metadata.Display || unit.Denom != metadata.Base && strings.EqualFold(unit.Denom, metadata.Display) {
+			metadata.Display = unit.Denom
+			hasDisplay = true
+		}
+
+		units = append(units, &unit)
+	}
+
+	if !hasDisplay && displayExponent != 0 {
+		units = append(units, &banktypes.DenomUnit{Denom: metadata.Display, Exponent: displayExponent})
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i].Exponent < units[j].Exponent
+	})
+	metadata.DenomUnits = units
+
+	return metadata
+}
@@ -0,0 +1,30 @@
+package v100
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v09 "github.com/e-money/em-ledger/x/genutil/legacy/v090"
+)
+
+func TestMigrateDenomMetadata(t *testing.T) {
+	for _, metadata := range v09.GetDenomMetaData() {
+		require.Error(t, metadata.Validate(), metadata.Base)
+
+		migrated := migrateDenomMetadata(metadata)
+		require.NoError(t, migrated.Validate(), metadata.Base)
+		require.Equal(t, metadata.Base, migrated.Base)
+		require.Equal(t, metadata.Description, migrated.Name)
+		require.Equal(t, metadata.Display, migrated.Symbol)
+	}
+
+	ngm := migrateDenomMetadata(v09.GetDenomMetaData()[0])
+	require.Equal(t, "ngm", ngm.Display)
+	require.Equal(t, []*banktypes.DenomUnit{{Denom: "ungm"}, {Denom: "ngm", Exponent: 6}}, ngm.DenomUnits)
+
+	echf := migrateDenomMetadata(v09.GetDenomMetaData()[1])
+	require.Equal(t, "ECHF", echf.Display)
+	require.Equal(t, []*banktypes.DenomUnit{{Denom: "echf"}, {Denom: "ECHF", Exponent: 6}}, echf.DenomUnits)
+}
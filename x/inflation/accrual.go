@@ -6,17 +6,19 @@ package inflation
 
 import (
 	"fmt"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/e-money/em-ledger/util"
+	"github.com/e-money/em-ledger/x/inflation/keeper"
 	"github.com/e-money/em-ledger/x/inflation/types"
 )
 
-const (
-	// Do not apply inflation if less than this period has elapsed since last accrual
-	minimumMintingPeriod = 10 * time.Second
-)
+// EndBlocker continues indexing the holders of denominations that have become interest-bearing.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if err := k.IndexHolders(ctx, keeper.MaxIndexedHoldersPerBlock); err != nil {
+		k.Logger(ctx).Error("Indexing interest holders failed", "err", err)
+	}
+}
 
 // BeginBlocker mints new tokens for the previous block.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
@@ -24,7 +26,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	blockTime := ctx.BlockTime()
 
 	// Gate-keep this functionality based on time since last block to prevent a cascade of blocks
-	if blockTime.Sub(state.LastAppliedTime) < minimumMintingPeriod {
+	if blockTime.Sub(state.LastAppliedTime) < types.MinimumMintingPeriod {
 		return
 	}
 
@@ -43,7 +45,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		return
 	}
 
-	mintedCoins := applyInflation(&state, totalTokenSupply, blockTime)
+	mintedCoins := state.ApplyInflation(totalTokenSupply, blockTime)
 	state.LastAppliedHeight = sdk.NewInt(ctx.BlockHeight())
 
 	k.SetState(ctx, state)
@@ -54,14 +56,45 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 
 	k.Logger(ctx).Info("Inflation minted coins", toKeyValuePairs(mintedCoins)...)
 
-	err = k.MintCoins(ctx, mintedCoins)
+	// The holders' share is paid out as their balances settle
+	holderCoins, err := k.AccrueInterest(ctx, state, mintedCoins)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.MintCoins(ctx, mintedCoins.Sub(holderCoins))
 	if err != nil {
 		panic(err)
 	}
 
-	// Divide into two pools: Staking tokens and Stablecoin tokens
+	// Route the denominations with a configured distribution to their recipients
+	var defaultCoins sdk.Coins
+	for _, coin := range mintedCoins {
+		asset := state.FindByDenom(coin.Denom)
+		if len(asset.Distribution) == 0 {
+			defaultCoins = append(defaultCoins, coin)
+			continue
+		}
+
+		// A failing distribution must not halt the chain. Its minted coins go to the default destination instead,
+		// while the holders' share has already accrued.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.DistributeToRecipients(cacheCtx, coin, asset.Distribution); err != nil {
+			k.Logger(ctx).Error("Inflation distribution failed", "denom", coin.Denom, "err", err)
+			remainder := coin.Amount.Sub(holderCoins.AmountOf(coin.Denom))
+			if remainder.IsPositive() {
+				defaultCoins = append(defaultCoins, sdk.NewCoin(coin.Denom, remainder))
+			}
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	// Divide the remainder into two pools: Staking tokens and Stablecoin tokens
 	stakingDenom := k.GetStakingDenomination(ctx)
-	stakingTokens, coinTokens := util.SplitCoinsByDenom(mintedCoins, stakingDenom)
+	stakingTokens, coinTokens := util.SplitCoinsByDenom(defaultCoins, stakingDenom)
 
 	err = k.DistributeMintedCoins(ctx, coinTokens)
 	if err != nil {
@@ -82,40 +115,6 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	)
 }
 
-func applyInflation(state *InflationState, totalTokenSupply sdk.Coins, currentTime time.Time) sdk.Coins {
-	lastAccrual := state.LastAppliedTime
-	mintedCoins := sdk.Coins{}
-
-	state.LastAppliedTime = currentTime
-
-	for i, asset := range state.InflationAssets {
-		supply := totalTokenSupply.AmountOf(asset.Denom)
-
-		accum, minted := calculateInflation(asset.Accum, supply, asset.Inflation, lastAccrual, currentTime)
-
-		if minted.IsPositive() { // Coins.IsValid() considers any coin of amount 0 to be invalid, so filter 0 coins.
-			mintedCoins = append(mintedCoins, sdk.NewCoin(asset.Denom, minted))
-		}
-
-		asset.Accum = accum
-		state.InflationAssets[i] = asset
-	}
-
-	return mintedCoins.Sort()
-}
-
-func calculateInflation(prevAccum sdk.Dec, supply sdk.Int, annualInflation sdk.Dec, lastAccrual, currentTime time.Time) (accum sdk.Dec, minted sdk.Int) {
-	annualNS := 365 * 24 * time.Hour.Nanoseconds()
-
-	periodNS := sdk.NewDec(currentTime.Sub(lastAccrual).Nanoseconds())
-	accum = annualInflation.MulInt(supply).Mul(periodNS).Add(prevAccum)
-
-	minted = accum.Quo(sdk.NewDec(annualNS)).TruncateInt()
-	accum = accum.Sub(minted.MulRaw(annualNS).ToDec())
-
-	return
-}
-
 // For use in logging
 func toKeyValuePairs(coins sdk.Coins) (res []interface{}) {
 	for _, coin := range coins {
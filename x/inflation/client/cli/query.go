@@ -5,6 +5,9 @@
 package cli
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/e-money/em-ledger/x/inflation/types"
@@ -32,5 +35,54 @@ func GetQueryCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+
+	cmd.AddCommand(getCmdQueryProjection())
+	return cmd
+}
+
+const flagInterval = "interval"
+
+func getCmdQueryProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection [time]",
+		Short: "Project the tokens minted until a future time at the current inflation rates",
+		Long: `Project the tokens minted until a future time at the current inflation rates. The time is either
+RFC3339 formatted or a duration from now. Use --interval to compound the inflation in steps, as blocks do.`,
+		Example: "emd query inflation projection 2030-01-01T00:00:00Z --interval 24h\nemd query inflation projection 8760h",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			projectionTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				d, durationErr := time.ParseDuration(args[0])
+				if durationErr != nil {
+					return fmt.Errorf("time must be RFC3339 formatted or a duration: %w", err)
+				}
+				projectionTime = time.Now().Add(d)
+			}
+
+			interval, err := cmd.Flags().GetDuration(flagInterval)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Projection(cmd.Context(), &types.QueryProjectionRequest{
+				Time:     projectionTime.UTC(),
+				Interval: interval,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Duration(flagInterval, 0, "Accrue the inflation in steps of this duration")
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -5,6 +5,8 @@
 package inflation
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/e-money/em-ledger/x/inflation/types"
 )
@@ -25,12 +27,46 @@ func DefaultGenesisState() *types.GenesisState {
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetState(ctx, data.InflationState)
+
+	for _, index := range data.InterestIndexes {
+		keeper.SetInterestIndex(ctx, index)
+	}
+
+	for _, accountIndex := range data.AccountInterestIndexes {
+		addr, err := sdk.AccAddressFromBech32(accountIndex.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetAccountInterestIndex(ctx, accountIndex.Denom, addr, accountIndex.Index)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	state := keeper.GetState(ctx)
-	return NewGenesisState(state)
+	genesis := NewGenesisState(keeper.GetState(ctx))
+
+	// The genesis state has no record of holders that have not been indexed
+	for _, index := range keeper.GetInterestIndexes(ctx) {
+		for keeper.IsIndexingHolders(ctx, index.Denom) {
+			if err := keeper.IndexHolders(ctx, math.MaxUint64); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	genesis.InterestIndexes = keeper.GetInterestIndexes(ctx)
+	for _, index := range genesis.InterestIndexes {
+		keeper.IterateAccountInterestIndexes(ctx, index.Denom, func(addr sdk.AccAddress, accountIndex sdk.Dec) bool {
+			genesis.AccountInterestIndexes = append(genesis.AccountInterestIndexes, types.AccountInterestIndex{
+				Denom:   index.Denom,
+				Address: addr.String(),
+				Index:   accountIndex,
+			})
+			return false
+		})
+	}
+
+	return genesis
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the
@@ -41,5 +77,10 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	err = types.ValidateInterestIndexes(data.InterestIndexes, data.AccountInterestIndexes)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
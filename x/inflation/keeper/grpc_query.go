@@ -21,3 +21,60 @@ func (k Keeper) Inflation(c context.Context, req *types.QueryInflationRequest) (
 	}
 	return &response, nil
 }
+
+// Limits the work of a projection query
+const maxProjectionSteps = 100000
+
+func (k Keeper) Projection(c context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state := k.GetState(ctx)
+	if !req.Time.After(state.LastAppliedTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "projection time must be after the last accrual at %v", state.LastAppliedTime)
+	}
+
+	period := req.Time.Sub(state.LastAppliedTime)
+	interval := req.Interval
+	switch {
+	case interval == 0:
+		// Without an interval, inflation is applied once for the whole period, however short
+		interval = period
+	case interval < types.MinimumMintingPeriod:
+		return nil, status.Errorf(codes.InvalidArgument, "interval must be at least %v", types.MinimumMintingPeriod)
+	}
+	if (period+interval-1)/interval > maxProjectionSteps {
+		return nil, status.Errorf(codes.InvalidArgument, "projection exceeds %d steps of %v", maxProjectionSteps, interval)
+	}
+
+	supply, err := k.TotalTokenSupply(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	minted := sdk.NewCoins()
+	for state.LastAppliedTime.Before(req.Time) {
+		next := state.LastAppliedTime.Add(interval)
+		if next.After(req.Time) {
+			next = req.Time
+		}
+
+		mintedCoins := state.ApplyInflation(supply, next)
+		supply = supply.Add(mintedCoins...)
+		minted = minted.Add(mintedCoins...)
+	}
+
+	assets := make([]types.AssetProjection, len(state.InflationAssets))
+	for i, asset := range state.InflationAssets {
+		assets[i] = types.AssetProjection{
+			Denom:  asset.Denom,
+			Minted: minted.AmountOf(asset.Denom),
+			Supply: supply.AmountOf(asset.Denom),
+			Accum:  asset.Accum,
+		}
+	}
+
+	return &types.QueryProjectionResponse{Assets: assets}, nil
+}
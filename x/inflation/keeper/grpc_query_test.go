@@ -41,3 +41,75 @@ func TestQueryInflation(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryProjection(t *testing.T) {
+	input := newTestInput(t)
+	ctx := input.ctx
+
+	lastApplied := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := types.NewInflationState(lastApplied, "echf", "0.10", "eeur", "0.01")
+	state.InflationAssets[1].Accum = sdk.NewDec(365 * 24 * time.Hour.Nanoseconds()).QuoInt64(2)
+	input.mintKeeper.SetState(ctx, state)
+
+	supply := sdk.NewCoins(sdk.NewInt64Coin("echf", 1000), sdk.NewInt64Coin("eeur", 1000000000))
+	require.NoError(t, input.bankKeeper.MintCoins(ctx, types.ModuleName, supply))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, input.encConfig.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, input.mintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// A single accrual over a year mints the annual inflation and the carried over half token
+	res, err := queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{
+		Time: lastApplied.Add(365 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Assets, 2)
+	assert.Equal(t, "echf", res.Assets[0].Denom)
+	assert.Equal(t, sdk.NewInt(100), res.Assets[0].Minted)
+	assert.Equal(t, sdk.NewInt(1100), res.Assets[0].Supply)
+	assert.Equal(t, sdk.NewInt(10000000), res.Assets[1].Minted)
+	assert.Equal(t, sdk.NewInt(1010000000), res.Assets[1].Supply)
+	assert.Equal(t, state.InflationAssets[1].Accum, res.Assets[1].Accum)
+
+	// Matches the on-chain arithmetic
+	accum, minted := types.CalculateInflation(state.InflationAssets[1].Accum, supply.AmountOf("eeur"), sdk.NewDecWithPrec(1, 2), lastApplied, lastApplied.Add(time.Hour))
+	res, err = queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{
+		Time: lastApplied.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, minted, res.Assets[1].Minted)
+	assert.Equal(t, accum, res.Assets[1].Accum)
+
+	// Periods shorter than the minimum interval are projected in a single step
+	res, err = queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{
+		Time: lastApplied.Add(5 * time.Second),
+	})
+	require.NoError(t, err)
+	assert.True(t, res.Assets[1].Minted.IsPositive())
+
+	// Minted tokens compound when accrued in steps
+	res, err = queryClient.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{
+		Time:     lastApplied.Add(365 * 24 * time.Hour),
+		Interval: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(105), res.Assets[0].Minted)
+	assert.True(t, res.Assets[1].Minted.GT(sdk.NewInt(10000000)))
+
+	// State is unchanged
+	assert.Equal(t, state, input.mintKeeper.GetState(ctx))
+
+	_, err = input.mintKeeper.Projection(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	for name, req := range map[string]*types.QueryProjectionRequest{
+		"time in past":   {Time: lastApplied.Add(-time.Hour)},
+		"short interval": {Time: lastApplied.Add(time.Hour), Interval: time.Second},
+		"too many steps": {Time: lastApplied.Add(365 * 24 * time.Hour), Interval: time.Minute},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := queryClient.Projection(sdk.WrapSDKContext(ctx), req)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/inflation/types"
+)
+
+func (k Keeper) GetInterestIndex(ctx sdk.Context, denom string) (index types.InterestIndex, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetInterestIndexKey(denom))
+	if bz == nil {
+		return index, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &index)
+	return index, true
+}
+
+// GetInterestIndexes returns the interest indexes of all denominations that have accrued to their holders.
+func (k Keeper) GetInterestIndexes(ctx sdk.Context) (indexes []types.InterestIndex) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.InterestIndexKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var index types.InterestIndex
+		k.cdc.MustUnmarshal(iterator.Value(), &index)
+		indexes = append(indexes, index)
+	}
+
+	return
+}
+
+func (k Keeper) SetInterestIndex(ctx sdk.Context, index types.InterestIndex) {
+	ctx.KVStore(k.storeKey).Set(types.GetInterestIndexKey(index.Denom), k.cdc.MustMarshal(&index))
+}
+
+// GetAccountInterestIndex returns the interest index at which the balance of an account last settled. Balances that
+// have never settled were held when the interest index started.
+func (k Keeper) GetAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAccountInterestIndexKey(denom, addr))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+
+	var index sdk.Dec
+	if err := index.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return index
+}
+
+func (k Keeper) SetAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress, index sdk.Dec) {
+	bz, err := index.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetAccountInterestIndexKey(denom, addr), bz)
+}
+
+func (k Keeper) DeleteAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetAccountInterestIndexKey(denom, addr))
+}
+
+func (k Keeper) IterateAccountInterestIndexes(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress, index sdk.Dec) (stop bool)) {
+	prefix := types.GetAccountInterestIndexPrefix(denom)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var index sdk.Dec
+		if err := index.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(iterator.Key()[len(prefix):], index) {
+			break
+		}
+	}
+}
+
+// MaxIndexedHoldersPerBlock limits the number of accounts whose balances are indexed when a denomination becomes
+// interest-bearing, and at the end of every block until all holders have been indexed.
+const MaxIndexedHoldersPerBlock = 1000
+
+// startInterestIndex makes the balances of a denomination interest-bearing. Module accounts do not earn interest.
+func (k Keeper) startInterestIndex(ctx sdk.Context, denom string) error {
+	if _, found := k.GetInterestIndex(ctx, denom); found {
+		return nil
+	}
+
+	k.SetInterestIndex(ctx, types.NewInterestIndex(denom, sdk.ZeroInt()))
+	_, err := k.indexHolders(ctx, denom, nil, MaxIndexedHoldersPerBlock)
+	return err
+}
+
+// IsIndexingHolders reports whether the balances of some holders of an interest-bearing denomination have not yet been
+// included in its holder supply.
+func (k Keeper) IsIndexingHolders(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetHolderIndexingKey(denom))
+}
+
+func (k Keeper) HasAccountInterestIndex(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAccountInterestIndexKey(denom, addr))
+}
+
+// IndexHolders continues the indexing of the holders of interest-bearing denominations, up to limit accounts in total.
+func (k Keeper) IndexHolders(ctx sdk.Context, limit uint64) error {
+	type cursor struct {
+		denom string
+		key   []byte
+	}
+
+	var cursors []cursor
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.HolderIndexingKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.HolderIndexingKeyPrefix):])
+		cursors = append(cursors, cursor{denom, iterator.Value()})
+	}
+	iterator.Close()
+
+	for _, c := range cursors {
+		if limit == 0 {
+			break
+		}
+
+		count, err := k.indexHolders(ctx, c.denom, c.key, limit)
+		if err != nil {
+			return err
+		}
+		limit -= count
+	}
+
+	return nil
+}
+
+// indexHolders adds the balances of up to limit accounts, starting from key, to the holder supply of a denomination.
+// Accounts that have settled since the indexing started are already included.
+func (k Keeper) indexHolders(ctx sdk.Context, denom string, key []byte, limit uint64) (uint64, error) {
+	index, found := k.GetInterestIndex(ctx, denom)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrInternal, "interest index of %v not found", denom)
+	}
+
+	res, err := k.accountKeeper.Accounts(sdk.WrapSDKContext(ctx), &authtypes.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Key: key, Limit: limit},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, any := range res.Accounts {
+		account, ok := any.GetCachedValue().(authtypes.AccountI)
+		if !ok {
+			return 0, sdkerrors.Wrapf(types.ErrInternal, "unexpected account type %v", any.TypeUrl)
+		}
+
+		addr := account.GetAddress()
+		if k.supplyKeeper.BlockedAddr(addr) || k.HasAccountInterestIndex(ctx, denom, addr) {
+			continue
+		}
+
+		balance := k.supplyKeeper.GetBalance(ctx, addr, denom).Amount
+		if balance.IsPositive() {
+			index.Track(balance)
+			k.SetAccountInterestIndex(ctx, denom, addr, index.Index)
+		}
+	}
+	k.SetInterestIndex(ctx, index)
+
+	store := ctx.KVStore(k.storeKey)
+	if len(res.Pagination.NextKey) == 0 {
+		store.Delete(types.GetHolderIndexingKey(denom))
+		k.Logger(ctx).Info("Indexed the holders of interest-bearing denomination", "denom", denom, "supply", index.ScaledSupply.String())
+	} else {
+		store.Set(types.GetHolderIndexingKey(denom), res.Pagination.NextKey)
+	}
+
+	return uint64(len(res.Accounts)), nil
+}
+
+// AccrueInterest increases the interest indexes by the holders' share of the minted coins. The returned coins are
+// paid out as the balances of the holders settle and must not be minted by the caller. If a denomination has no
+// holders, their share is forfeited.
+func (k Keeper) AccrueInterest(ctx sdk.Context, state types.InflationState, mintedCoins sdk.Coins) (holderCoins sdk.Coins, err error) {
+	for _, coin := range mintedCoins {
+		asset := state.FindByDenom(coin.Denom)
+		amount := types.HoldersShare(coin.Amount, asset.Distribution)
+		if !amount.IsPositive() {
+			continue
+		}
+		holderCoins = holderCoins.Add(sdk.NewCoin(coin.Denom, amount))
+
+		index, found := k.GetInterestIndex(ctx, coin.Denom)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrInternal, "interest index of %v not found", coin.Denom)
+		}
+
+		if k.IsIndexingHolders(ctx, coin.Denom) {
+			// The holder supply is incomplete, so the index would overpay the holders indexed so far
+			k.Logger(ctx).Info("Inflation is forfeited while the holders are indexed", "denom", coin.Denom, "amount", amount.String())
+			continue
+		}
+
+		if !index.Accrue(amount) {
+			k.Logger(ctx).Info("Inflation has no holders to accrue to", "denom", coin.Denom, "amount", amount.String())
+			continue
+		}
+		k.SetInterestIndex(ctx, index)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInterest,
+				sdk.NewAttribute(types.AttributeKeyAction, "accrue"),
+				sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(coin.Denom, amount).String()),
+				sdk.NewAttribute(types.AttributeKeyIndex, index.Index.String()),
+			),
+		)
+	}
+
+	return holderCoins, nil
+}
@@ -23,28 +23,43 @@ type Keeper struct {
 	supplyKeeper  types.BankKeeper
 	stakingKeeper types.StakingKeeper
 	accountKeeper types.AccountKeeper
+	distrKeeper   types.DistributionKeeper
 
 	cointokenDestination,
 	stakingtokenDestination string
+
+	// module accounts that issuers may distribute inflation to
+	recipientModules map[string]bool
 }
 
 func NewKeeper(
 	cdc codec.Codec, key sdk.StoreKey, bankKeeper types.BankKeeper,
-	accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper,
-	coinTokenDestination, stakingTokenDestination string,
+	accountKeeper types.AccountKeeper, stakingKeeper types.StakingKeeper, distrKeeper types.DistributionKeeper,
+	coinTokenDestination, stakingTokenDestination string, recipientModules []string,
 ) Keeper {
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the inflation module account has not been set")
 	}
 
+	allowedModules := make(map[string]bool)
+	for _, name := range recipientModules {
+		if addr := accountKeeper.GetModuleAddress(name); addr == nil {
+			panic(fmt.Sprintf("the %v module account has not been set", name))
+		}
+		allowedModules[name] = true
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
 		supplyKeeper:  bankKeeper,
 		stakingKeeper: stakingKeeper,
+		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
 
 		cointokenDestination:    coinTokenDestination,
 		stakingtokenDestination: stakingTokenDestination,
+		recipientModules:        allowedModules,
 	}
 }
 
@@ -86,6 +101,46 @@ func (k Keeper) SetInflation(ctx sdk.Context, newInflation sdk.Dec, denom string
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// SetDistribution routes the tokens minted for a denomination to the given weighted recipients. An empty list of
+// recipients restores the default destination.
+func (k Keeper) SetDistribution(ctx sdk.Context, denom string, recipients []types.InflationRecipient) (*sdk.Result, error) {
+	if err := types.ValidateDistribution(recipients); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	for _, recipient := range recipients {
+		switch recipient.Type {
+		case types.RecipientType_Address:
+			addr, _ := sdk.AccAddressFromBech32(recipient.Address)
+			if k.supplyKeeper.BlockedAddr(addr) {
+				return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%v is not allowed to receive inflation", recipient.Address)
+			}
+		case types.RecipientType_Module:
+			// Module accounts such as the staking pools track their balances and must not receive inflation
+			if !k.recipientModules[recipient.Address] {
+				return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "module %v is not allowed to receive inflation", recipient.Address)
+			}
+		}
+	}
+
+	state := k.GetState(ctx)
+	asset := state.FindByDenom(denom)
+	if asset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRequest, "Unrecognized asset denomination: %v", denom)
+	}
+
+	if types.HasHolders(recipients) {
+		if err := k.startInterestIndex(ctx, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	asset.Distribution = recipients
+	k.SetState(ctx, state)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func (k Keeper) GetStakingDenomination(ctx sdk.Context) string {
 	return k.stakingKeeper.GetParams(ctx).BondDenom
 }
@@ -111,10 +166,22 @@ func (k Keeper) AddDenoms(ctx sdk.Context, denoms []string) (*sdk.Result, error)
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// TotalTokenSupply returns the supply of all denominations, including the interest that has accrued to holders but has
+// not been minted yet.
 func (k Keeper) TotalTokenSupply(ctx sdk.Context) (sdk.Coins, error) {
 	// TotalSupply removed https://github.com/cosmos/cosmos-sdk/pull/8798#discussion_r599867976
 	totalSupply, _, err := k.supplyKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
-	return totalSupply, err
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range k.GetInterestIndexes(ctx) {
+		if outstanding := index.OutstandingInterest(); outstanding.IsPositive() {
+			totalSupply = totalSupply.Add(sdk.NewCoin(index.Denom, outstanding))
+		}
+	}
+
+	return totalSupply, nil
 }
 
 // MintCoins implements an alias call to the underlying supply keeper's
@@ -134,3 +201,48 @@ func (k Keeper) DistributeMintedCoins(ctx sdk.Context, fees sdk.Coins) error {
 func (k Keeper) DistributeStakingCoins(ctx sdk.Context, fees sdk.Coins) error {
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.stakingtokenDestination, fees)
 }
+
+// DistributeToRecipients splits the minted coin between the recipients in proportion to their weights.
+func (k Keeper) DistributeToRecipients(ctx sdk.Context, minted sdk.Coin, recipients []types.InflationRecipient) error {
+	shares := types.SplitByWeight(minted.Amount, recipients)
+
+	for i, recipient := range recipients {
+		if !shares[i].IsPositive() {
+			continue
+		}
+
+		amount := sdk.NewCoins(sdk.NewCoin(minted.Denom, shares[i]))
+
+		var err error
+		switch recipient.Type {
+		case types.RecipientType_Address:
+			addr, _ := sdk.AccAddressFromBech32(recipient.Address)
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount)
+		case types.RecipientType_Module:
+			err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Address, amount)
+		case types.RecipientType_CommunityPool:
+			err = k.distrKeeper.FundCommunityPool(ctx, amount, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		case types.RecipientType_Holders:
+			// Accrued through the interest index rather than minted
+			continue
+		default:
+			err = sdkerrors.Wrapf(types.ErrInternal, "unknown inflation recipient type: %v", recipient.Type)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInflation,
+				sdk.NewAttribute(types.AttributeKeyAction, "distribute"),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipientType, recipient.Type.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+			),
+		)
+	}
+
+	return nil
+}
@@ -36,6 +36,7 @@ type testInput struct {
 	ctx        sdk.Context
 	cdc        codec.Codec
 	mintKeeper Keeper
+	bankKeeper bankkeeper.Keeper
 	encConfig  simappparams.EncodingConfig
 }
 
@@ -88,7 +89,7 @@ func newTestInput(t *testing.T) testInput {
 	stakingKeeper := mockStakingKeeper{}
 
 	inflationKeeper := NewKeeper(
-		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, "buyback", authtypes.FeeCollectorName,
+		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, nil, "buyback", authtypes.FeeCollectorName, []string{"buyback"},
 	)
 	inflationKeeper.SetState(ctx, types.NewInflationState(time.Now(), "ejpy", "0.05", "echf", "0.10", "eeur", "0.01"))
 
@@ -107,6 +108,7 @@ func newTestInput(t *testing.T) testInput {
 		ctx:        ctx,
 		cdc:        encConfig.Marshaler,
 		mintKeeper: inflationKeeper,
+		bankKeeper: bankKeeper,
 		encConfig:  encConfig,
 	}
 }
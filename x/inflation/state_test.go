@@ -5,6 +5,7 @@
 package inflation
 
 import (
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	embank "github.com/e-money/em-ledger/hooks/bank"
 	"github.com/e-money/em-ledger/x/inflation/keeper"
 	"github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/stretchr/testify/require"
@@ -101,6 +103,277 @@ func TestModuleDestinations(t *testing.T) {
 	require.False(t, balances.AmountOf("ungm").IsZero())
 }
 
+// Verify that the newly minted tokens are split between the configured recipients
+func TestDistributionRecipients(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper := createTestComponents(t)
+
+	mintBalance(t, ctx, bankKeeper, coins("400000000eur,400000000chf"))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur", "chf"})
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(1, 2), "eur")
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(1, 2), "chf")
+
+	treasury := sdk.AccAddress("treasury")
+	distribution := []types.InflationRecipient{
+		{Type: types.RecipientType_Address, Address: treasury.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Type: types.RecipientType_Module, Address: "buyback", Weight: sdk.NewDecWithPrec(3, 1)},
+		{Type: types.RecipientType_CommunityPool, Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+
+	_, err := keeper.SetDistribution(ctx, "eur", distribution[:2])
+	require.Error(t, err, "weights must sum to 1")
+
+	_, err = keeper.SetDistribution(ctx, "eur", []types.InflationRecipient{
+		{Type: types.RecipientType_Address, Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(), Weight: sdk.OneDec()},
+	})
+	require.Error(t, err, "blocked address")
+
+	_, err = keeper.SetDistribution(ctx, "eur", []types.InflationRecipient{
+		{Type: types.RecipientType_Module, Address: "unknown", Weight: sdk.OneDec()},
+	})
+	require.Error(t, err, "unknown module")
+
+	_, err = keeper.SetDistribution(ctx, "eur", []types.InflationRecipient{
+		{Type: types.RecipientType_Module, Address: stakingtypes.BondedPoolName, Weight: sdk.OneDec()},
+	})
+	require.Error(t, err, "module not allowed to receive inflation")
+
+	_, err = keeper.SetDistribution(ctx, "usd", distribution)
+	require.Error(t, err, "unknown denomination")
+
+	_, err = keeper.SetDistribution(ctx, "eur", distribution)
+	require.NoError(t, err)
+	state := keeper.GetState(ctx)
+	require.Equal(t, distribution, state.FindByDenom("eur").Distribution)
+
+	for i := int64(1); i <= 10; i++ {
+		currentTime = currentTime.Add(time.Minute)
+		ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(60 + 5*i)
+		BeginBlocker(ctx, keeper)
+	}
+
+	minted := getTotalSupply(t, ctx, bankKeeper).AmountOf("eur").SubRaw(400000000)
+	require.True(t, minted.IsPositive())
+
+	var (
+		treasuryEur  = bankKeeper.GetBalance(ctx, treasury, "eur").Amount
+		buybackEur   = bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress("buyback"), "eur").Amount
+		communityEur = bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress("distribution"), "eur").Amount
+	)
+
+	require.Equal(t, minted, treasuryEur.Add(buybackEur).Add(communityEur))
+	require.True(t, treasuryEur.Sub(minted.QuoRaw(2)).Abs().LTE(sdk.NewInt(10)))
+	require.True(t, buybackEur.Sub(minted.MulRaw(3).QuoRaw(10)).Abs().LTE(sdk.NewInt(10)))
+
+	// Denominations without a distribution keep using the default destination
+	require.True(t, bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress("buyback"), "chf").IsPositive())
+	require.True(t, bankKeeper.GetBalance(ctx, treasury, "chf").IsZero())
+}
+
+// Verify that a failing distribution does not halt the chain
+func TestDistributionFailure(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper := createTestComponents(t)
+
+	mintBalance(t, ctx, bankKeeper, coins("400000000eur"))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur"})
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(1, 2), "eur")
+
+	// Blocked addresses are rejected by SetDistribution, but cannot receive coins either way
+	state := keeper.GetState(ctx)
+	state.FindByDenom("eur").Distribution = []types.InflationRecipient{
+		{Type: types.RecipientType_Address, Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(), Weight: sdk.OneDec()},
+	}
+	keeper.SetState(ctx, state)
+
+	currentTime = currentTime.Add(time.Minute)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(60)
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+
+	// The coins go to the default destination instead
+	minted := getTotalSupply(t, ctx, bankKeeper).AmountOf("eur").SubRaw(400000000)
+	require.True(t, minted.IsPositive())
+	require.Equal(t, minted, bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress("buyback"), "eur").Amount)
+}
+
+// Verify that a failing distribution only falls back with the coins that were minted
+func TestDistributionFailureWithHolders(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper := createTestComponents(t)
+
+	proxy := embank.Wrap(bankKeeper)
+	proxy.SetInterestKeeper(keeper)
+
+	acc1 := sdk.AccAddress("acc1")
+	mintBalance(t, ctx, bankKeeper, coins("4000000eur"))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, acc1, coins("4000000eur")))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur"})
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(10, 2), "eur")
+	_, err := keeper.SetDistribution(ctx, "eur", []types.InflationRecipient{
+		{Type: types.RecipientType_Holders, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Type: types.RecipientType_Address, Address: sdk.AccAddress("treasury").String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	})
+	require.NoError(t, err)
+
+	// Blocked addresses are rejected by SetDistribution, but cannot receive coins either way
+	state := keeper.GetState(ctx)
+	state.FindByDenom("eur").Distribution[1].Address = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	keeper.SetState(ctx, state)
+
+	currentTime = currentTime.Add(365 * 24 * time.Hour)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(100)
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+
+	// Only the share of the failing recipient is minted and sent to the default destination
+	minted := getTotalSupply(t, ctx, bankKeeper).AmountOf("eur").SubRaw(4000000)
+	require.Equal(t, sdk.NewInt(200000), minted)
+	require.Equal(t, minted, bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress("buyback"), "eur").Amount)
+	require.True(t, bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress(ModuleName), "eur").IsZero())
+
+	// The holders' share is paid once, through the interest index
+	require.Equal(t, sdk.NewInt(4200000), proxy.GetBalance(ctx, acc1, "eur").Amount)
+	totalSupply, err := keeper.TotalTokenSupply(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(4400000), totalSupply.AmountOf("eur"))
+}
+
+func TestInterestBearingBalances(t *testing.T) {
+	ctx, keeper, bankKeeper, _ := createTestComponents(t)
+
+	proxy := embank.Wrap(bankKeeper)
+	proxy.SetInterestKeeper(keeper)
+
+	var (
+		acc1 = sdk.AccAddress("acc1")
+		acc2 = sdk.AccAddress("acc2")
+	)
+	mintBalance(t, ctx, bankKeeper, coins("4000000eur"))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, acc1, coins("1000000eur")))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, acc2, coins("2000000eur")))
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+	BeginBlocker(ctx, keeper)
+
+	keeper.AddDenoms(ctx, []string{"eur"})
+	keeper.SetInflation(ctx, sdk.NewDecWithPrec(10, 2), "eur")
+	_, err := keeper.SetDistribution(ctx, "eur", []types.InflationRecipient{
+		{Type: types.RecipientType_Holders, Weight: sdk.OneDec()},
+	})
+	require.NoError(t, err)
+
+	// The balance of the inflation module does not earn interest
+	index, found := keeper.GetInterestIndex(ctx, "eur")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(3000000), index.SettledSupply)
+
+	currentTime = currentTime.Add(365 * 24 * time.Hour)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(100)
+	BeginBlocker(ctx, keeper)
+
+	// Interest is not minted until balances settle, but is part of the supply that inflation applies to. The index
+	// truncates a unit of the 400000eur inflation.
+	require.Equal(t, sdk.NewInt(4000000), getTotalSupply(t, ctx, bankKeeper).AmountOf("eur"))
+	totalSupply, err := keeper.TotalTokenSupply(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(4399999), totalSupply.AmountOf("eur"))
+
+	// The holders share the inflation of the total supply pro-rata
+	require.Equal(t, sdk.NewInt(1133333), proxy.GetBalance(ctx, acc1, "eur").Amount)
+	require.Equal(t, sdk.NewInt(2266666), proxy.GetBalance(ctx, acc2, "eur").Amount)
+	require.Equal(t, coins("1133333eur"), proxy.SpendableCoins(ctx, acc1))
+	require.Equal(t, coins("2266666eur"), proxy.GetAllBalances(ctx, acc2))
+	require.Equal(t, sdk.NewInt(1000000), bankKeeper.GetBalance(ctx, acc1, "eur").Amount)
+
+	// Spending the accrued interest settles the balances
+	require.NoError(t, proxy.SendCoins(ctx, acc1, acc2, coins("1133333eur")))
+	require.True(t, proxy.GetBalance(ctx, acc1, "eur").IsZero())
+	require.Equal(t, sdk.NewInt(3399999), proxy.GetBalance(ctx, acc2, "eur").Amount)
+	require.Equal(t, sdk.NewInt(3399999), bankKeeper.GetBalance(ctx, acc2, "eur").Amount)
+	require.Equal(t, sdk.NewInt(4399999), getTotalSupply(t, ctx, bankKeeper).AmountOf("eur"))
+
+	index, _ = keeper.GetInterestIndex(ctx, "eur")
+	require.Equal(t, sdk.NewInt(3399999), index.SettledSupply)
+	require.True(t, index.OutstandingInterest().IsZero())
+
+	// New holders earn interest from the time they receive their balance
+	require.NoError(t, proxy.SendCoins(ctx, acc2, acc1, coins("399999eur")))
+
+	currentTime = currentTime.Add(365 * 24 * time.Hour)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(200)
+	BeginBlocker(ctx, keeper)
+
+	// The inflation of the balances held by modules also accrues to the holders
+	require.Equal(t, sdk.NewInt(451763), proxy.GetBalance(ctx, acc1, "eur").Amount)
+	require.Equal(t, sdk.NewInt(3388234), proxy.GetBalance(ctx, acc2, "eur").Amount)
+}
+
+func TestHolderIndexing(t *testing.T) {
+	ctx, k, bankKeeper, _ := createTestComponents(t)
+
+	proxy := embank.Wrap(bankKeeper)
+	proxy.SetInterestKeeper(k)
+
+	holders := make([]sdk.AccAddress, keeper.MaxIndexedHoldersPerBlock+500)
+	mintBalance(t, ctx, bankKeeper, coins(fmt.Sprintf("%veur", 1000*(len(holders)+1))))
+	for i := range holders {
+		holders[i] = sdk.AccAddress(fmt.Sprintf("holder%04d", i))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, holders[i], coins("1000eur")))
+	}
+
+	currentTime := time.Now()
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(55)
+	BeginBlocker(ctx, k)
+
+	k.AddDenoms(ctx, []string{"eur"})
+	k.SetInflation(ctx, sdk.NewDecWithPrec(10, 2), "eur")
+	_, err := k.SetDistribution(ctx, "eur", []types.InflationRecipient{
+		{Type: types.RecipientType_Holders, Weight: sdk.OneDec()},
+	})
+	require.NoError(t, err)
+
+	// The holders are indexed in bounded batches
+	require.True(t, k.IsIndexingHolders(ctx, "eur"))
+	index, _ := k.GetInterestIndex(ctx, "eur")
+	require.True(t, index.SettledSupply.LT(sdk.NewInt(1000*int64(len(holders)))))
+
+	// Holders that have not been indexed yet are included when their balances change
+	var unindexed sdk.AccAddress
+	for _, addr := range holders {
+		if !k.HasAccountInterestIndex(ctx, "eur", addr) {
+			unindexed = addr
+			break
+		}
+	}
+	require.NotNil(t, unindexed)
+	require.NoError(t, proxy.SendCoins(ctx, unindexed, sdk.AccAddress("recipient"), coins("400eur")))
+
+	// No inflation accrues to an incomplete holder supply
+	currentTime = currentTime.Add(365 * 24 * time.Hour)
+	ctx = ctx.WithBlockTime(currentTime).WithBlockHeight(100)
+	BeginBlocker(ctx, k)
+	index, _ = k.GetInterestIndex(ctx, "eur")
+	require.True(t, index.Index.Equal(sdk.OneDec()))
+
+	EndBlocker(ctx, k)
+	require.False(t, k.IsIndexingHolders(ctx, "eur"))
+	index, _ = k.GetInterestIndex(ctx, "eur")
+	require.Equal(t, sdk.NewInt(1000*int64(len(holders))), index.SettledSupply)
+}
+
 func TestStartTimeInFuture(t *testing.T) {
 	ctx, keeper, bankKeeper, _ := createTestComponents(t)
 
@@ -169,9 +442,13 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, bankkeeper.
 		ModuleName:                     {authtypes.Minter},
 		authtypes.FeeCollectorName:     nil,
 		"buyback":                      {authtypes.Burner},
+		"distribution":                 nil,
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	}
+	for acc := range maccPerms {
+		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = true
+	}
 
 	pk := paramskeeper.NewKeeper(encConfig.Marshaler, encConfig.Amino, keyParams, tkeyParams)
 
@@ -188,7 +465,8 @@ func createTestComponents(t *testing.T) (sdk.Context, keeper.Keeper, bankkeeper.
 	stakingKeeper := mockStakingKeeper{}
 
 	inflationKeeper := NewKeeper(
-		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, "buyback", authtypes.FeeCollectorName)
+		encConfig.Marshaler, keyInflation, bankKeeper, accountKeeper, stakingKeeper, mockDistributionKeeper{bankKeeper},
+		"buyback", authtypes.FeeCollectorName, []string{"buyback"})
 
 	lastAppliedTime := time.Now().Add(-2400 * time.Hour)
 
@@ -239,6 +517,14 @@ func (m mockStakingKeeper) GetParams(_ sdk.Context) stakingtypes.Params {
 	return stakingtypes.NewParams(5*time.Minute, 40, 50, 0, "ungm")
 }
 
+type mockDistributionKeeper struct {
+	bk bankkeeper.Keeper
+}
+
+func (m mockDistributionKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bk.SendCoinsFromAccountToModule(ctx, sender, "distribution", amount)
+}
+
 func getTotalSupply(t *testing.T, ctx sdk.Context, bk bankkeeper.Keeper) sdk.Coins {
 	totalSupply, _, err := bk.GetPaginatedTotalSupply(
 		ctx, &query.PageRequest{Limit: math.MaxUint64},
@@ -0,0 +1,51 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Do not apply inflation if less than this period has elapsed since last accrual
+const MinimumMintingPeriod = 10 * time.Second
+
+// ApplyInflation accrues the inflation of all assets since the last accrual and returns the minted coins.
+func (is *InflationState) ApplyInflation(totalTokenSupply sdk.Coins, currentTime time.Time) sdk.Coins {
+	lastAccrual := is.LastAppliedTime
+	mintedCoins := sdk.Coins{}
+
+	is.LastAppliedTime = currentTime
+
+	for i, asset := range is.InflationAssets {
+		supply := totalTokenSupply.AmountOf(asset.Denom)
+
+		accum, minted := CalculateInflation(asset.Accum, supply, asset.Inflation, lastAccrual, currentTime)
+
+		if minted.IsPositive() { // Coins.IsValid() considers any coin of amount 0 to be invalid, so filter 0 coins.
+			mintedCoins = append(mintedCoins, sdk.NewCoin(asset.Denom, minted))
+		}
+
+		asset.Accum = accum
+		is.InflationAssets[i] = asset
+	}
+
+	return mintedCoins.Sort()
+}
+
+// CalculateInflation returns the whole tokens minted over a period. The fraction that remains is carried over to the
+// next period in accum.
+func CalculateInflation(prevAccum sdk.Dec, supply sdk.Int, annualInflation sdk.Dec, lastAccrual, currentTime time.Time) (accum sdk.Dec, minted sdk.Int) {
+	annualNS := 365 * 24 * time.Hour.Nanoseconds()
+
+	periodNS := sdk.NewDec(currentTime.Sub(lastAccrual).Nanoseconds())
+	accum = annualInflation.MulInt(supply).Mul(periodNS).Add(prevAccum)
+
+	minted = accum.Quo(sdk.NewDec(annualNS)).TruncateInt()
+	accum = accum.Sub(minted.MulRaw(annualNS).ToDec())
+
+	return
+}
@@ -2,7 +2,7 @@
 //
 // Please contact partners@e-money.com for licensing related questions.
 
-package inflation
+package types
 
 import (
 	"math/rand"
@@ -24,7 +24,7 @@ func TestYearHourlyAccrual(t *testing.T) {
 
 	totalMinted := sdk.ZeroInt()
 	for i := 0; i < 365*24; i++ {
-		accum, minted = calculateInflation(accum, supply, annualInflation, lastAccrual, lastAccrual.Add(time.Hour))
+		accum, minted = CalculateInflation(accum, supply, annualInflation, lastAccrual, lastAccrual.Add(time.Hour))
 		lastAccrual = lastAccrual.Add(time.Hour)
 		totalMinted = totalMinted.Add(minted)
 	}
@@ -59,7 +59,7 @@ func TestRandomBlockTimes(t *testing.T) {
 
 		totalDuration = totalDuration + d
 
-		accum, minted = calculateInflation(accum, supply, annualInterest, lastAccrual, lastAccrual.Add(d))
+		accum, minted = CalculateInflation(accum, supply, annualInterest, lastAccrual, lastAccrual.Add(d))
 		lastAccrual = lastAccrual.Add(d)
 		totalMinted = totalMinted.Add(minted)
 
@@ -89,7 +89,7 @@ func TestMultipleCoinsAccrual(t *testing.T) {
 
 	for i := 0; i < 365*24*60; i++ {
 		currentTime = currentTime.Add(time.Minute)
-		mintedCoins := applyInflation(&state, supply, currentTime)
+		mintedCoins := state.ApplyInflation(supply, currentTime)
 
 		// Add the minted coins to the total supply
 		supply = supply.Add(mintedCoins...)
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateDistribution checks that all recipients are well-formed and that their weights sum to one. An empty
+// distribution is valid and selects the default destination of the minted tokens.
+func ValidateDistribution(recipients []InflationRecipient) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, recipient := range recipients {
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("weight of inflation recipient %v must be positive", recipient)
+		}
+
+		switch recipient.Type {
+		case RecipientType_Address:
+			if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+				return fmt.Errorf("invalid inflation recipient address %q: %w", recipient.Address, err)
+			}
+		case RecipientType_Module:
+			if recipient.Address == "" {
+				return fmt.Errorf("module name of inflation recipient is missing")
+			}
+		case RecipientType_CommunityPool, RecipientType_Holders:
+			if recipient.Address != "" {
+				return fmt.Errorf("%v inflation recipient must not have an address: %v", recipient.Type, recipient.Address)
+			}
+		default:
+			return fmt.Errorf("unknown inflation recipient type: %v", recipient.Type)
+		}
+
+		key := fmt.Sprintf("%v/%v", recipient.Type, recipient.Address)
+		if seen[key] {
+			return fmt.Errorf("duplicate inflation recipient: %v", recipient)
+		}
+		seen[key] = true
+
+		total = total.Add(recipient.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("weights of inflation recipients must sum to 1: %v", total)
+	}
+
+	return nil
+}
+
+// HasHolders reports whether the distribution makes the balances of the denomination interest-bearing.
+func HasHolders(recipients []InflationRecipient) bool {
+	for _, recipient := range recipients {
+		if recipient.Type == RecipientType_Holders {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HoldersShare returns the part of the minted amount that accrues to the holders of the denomination.
+func HoldersShare(amount sdk.Int, recipients []InflationRecipient) sdk.Int {
+	shares := SplitByWeight(amount, recipients)
+	for i, recipient := range recipients {
+		if recipient.Type == RecipientType_Holders {
+			return shares[i]
+		}
+	}
+
+	return sdk.ZeroInt()
+}
+
+// SplitByWeight divides an amount between the recipients in proportion to their weights. Amounts are truncated and
+// the remainder is given to the last recipient, so that the shares add up to the full amount.
+func SplitByWeight(amount sdk.Int, recipients []InflationRecipient) []sdk.Int {
+	shares := make([]sdk.Int, len(recipients))
+
+	remaining := amount
+	for i, recipient := range recipients {
+		if i == len(recipients)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		shares[i] = recipient.Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(shares[i])
+	}
+
+	return shares
+}
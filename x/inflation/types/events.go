@@ -7,7 +7,11 @@ package types
 // Minting module event types
 const (
 	EventTypeInflation = ModuleName
+	EventTypeInterest  = "interest"
 
-	AttributeKeyAction = "action"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction        = "action"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyRecipientType = "recipient_type"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyIndex         = "index"
 )
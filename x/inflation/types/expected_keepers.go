@@ -5,6 +5,8 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -17,12 +19,19 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	BlockedAddr(addr sdk.AccAddress) bool
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
+	Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error)
 }
 type StakingKeeper interface {
 	GetParams(ctx sdk.Context) stakingtypes.Params
 }
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -26,7 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	// todo (reviewer): yaml naming is a bit inconsistent. state contains assets
-	InflationState InflationState `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets" yaml:"assets"`
+	InflationState         InflationState         `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets" yaml:"assets"`
+	InterestIndexes        []InterestIndex        `protobuf:"bytes,2,rep,name=interest_indexes,json=interestIndexes,proto3" json:"interest_indexes" yaml:"interest_indexes"`
+	AccountInterestIndexes []AccountInterestIndex `protobuf:"bytes,3,rep,name=account_interest_indexes,json=accountInterestIndexes,proto3" json:"account_interest_indexes" yaml:"account_interest_indexes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,20 @@ func (m *GenesisState) GetInflationState() InflationState {
 	return InflationState{}
 }
 
+func (m *GenesisState) GetInterestIndexes() []InterestIndex {
+	if m != nil {
+		return m.InterestIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountInterestIndexes() []AccountInterestIndex {
+	if m != nil {
+		return m.AccountInterestIndexes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.inflation.v1.GenesisState")
 }
@@ -76,23 +92,29 @@ func init() {
 func init() { proto.RegisterFile("em/inflation/v1/genesis.proto", fileDescriptor_8d206018450f821a) }
 
 var fileDescriptor_8d206018450f821a = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0xe3, 0xa5, 0x43, 0xf8, 0x93, 0x2a, 0x06, 0x54, 0x09, 0x1b, 0x65, 0x81, 0xa5, 0xbe,
-	0x0a, 0x6c, 0x8c, 0x5d, 0x80, 0x15, 0x36, 0x26, 0x9c, 0x70, 0x31, 0x96, 0x62, 0xdf, 0xa8, 0x36,
-	0x11, 0x79, 0x0b, 0x1e, 0xab, 0x63, 0x47, 0xa6, 0x08, 0x25, 0x6f, 0xc0, 0x13, 0x20, 0x92, 0xf2,
-	0xd3, 0x6c, 0xf6, 0x3d, 0xe7, 0x7e, 0xe7, 0x9e, 0xf8, 0x18, 0x2d, 0x18, 0xf7, 0x54, 0xa8, 0x60,
-	0xc8, 0x41, 0x95, 0x82, 0x46, 0x87, 0xde, 0x78, 0x59, 0x2e, 0x29, 0xd0, 0xf4, 0x00, 0xad, 0xfc,
-	0x95, 0x65, 0x95, 0xce, 0x0e, 0x35, 0x69, 0xea, 0x35, 0xf8, 0x7e, 0x0d, 0xb6, 0x19, 0xcf, 0xc9,
-	0x5b, 0xf2, 0x90, 0x29, 0x8f, 0x50, 0xa5, 0x19, 0x06, 0x95, 0x42, 0x4e, 0xc6, 0x6d, 0x74, 0x31,
-	0x4e, 0xf9, 0x63, 0xf6, 0x86, 0xa4, 0x8c, 0x77, 0xaf, 0x86, 0xe0, 0xbb, 0xa0, 0x02, 0x4e, 0x1f,
-	0xe2, 0x89, 0xf2, 0x1e, 0x83, 0x3f, 0x62, 0x27, 0xec, 0x6c, 0xe7, 0x5c, 0xc8, 0xd1, 0x21, 0xf2,
-	0xe6, 0xe7, 0xd3, 0x2f, 0x2c, 0x4e, 0x57, 0x8d, 0x88, 0xda, 0x46, 0xec, 0x6f, 0xcf, 0x3f, 0x1b,
-	0xb1, 0x57, 0x2b, 0x5b, 0x5c, 0x26, 0x03, 0x2e, 0xb9, 0xdd, 0x70, 0x17, 0xd7, 0xab, 0x96, 0xb3,
-	0x75, 0xcb, 0xd9, 0x47, 0xcb, 0xd9, 0x5b, 0xc7, 0xa3, 0x75, 0xc7, 0xa3, 0xf7, 0x8e, 0x47, 0xf7,
-	0x52, 0x9b, 0xf0, 0xfc, 0x92, 0xc9, 0x9c, 0x2c, 0xe0, 0xdc, 0x92, 0xc3, 0x1a, 0xd0, 0xce, 0x0b,
-	0x7c, 0xd4, 0xb8, 0x84, 0xd7, 0x7f, 0x45, 0x42, 0x5d, 0xa2, 0xcf, 0x26, 0x7d, 0x85, 0x8b, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xf5, 0x69, 0xdd, 0x4b, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x6f, 0xe2, 0x30,
+	0x18, 0x86, 0x13, 0x90, 0x18, 0xc2, 0xdd, 0x71, 0x8a, 0x4e, 0x57, 0x84, 0x54, 0x1b, 0x45, 0xaa,
+	0x60, 0xc1, 0x56, 0xe8, 0xd6, 0xad, 0x2c, 0x2d, 0x2b, 0xdd, 0xba, 0x50, 0x27, 0x7c, 0x4d, 0x5d,
+	0x61, 0x1b, 0x61, 0x83, 0xe0, 0x1f, 0x74, 0xec, 0xcf, 0x62, 0x64, 0xec, 0x14, 0x55, 0x61, 0xe8,
+	0xce, 0x2f, 0xa8, 0x48, 0x02, 0x05, 0x4a, 0xb7, 0xc4, 0xef, 0xfb, 0x3d, 0x8f, 0xad, 0xcf, 0x39,
+	0x07, 0x41, 0xb9, 0x7c, 0x1c, 0x32, 0xc3, 0x95, 0xa4, 0x53, 0x9f, 0x46, 0x20, 0x41, 0x73, 0x4d,
+	0x46, 0x63, 0x65, 0x94, 0x5b, 0x01, 0x41, 0x76, 0x31, 0x99, 0xfa, 0xb5, 0x7f, 0x91, 0x8a, 0x54,
+	0x9a, 0xd1, 0xcd, 0x57, 0x56, 0xab, 0xa1, 0x50, 0x69, 0xa1, 0x34, 0x0d, 0x98, 0x06, 0x3a, 0xf5,
+	0x03, 0x30, 0xcc, 0xa7, 0xa1, 0xe2, 0x32, 0xcf, 0xf1, 0xb1, 0xe5, 0x8b, 0x99, 0x16, 0xbc, 0x8f,
+	0x82, 0xf3, 0xeb, 0x26, 0x33, 0xdf, 0x19, 0x66, 0xc0, 0x7d, 0x70, 0x4a, 0x4c, 0x6b, 0x30, 0xba,
+	0x6a, 0xd7, 0xed, 0x66, 0xb9, 0x8d, 0xc9, 0xd1, 0x4d, 0x48, 0x77, 0xfb, 0x93, 0x0e, 0x74, 0x1a,
+	0x8b, 0x18, 0x5b, 0x49, 0x8c, 0xff, 0x1c, 0x9e, 0xaf, 0x63, 0xfc, 0x7b, 0xce, 0xc4, 0xf0, 0xca,
+	0xcb, 0x70, 0x5e, 0x2f, 0xe7, 0xba, 0xcf, 0xce, 0x5f, 0x2e, 0x0d, 0x8c, 0x41, 0x9b, 0x3e, 0x97,
+	0x03, 0x98, 0x81, 0xae, 0x16, 0xea, 0xc5, 0x66, 0xb9, 0x8d, 0x4e, 0xb8, 0xb2, 0x62, 0x77, 0xd3,
+	0xeb, 0xe0, 0x8d, 0x6a, 0x1d, 0xe3, 0xb3, 0x0c, 0x7c, 0x4c, 0xf1, 0x7a, 0x15, 0xbe, 0xdf, 0x07,
+	0xed, 0xbe, 0xd8, 0x4e, 0x95, 0x85, 0xa1, 0x9a, 0x48, 0xd3, 0xdf, 0x66, 0x3b, 0x69, 0x31, 0x95,
+	0x5e, 0x7c, 0x93, 0x5e, 0x67, 0x03, 0x87, 0xee, 0x46, 0xee, 0xc6, 0xf9, 0xa3, 0x7e, 0x80, 0x7a,
+	0xbd, 0xff, 0xec, 0xc4, 0x38, 0xe8, 0xce, 0xed, 0x22, 0x41, 0xf6, 0x32, 0x41, 0xf6, 0x7b, 0x82,
+	0xec, 0xd7, 0x15, 0xb2, 0x96, 0x2b, 0x64, 0xbd, 0xad, 0x90, 0x75, 0x4f, 0x22, 0x6e, 0x9e, 0x26,
+	0x01, 0x09, 0x95, 0xa0, 0xd0, 0x12, 0x4a, 0xc2, 0x9c, 0x82, 0x68, 0x0d, 0x61, 0x10, 0xc1, 0x98,
+	0xce, 0xf6, 0x16, 0x68, 0xe6, 0x23, 0xd0, 0x41, 0x29, 0x5d, 0xdd, 0xe5, 0xe7, 0x00, 0x38, 0xd7,
+	0xc6, 0x0d, 0x43, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +137,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountInterestIndexes) > 0 {
+		for iNdEx := len(m.AccountInterestIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountInterestIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InterestIndexes) > 0 {
+		for iNdEx := len(m.InterestIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.InflationState.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +197,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.InflationState.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InterestIndexes) > 0 {
+		for _, e := range m.InterestIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountInterestIndexes) > 0 {
+		for _, e := range m.AccountInterestIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +280,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestIndexes = append(m.InterestIndexes, InterestIndex{})
+			if err := m.InterestIndexes[len(m.InterestIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountInterestIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountInterestIndexes = append(m.AccountInterestIndexes, AccountInterestIndex{})
+			if err := m.AccountInterestIndexes[len(m.AccountInterestIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -29,10 +29,51 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type RecipientType int32
+
+const (
+	RecipientType_Unspecified RecipientType = 0
+	// An account, e.g. the treasury of the issuer
+	RecipientType_Address RecipientType = 1
+	// A module account, e.g. a reward pool
+	RecipientType_Module        RecipientType = 2
+	RecipientType_CommunityPool RecipientType = 3
+	// The holders of the denomination, pro-rata to their balances. The tokens
+	// accrue through an interest index and are minted when a balance settles.
+	RecipientType_Holders RecipientType = 4
+)
+
+var RecipientType_name = map[int32]string{
+	0: "RECIPIENT_TYPE_UNSPECIFIED",
+	1: "RECIPIENT_TYPE_ADDRESS",
+	2: "RECIPIENT_TYPE_MODULE",
+	3: "RECIPIENT_TYPE_COMMUNITY_POOL",
+	4: "RECIPIENT_TYPE_HOLDERS",
+}
+
+var RecipientType_value = map[string]int32{
+	"RECIPIENT_TYPE_UNSPECIFIED":    0,
+	"RECIPIENT_TYPE_ADDRESS":        1,
+	"RECIPIENT_TYPE_MODULE":         2,
+	"RECIPIENT_TYPE_COMMUNITY_POOL": 3,
+	"RECIPIENT_TYPE_HOLDERS":        4,
+}
+
+func (x RecipientType) String() string {
+	return proto.EnumName(RecipientType_name, int32(x))
+}
+
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{0}
+}
+
 type InflationAsset struct {
 	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation" yaml:"inflation"`
 	Accum     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=accum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accum" yaml:"accum"`
+	// distribution routes the minted tokens to weighted recipients. When empty,
+	// the tokens go to the default destination of the module.
+	Distribution []InflationRecipient `protobuf:"bytes,4,rep,name=distribution,proto3" json:"distribution" yaml:"distribution"`
 }
 
 func (m *InflationAsset) Reset()         { *m = InflationAsset{} }
@@ -75,6 +116,176 @@ func (m *InflationAsset) GetDenom() string {
 	return ""
 }
 
+func (m *InflationAsset) GetDistribution() []InflationRecipient {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+type InflationRecipient struct {
+	Type RecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=em.inflation.v1.RecipientType" json:"type,omitempty" yaml:"type"`
+	// address is the bech32 address of Address recipients and the module name of
+	// Module recipients.
+	Address string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{1}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetType() RecipientType {
+	if m != nil {
+		return m.Type
+	}
+	return RecipientType_Unspecified
+}
+
+func (m *InflationRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// InterestIndex tracks the inflation that has accrued to the holders of an
+// interest-bearing denomination. The balance of an account grows by the ratio
+// of the index to the index at which the account last settled.
+type InterestIndex struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Index github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index" yaml:"index"`
+	// scaled_supply is the sum of the holder balances divided by the index at
+	// which each holder last settled.
+	ScaledSupply github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=scaled_supply,json=scaledSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"scaled_supply" yaml:"scaled_supply"`
+	// settled_supply is the sum of the holder balances held by the bank module.
+	SettledSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=settled_supply,json=settledSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"settled_supply" yaml:"settled_supply"`
+}
+
+func (m *InterestIndex) Reset()         { *m = InterestIndex{} }
+func (m *InterestIndex) String() string { return proto.CompactTextString(m) }
+func (*InterestIndex) ProtoMessage()    {}
+func (*InterestIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{2}
+}
+func (m *InterestIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestIndex.Merge(m, src)
+}
+func (m *InterestIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestIndex proto.InternalMessageInfo
+
+func (m *InterestIndex) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AccountInterestIndex is the interest index at which an account last settled
+// its balance of an interest-bearing denomination.
+type AccountInterestIndex struct {
+	Denom   string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Address string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Index   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index" yaml:"index"`
+}
+
+func (m *AccountInterestIndex) Reset()         { *m = AccountInterestIndex{} }
+func (m *AccountInterestIndex) String() string { return proto.CompactTextString(m) }
+func (*AccountInterestIndex) ProtoMessage()    {}
+func (*AccountInterestIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25d8d858c54688c8, []int{3}
+}
+func (m *AccountInterestIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountInterestIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountInterestIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountInterestIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountInterestIndex.Merge(m, src)
+}
+func (m *AccountInterestIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountInterestIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountInterestIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountInterestIndex proto.InternalMessageInfo
+
+func (m *AccountInterestIndex) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AccountInterestIndex) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type InflationState struct {
 	LastAppliedTime   time.Time                              `protobuf:"bytes,1,opt,name=last_applied,json=lastApplied,proto3,stdtime" json:"last_applied" yaml:"last_applied"`
 	LastAppliedHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=last_applied_height,json=lastAppliedHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_applied_height" yaml:"last_applied_height"`
@@ -84,7 +295,7 @@ type InflationState struct {
 func (m *InflationState) Reset()      { *m = InflationState{} }
 func (*InflationState) ProtoMessage() {}
 func (*InflationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25d8d858c54688c8, []int{1}
+	return fileDescriptor_25d8d858c54688c8, []int{4}
 }
 func (m *InflationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,44 +339,72 @@ func (m *InflationState) GetInflationAssets() []InflationAsset {
 }
 
 func init() {
+	proto.RegisterEnum("em.inflation.v1.RecipientType", RecipientType_name, RecipientType_value)
 	proto.RegisterType((*InflationAsset)(nil), "em.inflation.v1.InflationAsset")
+	proto.RegisterType((*InflationRecipient)(nil), "em.inflation.v1.InflationRecipient")
+	proto.RegisterType((*InterestIndex)(nil), "em.inflation.v1.InterestIndex")
+	proto.RegisterType((*AccountInterestIndex)(nil), "em.inflation.v1.AccountInterestIndex")
 	proto.RegisterType((*InflationState)(nil), "em.inflation.v1.InflationState")
 }
 
 func init() { proto.RegisterFile("em/inflation/v1/inflation.proto", fileDescriptor_25d8d858c54688c8) }
 
 var fileDescriptor_25d8d858c54688c8 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xed, 0x86, 0x56, 0xaa, 0x53, 0x68, 0x71, 0x19, 0x22, 0x0f, 0xbe, 0xea, 0x86, 0x2a,
-	0x4b, 0xee, 0x94, 0xb0, 0x75, 0x40, 0xaa, 0xc5, 0xd0, 0x4a, 0x9d, 0x4c, 0x27, 0x96, 0x72, 0x76,
-	0xde, 0x3a, 0x16, 0x3e, 0x9f, 0xe9, 0x5d, 0x22, 0x22, 0xf1, 0x21, 0x3a, 0x32, 0xf2, 0x71, 0x3a,
-	0x76, 0x44, 0x0c, 0x07, 0x4a, 0x36, 0x26, 0xe4, 0x4f, 0x80, 0x72, 0xe7, 0x34, 0x2e, 0x4c, 0x9d,
-	0xec, 0xf7, 0x8f, 0x7f, 0xef, 0xfb, 0x3c, 0x77, 0xf6, 0x10, 0x70, 0x9a, 0x97, 0xd7, 0x05, 0x53,
-	0xb9, 0x28, 0xe9, 0x6c, 0xb8, 0x09, 0x48, 0x75, 0x23, 0x94, 0xf0, 0xf7, 0x81, 0x93, 0x4d, 0x6e,
-	0x36, 0x0c, 0x5e, 0x65, 0x22, 0x13, 0xa6, 0x46, 0x57, 0x6f, 0xb6, 0x2d, 0x08, 0x53, 0x21, 0xb9,
-	0x90, 0x34, 0x61, 0x12, 0xe8, 0x6c, 0x98, 0x80, 0x62, 0x43, 0x9a, 0x8a, 0xbc, 0xc1, 0x04, 0x28,
-	0x13, 0x22, 0x2b, 0x80, 0x9a, 0x28, 0x99, 0x5e, 0x53, 0x95, 0x73, 0x90, 0x8a, 0xf1, 0xca, 0x36,
-	0xe0, 0x3f, 0xae, 0xf7, 0xe2, 0x7c, 0x3d, 0xe7, 0x54, 0x4a, 0x50, 0xfe, 0xb1, 0xb7, 0x3d, 0x86,
-	0x52, 0xf0, 0x9e, 0x7b, 0xe4, 0xf6, 0x77, 0xa3, 0x83, 0x5a, 0xa3, 0xbd, 0x39, 0xe3, 0xc5, 0x09,
-	0x36, 0x69, 0x1c, 0xdb, 0xb2, 0xff, 0xc1, 0xdb, 0x7d, 0xd8, 0xb0, 0xb7, 0x65, 0x7a, 0xa3, 0x3b,
-	0x8d, 0x9c, 0x1f, 0x1a, 0x1d, 0x67, 0xb9, 0x9a, 0x4c, 0x13, 0x92, 0x0a, 0x4e, 0x9b, 0x0d, 0xed,
-	0x63, 0x20, 0xc7, 0x1f, 0xa9, 0x9a, 0x57, 0x20, 0xc9, 0x5b, 0x48, 0x6b, 0x8d, 0x0e, 0x2c, 0xf9,
-	0x01, 0x84, 0xe3, 0x0d, 0xd4, 0xbf, 0xf4, 0xb6, 0x59, 0x9a, 0x4e, 0x79, 0xaf, 0x63, 0xe8, 0x6f,
-	0x9e, 0x4c, 0x6f, 0xf6, 0x36, 0x10, 0x1c, 0x5b, 0x18, 0xfe, 0xbd, 0xd5, 0x92, 0xfc, 0x4e, 0x31,
-	0x05, 0xfe, 0x27, 0x6f, 0xaf, 0x60, 0x52, 0x5d, 0xb1, 0xaa, 0x2a, 0x72, 0x18, 0x1b, 0xe5, 0xdd,
-	0x51, 0x40, 0xac, 0x7b, 0x64, 0xed, 0x1e, 0xb9, 0x5c, 0xbb, 0x17, 0x8d, 0x56, 0xbb, 0x2c, 0x34,
-	0xda, 0xbf, 0x60, 0x52, 0x9d, 0xda, 0xcf, 0x56, 0xd5, 0x5a, 0xa3, 0x43, 0x3b, 0xb4, 0x0d, 0xc4,
-	0xb7, 0x3f, 0x91, 0x1b, 0x77, 0x8b, 0x4d, 0xaf, 0xff, 0xc5, 0x3b, 0x6c, 0x77, 0x5c, 0x4d, 0x20,
-	0xcf, 0x26, 0xaa, 0xf1, 0xf1, 0xe2, 0x09, 0x4a, 0xcf, 0x4b, 0x55, 0x6b, 0x14, 0xfc, 0x3f, 0xb4,
-	0x41, 0xe2, 0xf8, 0x65, 0x6b, 0xee, 0x99, 0xc9, 0xf9, 0xcc, 0xdb, 0x61, 0xab, 0xc3, 0x96, 0xbd,
-	0xce, 0x51, 0xa7, 0xdf, 0x1d, 0x21, 0xf2, 0xcf, 0x7d, 0x23, 0x8f, 0x2f, 0x45, 0xd4, 0x5f, 0xeb,
-	0x7d, 0x9c, 0x97, 0xb5, 0x46, 0xcf, 0x1b, 0x93, 0x4d, 0x8c, 0xe3, 0x06, 0x7c, 0xf2, 0xec, 0xeb,
-	0x37, 0xe4, 0x44, 0x67, 0x77, 0x8b, 0xd0, 0xbd, 0x5f, 0x84, 0xee, 0xaf, 0x45, 0xe8, 0xde, 0x2e,
-	0x43, 0xe7, 0x7e, 0x19, 0x3a, 0xdf, 0x97, 0xa1, 0xf3, 0x9e, 0xb4, 0xb4, 0xc1, 0x80, 0x8b, 0x12,
-	0xe6, 0x14, 0xf8, 0xa0, 0x80, 0x71, 0x06, 0x37, 0xf4, 0x73, 0xeb, 0xf7, 0x30, 0x3a, 0x93, 0x1d,
-	0x73, 0x0a, 0xaf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xef, 0x3d, 0xfd, 0xd2, 0x3b, 0x03, 0x00,
-	0x00,
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x24, 0xed, 0x6a, 0x27, 0x4d, 0x9b, 0x9d, 0xed, 0xa2, 0xc8, 0x08, 0x3b, 0x1a,
+	0xc4, 0x52, 0x21, 0x6a, 0xab, 0x85, 0xd3, 0x1e, 0x40, 0xf9, 0xb5, 0xd4, 0x52, 0xda, 0x44, 0x93,
+	0x54, 0x68, 0xb9, 0x04, 0xc7, 0x9e, 0xa6, 0xd6, 0xda, 0x1e, 0x93, 0x99, 0x94, 0x8d, 0xc4, 0x5f,
+	0xd0, 0xd3, 0x1e, 0xb9, 0x54, 0xe2, 0xc0, 0x81, 0xbf, 0x83, 0xd3, 0x1e, 0xf7, 0x84, 0x10, 0x07,
+	0xb3, 0x6a, 0x6f, 0x7b, 0x8c, 0xc4, 0x1d, 0x65, 0xc6, 0x49, 0x9c, 0x16, 0x24, 0x02, 0x9c, 0x92,
+	0x99, 0x79, 0xf3, 0xf9, 0xbe, 0xf9, 0xbe, 0x99, 0x67, 0xa0, 0x93, 0xc0, 0xf4, 0xc2, 0x33, 0xdf,
+	0xe6, 0x1e, 0x0d, 0xcd, 0x8b, 0x83, 0xe5, 0xc0, 0x88, 0x46, 0x94, 0x53, 0xb8, 0x43, 0x02, 0x63,
+	0x39, 0x77, 0x71, 0xa0, 0xee, 0x0e, 0xe9, 0x90, 0x8a, 0x35, 0x73, 0xf6, 0x4f, 0x86, 0xa9, 0x9a,
+	0x43, 0x59, 0x40, 0x99, 0x39, 0xb0, 0x19, 0x31, 0x2f, 0x0e, 0x06, 0x84, 0xdb, 0x07, 0xa6, 0x43,
+	0xbd, 0x04, 0xa3, 0xea, 0x43, 0x4a, 0x87, 0x3e, 0x31, 0xc5, 0x68, 0x30, 0x3e, 0x33, 0xb9, 0x17,
+	0x10, 0xc6, 0xed, 0x20, 0x92, 0x01, 0xe8, 0x97, 0x2c, 0xd8, 0xb6, 0xe6, 0x3a, 0x55, 0xc6, 0x08,
+	0x87, 0x8f, 0xc1, 0x86, 0x4b, 0x42, 0x1a, 0x94, 0x95, 0x8a, 0xb2, 0x77, 0xbf, 0x56, 0x9a, 0xc6,
+	0xfa, 0xd6, 0xc4, 0x0e, 0xfc, 0x27, 0x48, 0x4c, 0x23, 0x2c, 0x97, 0xe1, 0xd7, 0xe0, 0xfe, 0x22,
+	0xc3, 0x72, 0x56, 0xc4, 0xd6, 0x5e, 0xc5, 0x7a, 0xe6, 0xb7, 0x58, 0x7f, 0x3c, 0xf4, 0xf8, 0xf9,
+	0x78, 0x60, 0x38, 0x34, 0x30, 0x93, 0x0c, 0xe5, 0xcf, 0x3e, 0x73, 0x9f, 0x9b, 0x7c, 0x12, 0x11,
+	0x66, 0x34, 0x88, 0x33, 0x8d, 0xf5, 0x92, 0x24, 0x2f, 0x40, 0x08, 0x2f, 0xa1, 0xb0, 0x07, 0x36,
+	0x6c, 0xc7, 0x19, 0x07, 0xe5, 0x9c, 0xa0, 0x7f, 0xb6, 0x36, 0x3d, 0xc9, 0x5b, 0x40, 0x10, 0x96,
+	0x30, 0xe8, 0x82, 0x2d, 0xd7, 0x63, 0x7c, 0xe4, 0x0d, 0xc6, 0x22, 0xf5, 0x7c, 0x25, 0xb7, 0x57,
+	0x38, 0x7c, 0xdf, 0xb8, 0xe5, 0xb8, 0xb1, 0xb0, 0x05, 0x13, 0xc7, 0x8b, 0x3c, 0x12, 0xf2, 0xda,
+	0xbb, 0xb3, 0x0c, 0xa6, 0xb1, 0xfe, 0x30, 0xf1, 0x23, 0x85, 0x41, 0x78, 0x85, 0x8a, 0xde, 0x28,
+	0x00, 0xde, 0x25, 0xc0, 0x3a, 0xc8, 0xcf, 0x12, 0x14, 0xde, 0x6e, 0x1f, 0x6a, 0x77, 0x44, 0x17,
+	0x91, 0xbd, 0x49, 0x44, 0x6a, 0x3b, 0xd3, 0x58, 0x2f, 0x48, 0xad, 0xd9, 0x2e, 0x84, 0xc5, 0x66,
+	0xf8, 0x31, 0xb8, 0x67, 0xbb, 0xee, 0x88, 0x30, 0x96, 0xf8, 0x0e, 0xa7, 0xb1, 0xbe, 0x9d, 0x9c,
+	0x55, 0x2e, 0x20, 0x3c, 0x0f, 0x81, 0x5f, 0x82, 0xcd, 0x6f, 0x89, 0x37, 0x3c, 0xe7, 0x89, 0x8d,
+	0x9f, 0xaf, 0x6d, 0x63, 0x51, 0xa2, 0x25, 0x05, 0xe1, 0x04, 0x87, 0xde, 0x66, 0x41, 0xd1, 0x0a,
+	0x39, 0x19, 0x11, 0xc6, 0xad, 0xd0, 0x25, 0x2f, 0xfe, 0xf1, 0xd5, 0xe9, 0x81, 0x0d, 0x6f, 0xb6,
+	0xa1, 0x9c, 0xfd, 0x6f, 0x85, 0x15, 0x10, 0x84, 0x25, 0x0c, 0x3e, 0x07, 0x45, 0xe6, 0xd8, 0x3e,
+	0x71, 0xfb, 0x6c, 0x1c, 0x45, 0xfe, 0x24, 0x39, 0xef, 0xd3, 0xb5, 0xe9, 0xbb, 0x92, 0xbe, 0x02,
+	0x43, 0x78, 0x4b, 0x8e, 0xbb, 0x62, 0x08, 0x43, 0xb0, 0xcd, 0x08, 0xe7, 0x29, 0xb5, 0xbc, 0x50,
+	0xfb, 0x62, 0x0d, 0x35, 0x2b, 0xe4, 0xd3, 0x58, 0x7f, 0x94, 0xa8, 0xad, 0xd0, 0x10, 0x2e, 0x26,
+	0x13, 0x52, 0x0f, 0xfd, 0xac, 0x80, 0xdd, 0xaa, 0xe3, 0xd0, 0x71, 0xc8, 0xff, 0x9d, 0xe7, 0xeb,
+	0x5d, 0x9a, 0x45, 0x85, 0x72, 0xff, 0x63, 0x85, 0xd0, 0xdb, 0x74, 0xb7, 0xe9, 0x72, 0x9b, 0x13,
+	0xf8, 0x0d, 0xd8, 0xf2, 0x6d, 0xc6, 0xfb, 0x76, 0x14, 0xf9, 0x1e, 0x71, 0xc5, 0x29, 0x0a, 0x87,
+	0xaa, 0x21, 0x1b, 0x97, 0x31, 0x6f, 0x5c, 0x46, 0x6f, 0xde, 0xb8, 0x6a, 0x87, 0xb3, 0x5c, 0xae,
+	0x63, 0x7d, 0xa7, 0x65, 0x33, 0x5e, 0x95, 0xdb, 0x66, 0xab, 0xcb, 0x77, 0x99, 0x06, 0xa2, 0x97,
+	0xbf, 0xeb, 0x0a, 0x2e, 0xf8, 0xcb, 0x58, 0xf8, 0x1d, 0x78, 0x98, 0x8e, 0xe8, 0x9f, 0xcb, 0xd7,
+	0x21, 0x5d, 0x69, 0xad, 0x5d, 0x3f, 0xf5, 0xae, 0x68, 0x82, 0x44, 0xf8, 0x41, 0x4a, 0xf7, 0x48,
+	0xcc, 0x41, 0x1b, 0x6c, 0xda, 0x8c, 0x11, 0xce, 0xca, 0x39, 0xd1, 0x78, 0xf4, 0xbf, 0x6f, 0x3c,
+	0xa2, 0x1f, 0xd7, 0xf6, 0xe6, 0xe7, 0x5d, 0x9d, 0x67, 0xcb, 0x87, 0x29, 0x79, 0x08, 0x27, 0xe0,
+	0x27, 0xf9, 0xef, 0x7f, 0xd0, 0x33, 0x1f, 0xfd, 0xa1, 0x80, 0xe2, 0x4a, 0x3b, 0x81, 0x26, 0x50,
+	0x71, 0xb3, 0x6e, 0x75, 0xac, 0xe6, 0x49, 0xaf, 0xdf, 0x7b, 0xd6, 0x69, 0xf6, 0x4f, 0x4f, 0xba,
+	0x9d, 0x66, 0xdd, 0x7a, 0x6a, 0x35, 0x1b, 0xa5, 0x8c, 0xba, 0x73, 0x79, 0x55, 0x29, 0x9c, 0x86,
+	0x2c, 0x22, 0x8e, 0x77, 0x36, 0x73, 0xea, 0x43, 0xf0, 0xce, 0xad, 0x0d, 0xd5, 0x46, 0x03, 0x37,
+	0xbb, 0xdd, 0x92, 0xa2, 0x16, 0x2e, 0xaf, 0x2a, 0xf7, 0xaa, 0xc9, 0x75, 0xf9, 0x00, 0x3c, 0xba,
+	0x15, 0x78, 0xdc, 0x6e, 0x9c, 0xb6, 0x9a, 0xa5, 0xac, 0x0a, 0x2e, 0xaf, 0x2a, 0x9b, 0xc7, 0xd4,
+	0x1d, 0xfb, 0x04, 0x7e, 0x0a, 0xde, 0xbb, 0x15, 0x56, 0x6f, 0x1f, 0x1f, 0x9f, 0x9e, 0x58, 0xbd,
+	0x67, 0xfd, 0x4e, 0xbb, 0xdd, 0x2a, 0xe5, 0xd4, 0x07, 0x97, 0x57, 0x95, 0x62, 0x9d, 0x06, 0xc1,
+	0x38, 0xf4, 0xf8, 0xa4, 0x43, 0xa9, 0xff, 0x17, 0x59, 0x1c, 0xb5, 0x5b, 0x8d, 0x26, 0xee, 0x96,
+	0xf2, 0x32, 0x8b, 0x23, 0xea, 0xbb, 0x64, 0xc4, 0xd4, 0xfc, 0x4f, 0x3f, 0x6a, 0x4a, 0xed, 0xe8,
+	0xd5, 0xb5, 0xa6, 0xbc, 0xbe, 0xd6, 0x94, 0x37, 0xd7, 0x9a, 0xf2, 0xf2, 0x46, 0xcb, 0xbc, 0xbe,
+	0xd1, 0x32, 0xbf, 0xde, 0x68, 0x99, 0xaf, 0x8c, 0x54, 0x4d, 0xc9, 0x7e, 0x40, 0x43, 0x32, 0x31,
+	0x49, 0xb0, 0xef, 0x13, 0x77, 0x48, 0x46, 0xe6, 0x8b, 0xd4, 0x17, 0x59, 0xd4, 0x77, 0xb0, 0x29,
+	0x6e, 0xdf, 0x27, 0x7f, 0x0e, 0x00, 0xdc, 0x60, 0x10, 0x9b, 0xae, 0x07, 0x00, 0x00,
 }
 
 func (m *InflationAsset) Marshal() (dAtA []byte, err error) {
@@ -188,6 +427,20 @@ func (m *InflationAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.Accum.Size()
 		i -= size
@@ -218,6 +471,158 @@ func (m *InflationAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterestIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SettledSupply.Size()
+		i -= size
+		if _, err := m.SettledSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ScaledSupply.Size()
+		i -= size
+		if _, err := m.ScaledSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Index.Size()
+		i -= size
+		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountInterestIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountInterestIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountInterestIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Index.Size()
+		i -= size
+		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InflationState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -298,29 +703,91 @@ func (m *InflationAsset) Size() (n int) {
 	n += 1 + l + sovInflation(uint64(l))
 	l = m.Accum.Size()
 	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *InflationState) Size() (n int) {
+func (m *InflationRecipient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAppliedTime)
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.LastAppliedHeight.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	if len(m.InflationAssets) > 0 {
-		for _, e := range m.InflationAssets {
-			l = e.Size()
-			n += 1 + l + sovInflation(uint64(l))
-		}
+	if m.Type != 0 {
+		n += 1 + sovInflation(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
 	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
 	return n
 }
 
-func sovInflation(x uint64) (n int) {
+func (m *InterestIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Index.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.ScaledSupply.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.SettledSupply.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *AccountInterestIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Index.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *InflationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAppliedTime)
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.LastAppliedHeight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if len(m.InflationAssets) > 0 {
+		for _, e := range m.InflationAssets {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozInflation(x uint64) (n int) {
@@ -455,6 +922,507 @@ func (m *InflationAsset) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, InflationRecipient{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaledSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScaledSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountInterestIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountInterestIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountInterestIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInflation(dAtA[iNdEx:])
@@ -88,6 +88,12 @@ func ValidateInflationState(is InflationState) error {
 		}
 	}
 
+	for _, asset := range is.InflationAssets {
+		if err := ValidateDistribution(asset.Distribution); err != nil {
+			return fmt.Errorf("inflation distribution of %v: %w", asset.Denom, err)
+		}
+	}
+
 	return nil
 }
 
@@ -98,6 +104,9 @@ func (is InflationState) String() string {
 	result.WriteString("Inflation state:\n")
 	for _, asset := range is.InflationAssets {
 		result.WriteString(fmt.Sprintf("\tDenom: %v\t\t\tInflation: %v\t\tAccum: %v\n", asset.Denom, asset.Inflation, asset.Accum))
+		for _, recipient := range asset.Distribution {
+			result.WriteString(fmt.Sprintf("\t\tRecipient: %v %v\t\tWeight: %v\n", recipient.Type, recipient.Address, recipient.Weight))
+		}
 	}
 
 	return result.String()
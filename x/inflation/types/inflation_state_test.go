@@ -46,3 +46,114 @@ func TestFindAndChangeAssetByDenom(t *testing.T) {
 
 	assert.Equal(t, sdk.NewDecWithPrec(25, 2), is.FindByDenom("caps").Inflation)
 }
+
+func TestValidateDistribution(t *testing.T) {
+	treasury := sdk.AccAddress("treasury").String()
+
+	specs := map[string]struct {
+		recipients []InflationRecipient
+		expErr     bool
+	}{
+		"default destination": {},
+		"valid": {
+			recipients: []InflationRecipient{
+				{Type: RecipientType_Address, Address: treasury, Weight: sdk.NewDecWithPrec(6, 1)},
+				{Type: RecipientType_Module, Address: "buyback", Weight: sdk.NewDecWithPrec(3, 1)},
+				{Type: RecipientType_CommunityPool, Weight: sdk.NewDecWithPrec(1, 1)},
+			},
+		},
+		"interest-bearing": {
+			recipients: []InflationRecipient{
+				{Type: RecipientType_Holders, Weight: sdk.NewDecWithPrec(8, 1)},
+				{Type: RecipientType_Address, Address: treasury, Weight: sdk.NewDecWithPrec(2, 1)},
+			},
+		},
+		"holders with address": {
+			recipients: []InflationRecipient{{Type: RecipientType_Holders, Address: treasury, Weight: sdk.OneDec()}},
+			expErr:     true,
+		},
+		"weights below 1": {
+			recipients: []InflationRecipient{{Type: RecipientType_Address, Address: treasury, Weight: sdk.NewDecWithPrec(9, 1)}},
+			expErr:     true,
+		},
+		"weights above 1": {
+			recipients: []InflationRecipient{
+				{Type: RecipientType_Address, Address: treasury, Weight: sdk.OneDec()},
+				{Type: RecipientType_CommunityPool, Weight: sdk.NewDecWithPrec(1, 1)},
+			},
+			expErr: true,
+		},
+		"zero weight": {
+			recipients: []InflationRecipient{
+				{Type: RecipientType_Address, Address: treasury, Weight: sdk.OneDec()},
+				{Type: RecipientType_CommunityPool, Weight: sdk.ZeroDec()},
+			},
+			expErr: true,
+		},
+		"duplicate recipient": {
+			recipients: []InflationRecipient{
+				{Type: RecipientType_Address, Address: treasury, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Type: RecipientType_Address, Address: treasury, Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			expErr: true,
+		},
+		"invalid address": {
+			recipients: []InflationRecipient{{Type: RecipientType_Address, Address: "invalid", Weight: sdk.OneDec()}},
+			expErr:     true,
+		},
+		"missing module name": {
+			recipients: []InflationRecipient{{Type: RecipientType_Module, Weight: sdk.OneDec()}},
+			expErr:     true,
+		},
+		"unspecified type": {
+			recipients: []InflationRecipient{{Address: treasury, Weight: sdk.OneDec()}},
+			expErr:     true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDistribution(spec.recipients)
+			if spec.expErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitByWeight(t *testing.T) {
+	recipients := []InflationRecipient{
+		{Weight: sdk.NewDecWithPrec(3333, 4)},
+		{Weight: sdk.NewDecWithPrec(3333, 4)},
+		{Weight: sdk.NewDecWithPrec(3334, 4)},
+	}
+
+	shares := SplitByWeight(sdk.NewInt(100), recipients)
+	assert.Equal(t, []sdk.Int{sdk.NewInt(33), sdk.NewInt(33), sdk.NewInt(34)}, shares)
+}
+
+func TestInterestIndex(t *testing.T) {
+	index := NewInterestIndex("eur", sdk.NewInt(1000))
+	assert.True(t, index.Accrue(sdk.NewInt(100)))
+	assert.Equal(t, sdk.NewDecWithPrec(11, 1), index.Index)
+	assert.Equal(t, sdk.NewInt(100), index.OutstandingInterest())
+
+	// A balance held since the index started earns the full interest
+	assert.Equal(t, sdk.NewInt(40), index.AccruedInterest(sdk.NewInt(400), sdk.OneDec()))
+	assert.True(t, index.AccruedInterest(sdk.NewInt(400), index.Index).IsZero())
+
+	assert.Equal(t, sdk.NewInt(40), index.Settle(sdk.NewInt(400), sdk.OneDec()))
+	assert.Equal(t, sdk.NewInt(1040), index.SettledSupply)
+	assert.Equal(t, sdk.NewInt(60), index.OutstandingInterest())
+
+	// Moving a settled balance to a module account removes it from the holders
+	index.Track(sdk.NewInt(-440))
+	assert.Equal(t, sdk.NewInt(600), index.SettledSupply)
+	assert.Equal(t, sdk.NewInt(660), index.HolderSupply())
+
+	empty := NewInterestIndex("eur", sdk.ZeroInt())
+	assert.False(t, empty.Accrue(sdk.NewInt(100)))
+	assert.Equal(t, sdk.OneDec(), empty.Index)
+}
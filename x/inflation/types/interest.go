@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewInterestIndex starts the interest index of a denomination at one. Balances that have not been settled are
+// considered to have settled at this index.
+func NewInterestIndex(denom string, holderSupply sdk.Int) InterestIndex {
+	return InterestIndex{
+		Denom:         denom,
+		Index:         sdk.OneDec(),
+		ScaledSupply:  holderSupply.ToDec(),
+		SettledSupply: holderSupply,
+	}
+}
+
+// HolderSupply returns the balances of the holders including the interest that has not been settled.
+func (ii InterestIndex) HolderSupply() sdk.Int {
+	return ii.Index.Mul(ii.ScaledSupply).TruncateInt()
+}
+
+// OutstandingInterest returns the interest that has accrued to the holders but has not been minted yet.
+func (ii InterestIndex) OutstandingInterest() sdk.Int {
+	return sdk.MaxInt(ii.HolderSupply().Sub(ii.SettledSupply), sdk.ZeroInt())
+}
+
+// Accrue distributes amount among the holders in proportion to their balances. It returns false if there are no
+// holders to accrue to.
+func (ii *InterestIndex) Accrue(amount sdk.Int) bool {
+	if !ii.ScaledSupply.IsPositive() {
+		return false
+	}
+
+	ii.Index = ii.Index.Add(amount.ToDec().Quo(ii.ScaledSupply))
+	return true
+}
+
+// AccruedInterest returns the interest earned by a balance since the account last settled at accountIndex.
+func (ii InterestIndex) AccruedInterest(balance sdk.Int, accountIndex sdk.Dec) sdk.Int {
+	if !balance.IsPositive() || accountIndex.GTE(ii.Index) {
+		return sdk.ZeroInt()
+	}
+
+	return balance.ToDec().Mul(ii.Index).Quo(accountIndex).TruncateInt().Sub(balance)
+}
+
+// Settle accounts for the interest that is paid out to a balance, which then settles at the current index.
+func (ii *InterestIndex) Settle(balance sdk.Int, accountIndex sdk.Dec) sdk.Int {
+	interest := ii.AccruedInterest(balance, accountIndex)
+	if !balance.IsPositive() {
+		return interest
+	}
+
+	scaledBefore := balance.ToDec().Quo(accountIndex)
+	scaledAfter := balance.Add(interest).ToDec().Quo(ii.Index)
+	ii.ScaledSupply = ii.ScaledSupply.Add(scaledAfter).Sub(scaledBefore)
+	ii.SettledSupply = ii.SettledSupply.Add(interest)
+
+	return interest
+}
+
+// Track accounts for a change of a settled holder balance.
+func (ii *InterestIndex) Track(delta sdk.Int) {
+	ii.ScaledSupply = ii.ScaledSupply.Add(delta.ToDec().Quo(ii.Index))
+	ii.SettledSupply = ii.SettledSupply.Add(delta)
+}
+
+func (ii InterestIndex) Validate() error {
+	if err := sdk.ValidateDenom(ii.Denom); err != nil {
+		return err
+	}
+
+	if ii.Index.IsNil() || ii.Index.LT(sdk.OneDec()) {
+		return fmt.Errorf("interest index of %v must be at least 1: %v", ii.Denom, ii.Index)
+	}
+
+	if ii.ScaledSupply.IsNil() || ii.ScaledSupply.IsNegative() {
+		return fmt.Errorf("scaled supply of %v must not be negative: %v", ii.Denom, ii.ScaledSupply)
+	}
+
+	if ii.SettledSupply.IsNil() || ii.SettledSupply.IsNegative() {
+		return fmt.Errorf("settled supply of %v must not be negative: %v", ii.Denom, ii.SettledSupply)
+	}
+
+	return nil
+}
+
+// ValidateInterestIndexes checks the interest indexes and the account indexes of a genesis state.
+func ValidateInterestIndexes(indexes []InterestIndex, accountIndexes []AccountInterestIndex) error {
+	byDenom := make(map[string]InterestIndex)
+	for _, index := range indexes {
+		if err := index.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := byDenom[index.Denom]; found {
+			return fmt.Errorf("duplicate interest index: %v", index.Denom)
+		}
+		byDenom[index.Denom] = index
+	}
+
+	seen := make(map[string]bool)
+	for _, accountIndex := range accountIndexes {
+		index, found := byDenom[accountIndex.Denom]
+		if !found {
+			return fmt.Errorf("account interest index of unknown denomination: %v", accountIndex.Denom)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(accountIndex.Address); err != nil {
+			return fmt.Errorf("invalid account interest index address %q: %w", accountIndex.Address, err)
+		}
+
+		key := accountIndex.Denom + "/" + accountIndex.Address
+		if seen[key] {
+			return fmt.Errorf("duplicate account interest index: %v", key)
+		}
+		seen[key] = true
+
+		if accountIndex.Index.IsNil() || accountIndex.Index.LT(sdk.OneDec()) || accountIndex.Index.GT(index.Index) {
+			return fmt.Errorf("account interest index of %v must be between 1 and %v: %v", key, index.Index, accountIndex.Index)
+		}
+	}
+
+	return nil
+}
@@ -4,8 +4,37 @@
 
 package types
 
-// the one key to use for the keeper store
-var MinterKey = []byte{0x00}
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	// the one key to use for the keeper store
+	MinterKey = []byte{0x00}
+
+	InterestIndexKeyPrefix        = []byte{0x01}
+	AccountInterestIndexKeyPrefix = []byte{0x02}
+	HolderIndexingKeyPrefix       = []byte{0x03}
+)
+
+func GetInterestIndexKey(denom string) []byte {
+	return append(InterestIndexKeyPrefix, []byte(denom)...)
+}
+
+// GetAccountInterestIndexPrefix returns the prefix of the account indexes of an interest-bearing denomination.
+func GetAccountInterestIndexPrefix(denom string) []byte {
+	return append(AccountInterestIndexKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+func GetAccountInterestIndexKey(denom string, addr sdk.AccAddress) []byte {
+	return append(GetAccountInterestIndexPrefix(denom), addr...)
+}
+
+// GetHolderIndexingKey returns the key of the account from which the indexing of the holders of a denomination continues.
+func GetHolderIndexingKey(denom string) []byte {
+	return append(HolderIndexingKeyPrefix, []byte(denom)...)
+}
 
 // nolint
 const (
@@ -7,22 +7,28 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,36 +116,205 @@ func (m *QueryInflationResponse) GetState() InflationState {
 	return InflationState{}
 }
 
+type QueryProjectionRequest struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// interval accrues the inflation in steps of this duration, as blocks do,
+	// so that the tokens minted in a step add to the supply of the next. The
+	// inflation accrues in a single step when it is zero.
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c188548f8d76523, []int{2}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *QueryProjectionRequest) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+type QueryProjectionResponse struct {
+	Assets []AssetProjection `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets" yaml:"assets"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c188548f8d76523, []int{3}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetAssets() []AssetProjection {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+type AssetProjection struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted" yaml:"minted"`
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply" yaml:"supply"`
+	// accum is the fraction of a token that is carried over to the next
+	// accrual, scaled by the nanoseconds of a year.
+	Accum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=accum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accum" yaml:"accum"`
+}
+
+func (m *AssetProjection) Reset()         { *m = AssetProjection{} }
+func (m *AssetProjection) String() string { return proto.CompactTextString(m) }
+func (*AssetProjection) ProtoMessage()    {}
+func (*AssetProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c188548f8d76523, []int{4}
+}
+func (m *AssetProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetProjection.Merge(m, src)
+}
+func (m *AssetProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetProjection proto.InternalMessageInfo
+
+func (m *AssetProjection) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRequest)(nil), "em.inflation.v1.QueryInflationRequest")
 	proto.RegisterType((*QueryInflationResponse)(nil), "em.inflation.v1.QueryInflationResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "em.inflation.v1.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "em.inflation.v1.QueryProjectionResponse")
+	proto.RegisterType((*AssetProjection)(nil), "em.inflation.v1.AssetProjection")
 }
 
 func init() { proto.RegisterFile("em/inflation/v1/query.proto", fileDescriptor_8c188548f8d76523) }
 
 var fileDescriptor_8c188548f8d76523 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x3f, 0x4b, 0xfb, 0x40,
-	0x18, 0xc7, 0x73, 0x3f, 0xe8, 0x0f, 0x8c, 0x82, 0x10, 0xea, 0x1f, 0x5a, 0xbd, 0x48, 0x04, 0x75,
-	0xe9, 0x1d, 0xa9, 0x9b, 0x63, 0x27, 0xc5, 0xc9, 0xba, 0xb9, 0x5d, 0xea, 0x63, 0x0c, 0xe4, 0xee,
-	0x49, 0x7b, 0xd7, 0x62, 0x46, 0x5d, 0x5d, 0x04, 0xdf, 0x54, 0xc7, 0x82, 0x8b, 0x53, 0x91, 0xd6,
-	0x57, 0xe0, 0x2b, 0x90, 0x5e, 0x6b, 0xd4, 0x28, 0xb8, 0x1d, 0xf7, 0xfd, 0xf3, 0x7c, 0x9e, 0xc7,
-	0xad, 0x83, 0xe4, 0x89, 0xba, 0x4a, 0x85, 0x49, 0x50, 0xf1, 0x41, 0xc8, 0xbb, 0x7d, 0xe8, 0xe5,
-	0x2c, 0xeb, 0xa1, 0x41, 0x6f, 0x15, 0x24, 0x2b, 0x44, 0x36, 0x08, 0x6b, 0xd5, 0x18, 0x63, 0xb4,
-	0x1a, 0x9f, 0xbd, 0xe6, 0xb6, 0x1a, 0xed, 0xa0, 0x96, 0xa8, 0x79, 0x24, 0x34, 0xf0, 0x41, 0x18,
-	0x81, 0x11, 0x21, 0xef, 0x60, 0xa2, 0x16, 0xfa, 0x56, 0x8c, 0x18, 0xa7, 0xc0, 0x45, 0x96, 0x70,
-	0xa1, 0x14, 0x1a, 0xdb, 0xa7, 0x17, 0xaa, 0x5f, 0x26, 0xf8, 0x9c, 0x68, 0x0d, 0xc1, 0x86, 0xbb,
-	0x76, 0x36, 0x83, 0x3a, 0xf9, 0xf8, 0x6f, 0x43, 0xb7, 0x0f, 0xda, 0x04, 0xe0, 0xae, 0x97, 0x05,
-	0x9d, 0xa1, 0xd2, 0xe0, 0x9d, 0xba, 0x15, 0x6d, 0x84, 0x81, 0x4d, 0xb2, 0x43, 0x0e, 0x96, 0x9b,
-	0x3e, 0x2b, 0x2d, 0xc2, 0x8a, 0xc8, 0xf9, 0xcc, 0xd6, 0xaa, 0x0e, 0xc7, 0xbe, 0xf3, 0x36, 0xf6,
-	0x57, 0x72, 0x21, 0xd3, 0xa3, 0xc0, 0x66, 0x83, 0xf6, 0xbc, 0xa3, 0x79, 0x4f, 0xdc, 0x8a, 0x9d,
-	0xe3, 0xdd, 0x12, 0x77, 0xa9, 0x48, 0x7a, 0x7b, 0x3f, 0x5a, 0x7f, 0xc5, 0xac, 0xed, 0xff, 0xe9,
-	0x9b, 0x53, 0x07, 0xbb, 0x77, 0x4f, 0xaf, 0x8f, 0xff, 0xb6, 0xbd, 0x3a, 0x87, 0x86, 0x44, 0x05,
-	0xf9, 0xf7, 0xbb, 0x58, 0x9a, 0xd6, 0xf1, 0x70, 0x42, 0xc9, 0x68, 0x42, 0xc9, 0xcb, 0x84, 0x92,
-	0x87, 0x29, 0x75, 0x46, 0x53, 0xea, 0x3c, 0x4f, 0xa9, 0x73, 0xc1, 0xe2, 0xc4, 0x5c, 0xf7, 0x23,
-	0xd6, 0x41, 0x59, 0x14, 0x80, 0x6c, 0xa4, 0x70, 0x19, 0x43, 0x8f, 0xdf, 0x7c, 0x29, 0x33, 0x79,
-	0x06, 0x3a, 0xfa, 0x6f, 0xcf, 0x7b, 0xf8, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xde, 0x43, 0x55,
-	0x03, 0x02, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xb4, 0xa9, 0xbe, 0x6e, 0x3f, 0x54, 0xb4, 0x6a, 0x69, 0x48, 0xc1, 0x8e, 0x16,
+	0x29, 0xe4, 0x12, 0xaf, 0x12, 0x6e, 0x1c, 0x40, 0x58, 0x95, 0xa0, 0xe2, 0x00, 0x98, 0x4a, 0x48,
+	0xdc, 0x1c, 0x67, 0x6b, 0x5c, 0xbc, 0x5e, 0xd7, 0xbb, 0x8e, 0xc8, 0x11, 0x6e, 0xdc, 0x2a, 0x71,
+	0x80, 0x17, 0xe1, 0x01, 0xb8, 0xf5, 0x58, 0x89, 0x0b, 0xe2, 0x10, 0x50, 0xc2, 0x13, 0xf0, 0x04,
+	0xc8, 0xbb, 0xeb, 0x38, 0x24, 0x45, 0x15, 0xe2, 0x94, 0x78, 0xff, 0x33, 0xbf, 0x99, 0xf9, 0xef,
+	0x0e, 0xd8, 0x25, 0x14, 0x87, 0xf1, 0x61, 0xe4, 0x89, 0x90, 0xc5, 0x78, 0xd8, 0xc5, 0xc7, 0x19,
+	0x49, 0x47, 0x76, 0x92, 0x32, 0xc1, 0xe0, 0x26, 0xa1, 0xf6, 0x4c, 0xb4, 0x87, 0xdd, 0xc6, 0x56,
+	0xc0, 0x02, 0x26, 0x35, 0x9c, 0xff, 0x53, 0x61, 0x0d, 0xd3, 0x67, 0x9c, 0x32, 0x8e, 0xfb, 0x1e,
+	0x27, 0x78, 0xd8, 0xed, 0x13, 0xe1, 0x75, 0xb1, 0xcf, 0xc2, 0x58, 0xeb, 0xd7, 0x02, 0xc6, 0x82,
+	0x88, 0x60, 0x2f, 0x09, 0xb1, 0x17, 0xc7, 0x4c, 0x48, 0x1e, 0x2f, 0xb2, 0xb5, 0x2a, 0xbf, 0xfa,
+	0xd9, 0x21, 0x1e, 0x64, 0xa9, 0x2a, 0xa8, 0x74, 0x6b, 0x51, 0x17, 0x21, 0x25, 0x5c, 0x78, 0x34,
+	0x29, 0x02, 0x16, 0x47, 0x28, 0x5b, 0x96, 0x01, 0x68, 0x07, 0x6c, 0x3f, 0xc9, 0xa7, 0xda, 0x2f,
+	0xce, 0x5d, 0x72, 0x9c, 0x11, 0x2e, 0x10, 0x01, 0x57, 0x16, 0x05, 0x9e, 0xb0, 0x98, 0x13, 0xf8,
+	0x10, 0xd4, 0xb8, 0xf0, 0x04, 0xa9, 0x1b, 0x4d, 0xa3, 0xbd, 0xd1, 0xb3, 0xec, 0x05, 0x27, 0xec,
+	0x59, 0xca, 0xd3, 0x3c, 0xcc, 0xd9, 0x3a, 0x1d, 0x5b, 0x95, 0x9f, 0x63, 0xeb, 0xff, 0x91, 0x47,
+	0xa3, 0xdb, 0x48, 0xe6, 0x22, 0x57, 0x31, 0xd0, 0x47, 0x43, 0xd7, 0x79, 0x9c, 0xb2, 0x23, 0xe2,
+	0xcf, 0x75, 0x00, 0xef, 0x83, 0xd5, 0x7c, 0x1c, 0x5d, 0xa6, 0x61, 0xab, 0x59, 0xed, 0x62, 0x56,
+	0xfb, 0xa0, 0x98, 0xd5, 0xd9, 0xd1, 0x15, 0x36, 0x54, 0x85, 0x3c, 0x0b, 0x9d, 0x7c, 0xb3, 0x0c,
+	0x57, 0x02, 0xa0, 0x0b, 0xfe, 0x0b, 0x63, 0x41, 0xd2, 0xa1, 0x17, 0xd5, 0xab, 0x12, 0x76, 0x75,
+	0x09, 0xb6, 0xa7, 0x8d, 0x75, 0x76, 0x35, 0x6b, 0x53, 0xb1, 0x8a, 0x44, 0xf4, 0x21, 0xe7, 0xcd,
+	0x38, 0xe8, 0x08, 0xec, 0x2c, 0xb5, 0xad, 0xfd, 0x79, 0x04, 0xd6, 0x3c, 0xce, 0x89, 0xe0, 0x75,
+	0xa3, 0xb9, 0xd2, 0xde, 0xe8, 0x35, 0x97, 0x0c, 0xba, 0x97, 0xcb, 0x65, 0xa6, 0xb3, 0xad, 0x6b,
+	0x5e, 0x52, 0x35, 0x55, 0x36, 0x72, 0x35, 0x06, 0x7d, 0xaa, 0x82, 0xcd, 0x85, 0x14, 0xd8, 0x02,
+	0xb5, 0x01, 0x89, 0x19, 0x95, 0xee, 0xac, 0x3b, 0x97, 0x4b, 0x7f, 0xe5, 0x31, 0x72, 0x95, 0x0c,
+	0x9f, 0x81, 0x35, 0x9a, 0x37, 0x3d, 0x90, 0x93, 0xaf, 0x3b, 0x77, 0xf3, 0x52, 0x5f, 0xc7, 0x56,
+	0x2b, 0x08, 0xc5, 0x8b, 0xac, 0x6f, 0xfb, 0x8c, 0x62, 0xfd, 0x44, 0xd5, 0x4f, 0x87, 0x0f, 0x5e,
+	0x62, 0x31, 0x4a, 0x08, 0xb7, 0xf7, 0x63, 0x51, 0x36, 0xa5, 0x28, 0xc8, 0xd5, 0xb8, 0x1c, 0xcc,
+	0xb3, 0x24, 0x89, 0x46, 0xf5, 0x95, 0x7f, 0x03, 0x2b, 0x0a, 0x72, 0x35, 0x0e, 0x1e, 0x80, 0x9a,
+	0xe7, 0xfb, 0x19, 0xad, 0xaf, 0x4a, 0xee, 0x9d, 0xbf, 0xe0, 0xee, 0x11, 0xbf, 0xf4, 0x41, 0x42,
+	0x90, 0xab, 0x60, 0xbd, 0xf7, 0x55, 0x50, 0x93, 0x17, 0x06, 0x5f, 0x1b, 0x60, 0x7d, 0xf6, 0x42,
+	0x61, 0x6b, 0xe9, 0x72, 0xce, 0x5d, 0x87, 0xc6, 0xcd, 0x0b, 0xe3, 0xd4, 0xed, 0xa3, 0x1b, 0x6f,
+	0x3e, 0xff, 0x78, 0x57, 0xbd, 0x0e, 0x77, 0x31, 0xe9, 0x50, 0x16, 0x93, 0xd1, 0xef, 0xfb, 0x27,
+	0x5f, 0x3d, 0x7c, 0x6b, 0x00, 0x30, 0x77, 0x99, 0x7f, 0x80, 0x2f, 0xad, 0x44, 0xa3, 0x7d, 0x71,
+	0xa0, 0x6e, 0xa3, 0x2d, 0xdb, 0x40, 0xb0, 0x79, 0x7e, 0x1b, 0x49, 0xf9, 0xf8, 0x1e, 0x9c, 0x4e,
+	0x4c, 0xe3, 0x6c, 0x62, 0x1a, 0xdf, 0x27, 0xa6, 0x71, 0x32, 0x35, 0x2b, 0x67, 0x53, 0xb3, 0xf2,
+	0x65, 0x6a, 0x56, 0x9e, 0xdb, 0x73, 0x96, 0x17, 0x14, 0x42, 0x3b, 0x11, 0x19, 0x04, 0x24, 0xc5,
+	0xaf, 0xe6, 0x88, 0xd2, 0xfe, 0xfe, 0x9a, 0xdc, 0xa6, 0x5b, 0xbf, 0x06, 0x00, 0xe9, 0x07, 0xfb,
+	0x8e, 0x38, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +330,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// Projection returns the tokens that will be minted until a future time if
+	// no inflation rates change.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -174,9 +352,21 @@ func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest,
 	return out, nil
 }
 
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/em.inflation.v1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// Projection returns the tokens that will be minted until a future time if
+	// no inflation rates change.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +376,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
 }
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +402,24 @@ func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.inflation.v1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -217,6 +428,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Inflation",
 			Handler:    _Query_Inflation_Handler,
 		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/inflation/v1/query.proto",
@@ -278,6 +493,142 @@ func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Accum.Size()
+		i -= size
+		if _, err := m.Accum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -309,6 +660,53 @@ func (m *QueryInflationResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Accum.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -448,6 +846,390 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, AssetProjection{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
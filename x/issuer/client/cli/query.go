@@ -32,5 +32,89 @@ func GetQueryCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.AddCommand(
+		getCmdQueryTransferPolicy(),
+		getCmdQueryFlows(),
+	)
+	return cmd
+}
+
+func getCmdQueryTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-policy [denomination]",
+		Example: "emd query issuers transfer-policy eeur",
+		Short:   "Show the IBC transfer policy of a denomination and the amounts sent through its rate limited channels",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TransferPolicy(cmd.Context(), &types.QueryTransferPolicyRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagDenom   = "denom"
+	flagChannel = "channel"
+	flagWindow  = "window"
+)
+
+func getCmdQueryFlows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "flows",
+		Example: "emd query issuers flows --denom eeur --window 1h",
+		Short:   "Show the amounts transferred over IBC per channel and denomination",
+		Long: `Show the amounts transferred over IBC per channel and denomination within a window, 24 hours by default.
+Windows start on the hour and can be at most a week long.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+
+			window, err := cmd.Flags().GetDuration(flagWindow)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Flows(cmd.Context(), &types.QueryFlowsRequest{
+				Denom:     denom,
+				ChannelId: channelID,
+				Window:    window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "Only show the flows of this denomination")
+	cmd.Flags().String(flagChannel, "", "Only show the flows through this channel")
+	cmd.Flags().Duration(flagWindow, types.DefaultFlowWindow, "Length of the window")
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
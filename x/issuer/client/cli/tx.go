@@ -5,10 +5,15 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +31,8 @@ func GetTxCmd() *cobra.Command {
 		getCmdIncreaseMintableAmount(),
 		getCmdDecreaseMintableAmount(),
 		getCmdSetInflation(),
+		getCmdSetInflationDistribution(),
+		getCmdSetTransferPolicy(),
 		getCmdRevokeLiquidityProvider(),
 	)
 
@@ -71,6 +78,172 @@ func getCmdSetInflation() *cobra.Command {
 	return cmd
 }
 
+func getCmdSetInflationDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-inflation-distribution [issuer_key_or_address] [denomination] [type:address:weight]...",
+		Example: "emd tx issuer set-inflation-distribution issuerkey eeur address:emoney1n5ggspeff4fxc87dvmg0ematr3qzw5l4v20mdv:0.7 module:buyback:0.2 community_pool::0.1",
+		Short:   "Route the inflation of a denomination to weighted recipients",
+		Long: `Route the inflation of a denomination to weighted recipients. The weights must add up to 1.
+Recipient types are address, module, community_pool and holders. The only module that may receive inflation
+is buyback. The share of the holders accrues to their balances as interest. Omit all recipients to restore the default destination.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom := args[1]
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+
+			recipients := make([]inflationtypes.InflationRecipient, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				recipient, err := parseRecipient(arg)
+				if err != nil {
+					return err
+				}
+				recipients = append(recipients, recipient)
+			}
+
+			msg := &types.MsgSetInflationDistribution{
+				Issuer:     clientCtx.GetFromAddress().String(),
+				Denom:      denom,
+				Recipients: recipients,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagAllowedChannels      = "allowed-channels"
+	flagRateLimit            = "rate-limit"
+	flagBlockInboundVouchers = "block-inbound-vouchers"
+)
+
+func getCmdSetTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-transfer-policy [issuer_key_or_address] [denomination]",
+		Example: "emd tx issuer set-transfer-policy issuerkey eeur --allowed-channels channel-0,channel-3 --rate-limit channel-0:1000000000000:24h --block-inbound-vouchers",
+		Short:   "Restrict the IBC transfers of a denomination",
+		Long: `Restrict the IBC transfers of a denomination. The denomination may only be sent through the allowed channels,
+or any channel if none are given. Rate limits cap the amount sent through a channel within a rolling window.
+Transfers that would mint vouchers of the denomination issued elsewhere can be blocked. The policy replaces
+the current one and omitting all flags removes it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy := types.TransferPolicy{Denom: args[1]}
+
+			policy.AllowedChannels, err = cmd.Flags().GetStringSlice(flagAllowedChannels)
+			if err != nil {
+				return err
+			}
+
+			rateLimits, err := cmd.Flags().GetStringArray(flagRateLimit)
+			if err != nil {
+				return err
+			}
+			for _, arg := range rateLimits {
+				limit, err := parseRateLimit(arg)
+				if err != nil {
+					return err
+				}
+				policy.RateLimits = append(policy.RateLimits, limit)
+			}
+
+			policy.BlockInboundVouchers, err = cmd.Flags().GetBool(flagBlockInboundVouchers)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetTransferPolicy{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Policy: policy,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(flagAllowedChannels, nil, "Channels the denomination may be sent through")
+	cmd.Flags().StringArray(flagRateLimit, nil, "Rate limit of a channel as channel:amount:window, can be repeated")
+	cmd.Flags().Bool(flagBlockInboundVouchers, false, "Reject incoming transfers that would mint vouchers of the denomination")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseRateLimit(s string) (types.TransferRateLimit, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return types.TransferRateLimit{}, fmt.Errorf("rate limit %q must have the format channel:amount:window", s)
+	}
+
+	amount, ok := sdk.NewIntFromString(parts[1])
+	if !ok {
+		return types.TransferRateLimit{}, fmt.Errorf("invalid amount of rate limit %q", s)
+	}
+
+	window, err := time.ParseDuration(parts[2])
+	if err != nil {
+		return types.TransferRateLimit{}, fmt.Errorf("invalid window of rate limit %q: %w", s, err)
+	}
+
+	return types.TransferRateLimit{
+		ChannelId: parts[0],
+		Amount:    amount,
+		Window:    window,
+	}, nil
+}
+
+func parseRecipient(s string) (inflationtypes.InflationRecipient, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return inflationtypes.InflationRecipient{}, fmt.Errorf("recipient %q must have the format type:address:weight", s)
+	}
+
+	var recipientType inflationtypes.RecipientType
+	switch parts[0] {
+	case "address":
+		recipientType = inflationtypes.RecipientType_Address
+	case "module":
+		recipientType = inflationtypes.RecipientType_Module
+	case "community_pool":
+		recipientType = inflationtypes.RecipientType_CommunityPool
+	case "holders":
+		recipientType = inflationtypes.RecipientType_Holders
+	default:
+		return inflationtypes.InflationRecipient{}, fmt.Errorf("unknown recipient type %q", parts[0])
+	}
+
+	weight, err := sdk.NewDecFromStr(parts[2])
+	if err != nil {
+		return inflationtypes.InflationRecipient{}, fmt.Errorf("invalid weight of recipient %q: %w", s, err)
+	}
+
+	return inflationtypes.InflationRecipient{
+		Type:    recipientType,
+		Address: parts[1],
+		Weight:  weight,
+	}, nil
+}
+
 func getCmdIncreaseMintableAmount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "increase-mintable [issuer_key_or_address] [liquidity_provider_address] [amount]",
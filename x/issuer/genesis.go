@@ -20,6 +20,18 @@ func initGenesis(ctx sdk.Context, k keeper.Keeper, state types.GenesisState) {
 		}
 		k.AddIssuer(ctx, issuer, denomMetadata)
 	}
+
+	for _, policy := range state.TransferPolicies {
+		k.InitTransferPolicy(ctx, policy)
+	}
+
+	for _, outflow := range state.TransferOutflows {
+		k.InitTransferOutflow(ctx, outflow)
+	}
+
+	for _, flow := range state.ChannelFlows {
+		k.InitChannelFlow(ctx, flow)
+	}
 }
 
 func defaultGenesisState() *types.GenesisState {
@@ -35,6 +35,14 @@ func newHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SetInflation(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetInflationDistribution:
+			res, err := msgServer.SetInflationDistribution(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetTransferPolicy:
+			res, err := msgServer.SetTransferPolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unrecognized issuance Msg type: %T", msg)
 		}
@@ -0,0 +1,141 @@
+package ibc
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+type FlowKeeper interface {
+	RecordFlow(ctx sdk.Context, channelID, denom string, inflow, outflow sdk.Int)
+}
+
+var (
+	_ porttypes.ICS4Wrapper = FlowICS4Wrapper{}
+	_ porttypes.IBCModule   = FlowMiddleware{}
+)
+
+// FlowICS4Wrapper records the transfers sent through each channel once the channel keeper has accepted them.
+type FlowICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      FlowKeeper
+}
+
+func NewFlowICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, keeper FlowKeeper) FlowICS4Wrapper {
+	return FlowICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      keeper,
+	}
+}
+
+func (w FlowICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	if err := w.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	if denom, amount, ok := sentTokens(packet); ok {
+		w.keeper.RecordFlow(ctx, packet.GetSourceChannel(), denom, sdk.ZeroInt(), amount)
+	}
+
+	return nil
+}
+
+func (w FlowICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// FlowMiddleware records the transfers received through each channel and reverts the outflow of refunded transfers.
+type FlowMiddleware struct {
+	porttypes.IBCModule
+	keeper FlowKeeper
+}
+
+func NewFlowMiddleware(app porttypes.IBCModule, keeper FlowKeeper) FlowMiddleware {
+	return FlowMiddleware{
+		IBCModule: app,
+		keeper:    keeper,
+	}
+}
+
+func (im FlowMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	if denom, amount, ok := receivedTokens(packet); ok {
+		im.keeper.RecordFlow(ctx, packet.GetDestChannel(), denom, amount, sdk.ZeroInt())
+	}
+
+	return ack
+}
+
+func (im FlowMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		return nil
+	}
+
+	im.refund(ctx, packet)
+	return nil
+}
+
+func (im FlowMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.refund(ctx, packet)
+	return nil
+}
+
+func (im FlowMiddleware) refund(ctx sdk.Context, packet channeltypes.Packet) {
+	if denom, amount, ok := sentTokens(packet); ok {
+		im.keeper.RecordFlow(ctx, packet.GetSourceChannel(), denom, sdk.ZeroInt(), amount.Neg())
+	}
+}
+
+// sentTokens returns the denomination on this chain and the amount of a transfer sent from it.
+func sentTokens(packet exported.PacketI) (string, sdk.Int, bool) {
+	data, amount, ok := unmarshalTransfer(packet)
+	if !ok {
+		return "", sdk.Int{}, false
+	}
+
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount, true
+}
+
+// receivedTokens returns the denomination on this chain and the amount of a transfer received by it.
+func receivedTokens(packet exported.PacketI) (string, sdk.Int, bool) {
+	data, amount, ok := unmarshalTransfer(packet)
+	if !ok {
+		return "", sdk.Int{}, false
+	}
+
+	var fullDenom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		fullDenom = strings.TrimPrefix(data.Denom, transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel()))
+	} else {
+		fullDenom = transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	}
+
+	return transfertypes.ParseDenomTrace(fullDenom).IBCDenom(), amount, true
+}
+
+func unmarshalTransfer(packet exported.PacketI) (data transfertypes.FungibleTokenPacketData, amount sdk.Int, ok bool) {
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return data, amount, false
+	}
+
+	amount, ok = sdk.NewIntFromString(data.Amount)
+	return data, amount, ok
+}
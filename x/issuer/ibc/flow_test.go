@@ -0,0 +1,63 @@
+package ibc
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowSendPacket(t *testing.T) {
+	keeper := &flowKeeperMock{}
+	wrapper := NewFlowICS4Wrapper(&ics4WrapperMock{}, keeper)
+
+	require.NoError(t, wrapper.SendPacket(sdk.Context{}, nil, transferPacket("eeur", "100")))
+	require.NoError(t, wrapper.SendPacket(sdk.Context{}, nil, transferPacket("transfer/channel-7/uatom", "5")))
+
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-7/uatom").IBCDenom()
+	require.Equal(t, []string{"channel-0/eeur/0/100", "channel-0/" + voucher + "/0/5"}, keeper.flows)
+}
+
+func TestFlowOnRecvPacket(t *testing.T) {
+	keeper := &flowKeeperMock{}
+	middleware := NewFlowMiddleware(&ibcModuleMock{}, keeper)
+
+	// Returning tokens are recorded by their denomination on this chain
+	middleware.OnRecvPacket(sdk.Context{}, transferPacket("transfer/channel-0/eeur", "100"), nil)
+	middleware.OnRecvPacket(sdk.Context{}, transferPacket("uatom", "5"), nil)
+
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-9/uatom").IBCDenom()
+	require.Equal(t, []string{"channel-9/eeur/100/0", "channel-9/" + voucher + "/5/0"}, keeper.flows)
+
+	// Rejected transfers are not recorded
+	keeper.flows = nil
+	rejecting := NewFlowMiddleware(NewIBCMiddleware(&ibcModuleMock{}, &policyKeeperMock{inboundErr: transfertypes.ErrReceiveDisabled}), keeper)
+	ack := rejecting.OnRecvPacket(sdk.Context{}, transferPacket("uatom", "5"), nil)
+	require.False(t, ack.Success())
+	require.Empty(t, keeper.flows)
+}
+
+func TestFlowRefunds(t *testing.T) {
+	keeper := &flowKeeperMock{}
+	middleware := NewFlowMiddleware(&ibcModuleMock{}, keeper)
+	packet := transferPacket("eeur", "100")
+
+	success := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, middleware.OnAcknowledgementPacket(sdk.Context{}, packet, success.Acknowledgement(), nil))
+	require.Empty(t, keeper.flows)
+
+	failure := channeltypes.NewErrorAcknowledgement("failed")
+	require.NoError(t, middleware.OnAcknowledgementPacket(sdk.Context{}, packet, failure.Acknowledgement(), nil))
+	require.NoError(t, middleware.OnTimeoutPacket(sdk.Context{}, packet, nil))
+	require.Equal(t, []string{"channel-0/eeur/0/-100", "channel-0/eeur/0/-100"}, keeper.flows)
+}
+
+type flowKeeperMock struct {
+	flows []string
+}
+
+func (m *flowKeeperMock) RecordFlow(_ sdk.Context, channelID, denom string, inflow, outflow sdk.Int) {
+	m.flows = append(m.flows, channelID+"/"+denom+"/"+inflow.String()+"/"+outflow.String())
+}
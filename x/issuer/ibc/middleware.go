@@ -0,0 +1,87 @@
+// Package ibc layers the issuer transfer policies and the flow telemetry on top of the ICS-20 transfer application.
+package ibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+type PolicyKeeper interface {
+	CheckOutboundTransfer(ctx sdk.Context, channelID, denom string, amount sdk.Int) error
+	CheckInboundVoucher(ctx sdk.Context, channelID, denom string) error
+}
+
+var (
+	_ porttypes.ICS4Wrapper = ICS4Wrapper{}
+	_ porttypes.IBCModule   = IBCMiddleware{}
+)
+
+// ICS4Wrapper sits between the transfer keeper and the channel keeper and rejects outbound transfers that violate the
+// policy of the denomination.
+type ICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      PolicyKeeper
+}
+
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, keeper PolicyKeeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      keeper,
+	}
+}
+
+func (w ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		// Policies apply to the denominations native to this chain
+		if trace := transfertypes.ParseDenomTrace(data.Denom); trace.Path == "" {
+			amount, ok := sdk.NewIntFromString(data.Amount)
+			if !ok {
+				return transfertypes.ErrInvalidAmount
+			}
+
+			if err := w.keeper.CheckOutboundTransfer(ctx, packet.GetSourceChannel(), trace.BaseDenom, amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+func (w ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// IBCMiddleware wraps the transfer application and acknowledges incoming transfers that violate the policy of the
+// denomination with an error.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper PolicyKeeper
+}
+
+func NewIBCMiddleware(app porttypes.IBCModule, keeper PolicyKeeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    keeper,
+	}
+}
+
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		// Tokens returning to this chain are released from escrow rather than minted as vouchers
+		if !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+			baseDenom := transfertypes.ParseDenomTrace(data.Denom).BaseDenom
+			if err := im.keeper.CheckInboundVoucher(ctx, packet.GetDestChannel(), baseDenom); err != nil {
+				return transfertypes.NewErrorAcknowledgement(err)
+			}
+		}
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,112 @@
+package ibc
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/e-money/em-ledger/x/issuer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendPacket(t *testing.T) {
+	keeper := &policyKeeperMock{outboundErr: types.ErrChannelNotAllowed}
+	channel := &ics4WrapperMock{}
+	wrapper := NewICS4Wrapper(channel, keeper)
+
+	// Vouchers of denominations issued elsewhere are not subject to the policies
+	err := wrapper.SendPacket(sdk.Context{}, nil, transferPacket("transfer/channel-7/eeur", "100"))
+	require.NoError(t, err)
+	require.Empty(t, keeper.outbound)
+	require.Equal(t, 1, channel.sent)
+
+	err = wrapper.SendPacket(sdk.Context{}, nil, transferPacket("eeur", "100"))
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+	require.Equal(t, []string{"channel-0/eeur/100"}, keeper.outbound)
+	require.Equal(t, 1, channel.sent)
+
+	keeper.outboundErr = nil
+	err = wrapper.SendPacket(sdk.Context{}, nil, transferPacket("eeur", "100"))
+	require.NoError(t, err)
+	require.Equal(t, 2, channel.sent)
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	keeper := &policyKeeperMock{inboundErr: types.ErrInboundVouchersBlocked}
+	app := &ibcModuleMock{}
+	middleware := NewIBCMiddleware(app, keeper)
+
+	// Tokens returning to this chain are released from escrow
+	packet := transferPacket("transfer/channel-0/eeur", "100")
+	ack := middleware.OnRecvPacket(sdk.Context{}, packet, nil)
+	require.True(t, ack.Success())
+	require.Empty(t, keeper.inbound)
+	require.Equal(t, 1, app.received)
+
+	packet = transferPacket("eeur", "100")
+	ack = middleware.OnRecvPacket(sdk.Context{}, packet, nil)
+	require.False(t, ack.Success())
+	require.Equal(t, []string{"channel-9/eeur"}, keeper.inbound)
+	require.Equal(t, 1, app.received)
+
+	keeper.inboundErr = nil
+	ack = middleware.OnRecvPacket(sdk.Context{}, packet, nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 2, app.received)
+}
+
+func transferPacket(denom, amount string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-9", clienttypes.NewHeight(0, 100), 0)
+}
+
+type policyKeeperMock struct {
+	outboundErr, inboundErr error
+	outbound, inbound       []string
+}
+
+func (m *policyKeeperMock) CheckOutboundTransfer(_ sdk.Context, channelID, denom string, amount sdk.Int) error {
+	m.outbound = append(m.outbound, channelID+"/"+denom+"/"+amount.String())
+	return m.outboundErr
+}
+
+func (m *policyKeeperMock) CheckInboundVoucher(_ sdk.Context, channelID, denom string) error {
+	m.inbound = append(m.inbound, channelID+"/"+denom)
+	return m.inboundErr
+}
+
+type ics4WrapperMock struct {
+	sent int
+}
+
+func (m *ics4WrapperMock) SendPacket(sdk.Context, *capabilitytypes.Capability, exported.PacketI) error {
+	m.sent++
+	return nil
+}
+
+func (m *ics4WrapperMock) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+type ibcModuleMock struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *ibcModuleMock) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *ibcModuleMock) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *ibcModuleMock) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
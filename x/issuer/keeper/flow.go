@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+// RecordFlow adds a transfer of denom through channelID to the flow telemetry and emits an event for every alert of the
+// denomination whose threshold the net flow reaches. Refunded transfers are recorded with a negative outflow.
+func (k Keeper) RecordFlow(ctx sdk.Context, channelID, denom string, inflow, outflow sdk.Int) {
+	flow := k.GetChannelFlow(ctx, denom, channelID)
+
+	var alerts []types.FlowAlert
+	for _, alert := range k.GetParams(ctx).FlowAlerts {
+		if alert.AppliesTo(denom, channelID) {
+			alerts = append(alerts, alert)
+		}
+	}
+
+	before := make([]types.FlowSummary, len(alerts))
+	for i, alert := range alerts {
+		before[i] = flow.Summarize(ctx.BlockTime(), alert.Window)
+	}
+
+	flow.Record(ctx.BlockTime(), inflow, outflow)
+	k.setChannelFlow(ctx, flow)
+
+	for i, alert := range alerts {
+		after := flow.Summarize(ctx.BlockTime(), alert.Window)
+		if !alert.Crossed(before[i], after) {
+			continue
+		}
+
+		k.logger(ctx).Info("IBC flow threshold reached", "denom", denom, "channel", channelID, "net", after.Net, "window", alert.Window)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFlowThreshold,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+				sdk.NewAttribute(types.AttributeKeyInflow, after.Inflow.String()),
+				sdk.NewAttribute(types.AttributeKeyOutflow, after.Outflow.String()),
+				sdk.NewAttribute(types.AttributeKeyNet, after.Net.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, alert.Threshold.String()),
+				sdk.NewAttribute(types.AttributeKeyWindow, alert.Window.String()),
+			),
+		)
+	}
+}
+
+func (k Keeper) GetChannelFlow(ctx sdk.Context, denom, channelID string) (flow types.ChannelFlow) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetChannelFlowKey(denom, channelID))
+	if bz == nil {
+		return types.ChannelFlow{Denom: denom, ChannelId: channelID}
+	}
+
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow
+}
+
+func (k Keeper) setChannelFlow(ctx sdk.Context, flow types.ChannelFlow) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetChannelFlowKey(flow.Denom, flow.ChannelId)
+
+	if len(flow.Buckets) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&flow))
+}
+
+// InitChannelFlow sets the flow telemetry of a channel from genesis.
+func (k Keeper) InitChannelFlow(ctx sdk.Context, flow types.ChannelFlow) {
+	k.setChannelFlow(ctx, flow)
+}
+
+func (k Keeper) GetChannelFlows(ctx sdk.Context) (flows []types.ChannelFlow) {
+	k.iterateChannelFlows(ctx, types.ChannelFlowKeyPrefix, func(flow types.ChannelFlow) bool {
+		flows = append(flows, flow)
+		return false
+	})
+
+	return
+}
+
+func (k Keeper) iterateChannelFlows(ctx sdk.Context, prefix []byte, cb func(flow types.ChannelFlow) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.ChannelFlow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		if cb(flow) {
+			break
+		}
+	}
+}
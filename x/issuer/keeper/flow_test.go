@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/issuer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordFlow(t *testing.T) {
+	ctx, _, _, keeper, _ := createTestComponents(t)
+
+	now := time.Date(2022, 1, 1, 12, 30, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+
+	// Alerts are not tied to transfer policies, so vouchers can have them too
+	keeper.SetParams(ctx, types.Params{
+		FlowAlerts: []types.FlowAlert{
+			{Denom: "eeur", Threshold: sdk.NewInt(1000), Window: 2 * time.Hour},
+			{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", ChannelId: "channel-1", Threshold: sdk.NewInt(10), Window: time.Hour},
+		},
+	})
+	_, found := keeper.GetTransferPolicy(ctx, "eeur")
+	require.False(t, found)
+
+	keeper.RecordFlow(ctx, "channel-0", "eeur", sdk.ZeroInt(), sdk.NewInt(600))
+	keeper.RecordFlow(ctx, "channel-0", "eeur", sdk.NewInt(100), sdk.ZeroInt())
+	keeper.RecordFlow(ctx, "channel-1", "eeur", sdk.ZeroInt(), sdk.NewInt(900))
+	require.Empty(t, thresholdEvents(ctx))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	keeper.RecordFlow(ctx, "channel-0", "eeur", sdk.ZeroInt(), sdk.NewInt(500))
+
+	events := thresholdEvents(ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, sdk.NewEvent(types.EventTypeFlowThreshold,
+		sdk.NewAttribute(types.AttributeKeyDenom, "eeur"),
+		sdk.NewAttribute(types.AttributeKeyChannel, "channel-0"),
+		sdk.NewAttribute(types.AttributeKeyInflow, "100"),
+		sdk.NewAttribute(types.AttributeKeyOutflow, "1100"),
+		sdk.NewAttribute(types.AttributeKeyNet, "-1000"),
+		sdk.NewAttribute(types.AttributeKeyThreshold, "1000"),
+		sdk.NewAttribute(types.AttributeKeyWindow, "2h0m0s"),
+	), events[0])
+
+	// Staying above the threshold raises no further alerts
+	keeper.RecordFlow(ctx, "channel-0", "eeur", sdk.ZeroInt(), sdk.NewInt(1))
+	require.Len(t, thresholdEvents(ctx), 1)
+
+	flow := keeper.GetChannelFlow(ctx, "eeur", "channel-0")
+	require.Len(t, flow.Buckets, 2)
+	require.Equal(t, time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC), flow.Buckets[0].Start)
+
+	res, err := keeper.Flows(sdk.WrapSDKContext(ctx), &types.QueryFlowsRequest{Denom: "eeur"})
+	require.NoError(t, err)
+	require.Equal(t, []types.FlowSummary{
+		{ChannelId: "channel-0", Denom: "eeur", Inflow: sdk.NewInt(100), Outflow: sdk.NewInt(1101), Net: sdk.NewInt(-1001)},
+		{ChannelId: "channel-1", Denom: "eeur", Inflow: sdk.ZeroInt(), Outflow: sdk.NewInt(900), Net: sdk.NewInt(-900)},
+	}, res.Flows)
+
+	// The window starts at the beginning of the hour
+	res, err = keeper.Flows(sdk.WrapSDKContext(ctx), &types.QueryFlowsRequest{ChannelId: "channel-0", Window: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, []types.FlowSummary{
+		{ChannelId: "channel-0", Denom: "eeur", Inflow: sdk.ZeroInt(), Outflow: sdk.NewInt(501), Net: sdk.NewInt(-501)},
+	}, res.Flows)
+
+	_, err = keeper.Flows(sdk.WrapSDKContext(ctx), &types.QueryFlowsRequest{Window: types.FlowRetention + time.Hour})
+	require.Error(t, err)
+
+	// Buckets past the retention period are dropped
+	ctx = ctx.WithBlockTime(now.Add(types.FlowRetention + 2*time.Hour))
+	keeper.RecordFlow(ctx, "channel-0", "eeur", sdk.NewInt(1), sdk.ZeroInt())
+	require.Len(t, keeper.GetChannelFlow(ctx, "eeur", "channel-0").Buckets, 1)
+
+	res, err = keeper.Flows(sdk.WrapSDKContext(ctx), &types.QueryFlowsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Flows, 1)
+
+	// Alerts only cover their denomination and channel
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	voucher := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	keeper.RecordFlow(ctx, "channel-0", voucher, sdk.NewInt(10), sdk.ZeroInt())
+	require.Empty(t, thresholdEvents(ctx))
+	keeper.RecordFlow(ctx, "channel-1", voucher, sdk.NewInt(10), sdk.ZeroInt())
+	require.Len(t, thresholdEvents(ctx), 1)
+}
+
+func thresholdEvents(ctx sdk.Context) (events sdk.Events) {
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeFlowThreshold {
+			events = append(events, e)
+		}
+	}
+
+	return
+}
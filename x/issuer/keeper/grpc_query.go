@@ -21,3 +21,56 @@ func (k Keeper) Issuers(c context.Context, req *types.QueryIssuersRequest) (*typ
 	}
 	return &response, nil
 }
+
+func (k Keeper) TransferPolicy(c context.Context, req *types.QueryTransferPolicyRequest) (*types.QueryTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	policy, _ := k.GetTransferPolicy(ctx, req.Denom)
+	return &types.QueryTransferPolicyResponse{
+		Policy:   policy,
+		Outflows: k.GetChannelOutflows(ctx, policy),
+	}, nil
+}
+
+func (k Keeper) Flows(c context.Context, req *types.QueryFlowsRequest) (*types.QueryFlowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = types.DefaultFlowWindow
+	}
+	if window < 0 || window > types.FlowRetention {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive and at most %v", types.FlowRetention)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	prefix := types.ChannelFlowKeyPrefix
+	if req.Denom != "" {
+		prefix = types.GetChannelFlowPrefix(req.Denom)
+	}
+
+	flows := make([]types.FlowSummary, 0)
+	k.iterateChannelFlows(ctx, prefix, func(flow types.ChannelFlow) bool {
+		if req.ChannelId != "" && flow.ChannelId != req.ChannelId {
+			return false
+		}
+
+		summary := flow.Summarize(ctx.BlockTime(), window)
+		if summary.Inflow.IsZero() && summary.Outflow.IsZero() {
+			return false
+		}
+
+		flows = append(flows, summary)
+		return false
+	})
+
+	return &types.QueryFlowsResponse{Flows: flows}, nil
+}
@@ -11,11 +11,13 @@ import (
 	authtypes "github.com/e-money/em-ledger/x/authority/types"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 	lp "github.com/e-money/em-ledger/x/liquidityprovider"
 
@@ -28,23 +30,29 @@ const (
 )
 
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey sdk.StoreKey
-	lpKeeper lp.Keeper
-	ik       types.InflationKeeper
-	bk       types.BankKeeper
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+	lpKeeper   lp.Keeper
+	ik         types.InflationKeeper
+	bk         types.BankKeeper
 }
 
 func NewKeeper(
-	cdc codec.Codec, storeKey sdk.StoreKey, lpk lp.Keeper,
+	cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, lpk lp.Keeper,
 	ik types.InflationKeeper, bk types.BankKeeper,
 ) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		lpKeeper: lpk,
-		ik:       ik,
-		bk:       bk,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		lpKeeper:   lpk,
+		ik:         ik,
+		bk:         bk,
 	}
 }
 
@@ -153,6 +161,16 @@ func (k Keeper) SetInflationRate(ctx sdk.Context, issuer sdk.AccAddress, inflati
 	return k.ik.SetInflation(ctx, inflationRate, denom)
 }
 
+// SetInflationDistribution replaces the recipients of the inflation minted for denom.
+func (k Keeper) SetInflationDistribution(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error) {
+	_, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNotAnIssuer, issuer.String())
+	}
+
+	return k.ik.SetDistribution(ctx, denom, recipients)
+}
+
 func (k Keeper) logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -25,6 +25,7 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	apptypes "github.com/e-money/em-ledger/types"
 	emauthtypes "github.com/e-money/em-ledger/x/authority/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 	"github.com/e-money/em-ledger/x/liquidityprovider"
 	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
@@ -332,6 +333,8 @@ func createTestComponentsWithEncodingConfig(t *testing.T, encConfig simappparams
 	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(lpKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(issuerKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
@@ -360,7 +363,7 @@ func createTestComponentsWithEncodingConfig(t *testing.T, encConfig simappparams
 
 	lpk := liquidityprovider.NewKeeper(encConfig.Marshaler, lpKey, bk)
 
-	keeper := NewKeeper(encConfig.Marshaler, issuerKey, lpk, mockInflationKeeper{}, bk)
+	keeper := NewKeeper(encConfig.Marshaler, issuerKey, pk.Subspace(types.ModuleName), lpk, mockInflationKeeper{}, bk)
 	return ctx, ak, lpk, keeper, bk
 }
 
@@ -374,6 +377,10 @@ func (m mockInflationKeeper) AddDenoms(sdk.Context, []string) (_ *sdk.Result, _
 	return
 }
 
+func (m mockInflationKeeper) SetDistribution(sdk.Context, string, []inflationtypes.InflationRecipient) (_ *sdk.Result, _ error) {
+	return
+}
+
 func MakeTestEncodingConfig() simappparams.EncodingConfig {
 	cdc := codec.NewLegacyAmino()
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
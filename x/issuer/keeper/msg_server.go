@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 )
 
@@ -15,6 +16,8 @@ type issuerKeeper interface {
 	DecreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableDecrease sdk.Coins) (*sdk.Result, error)
 	RevokeLiquidityProvider(ctx sdk.Context, liquidityProvider, issuerAddress sdk.AccAddress) (*sdk.Result, error)
 	SetInflationRate(ctx sdk.Context, issuer sdk.AccAddress, inflationRate sdk.Dec, denom string) (*sdk.Result, error)
+	SetInflationDistribution(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error)
+	SetTransferPolicy(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error)
 }
 
 type msgServer struct {
@@ -108,3 +111,37 @@ func (m msgServer) SetInflation(c context.Context, msg *types.MsgSetInflation) (
 	}
 	return &types.MsgSetInflationResponse{}, nil
 }
+
+func (m msgServer) SetInflationDistribution(c context.Context, msg *types.MsgSetInflationDistribution) (*types.MsgSetInflationDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	result, err := m.k.SetInflationDistribution(ctx, issuer, msg.Denom, msg.Recipients)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetInflationDistributionResponse{}, nil
+}
+
+func (m msgServer) SetTransferPolicy(c context.Context, msg *types.MsgSetTransferPolicy) (*types.MsgSetTransferPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "issuer")
+	}
+
+	result, err := m.k.SetTransferPolicy(ctx, issuer, msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+	return &types.MsgSetTransferPolicyResponse{}, nil
+}
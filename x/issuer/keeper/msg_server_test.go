@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 	"github.com/e-money/em-ledger/x/issuer/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -344,11 +346,175 @@ func TestSetInflationRate(t *testing.T) {
 	}
 }
 
+func TestSetInflationDistribution(t *testing.T) {
+	var (
+		issuerAddr    = accAddress
+		gotIssuer     sdk.AccAddress
+		gotDenom      string
+		gotRecipients []inflationtypes.InflationRecipient
+	)
+
+	keeper := issuerKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	recipients := []inflationtypes.InflationRecipient{
+		{Type: inflationtypes.RecipientType_Address, Address: issuerAddr.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Type: inflationtypes.RecipientType_CommunityPool, Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+
+	captureArgsMock := func(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error) {
+		gotIssuer, gotDenom, gotRecipients = issuer, denom, recipients
+		return &sdk.Result{}, nil
+	}
+	specs := map[string]struct {
+		req       *types.MsgSetInflationDistribution
+		mockFn    func(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error)
+		expErr    bool
+		expEvents sdk.Events
+	}{
+		"all good": {
+			req: &types.MsgSetInflationDistribution{
+				Issuer:     issuerAddr.String(),
+				Denom:      "alx",
+				Recipients: recipients,
+			},
+			mockFn: func(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error) {
+				captureArgsMock(ctx, issuer, denom, recipients)
+				return &sdk.Result{
+					Events: []abcitypes.Event{{
+						Type:       "testing",
+						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+					}},
+				}, nil
+			},
+			expEvents: sdk.Events{{
+				Type:       "testing",
+				Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+			}},
+		},
+		"reset to default": {
+			req: &types.MsgSetInflationDistribution{
+				Issuer: issuerAddr.String(),
+				Denom:  "alx",
+			},
+			mockFn: captureArgsMock,
+		},
+		"issuer missing": {
+			req: &types.MsgSetInflationDistribution{
+				Denom:      "alx",
+				Recipients: recipients,
+			},
+			expErr: true,
+		},
+		"issuer invalid": {
+			req: &types.MsgSetInflationDistribution{
+				Issuer:     "invalid",
+				Denom:      "alx",
+				Recipients: recipients,
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetInflationDistribution{
+				Issuer:     issuerAddr.String(),
+				Denom:      "alx",
+				Recipients: recipients,
+			},
+			mockFn: func(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetInflationDistributionFn = spec.mockFn
+			eventManager := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
+			_, gotErr := svr.SetInflationDistribution(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.req.Issuer, gotIssuer.String())
+			assert.Equal(t, spec.req.Denom, gotDenom)
+			assert.Equal(t, spec.req.Recipients, gotRecipients)
+
+			if len(spec.expEvents) == 0 && len(eventManager.Events()) == 0 {
+				return // not fail when nil != empty
+			}
+			assert.Equal(t, spec.expEvents, eventManager.Events())
+		})
+	}
+}
+
+func TestSetTransferPolicy(t *testing.T) {
+	var (
+		issuerAddr = accAddress
+		gotIssuer  sdk.AccAddress
+		gotPolicy  types.TransferPolicy
+	)
+
+	keeper := issuerKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	policy := types.TransferPolicy{
+		Denom:           "alx",
+		AllowedChannels: []string{"channel-0"},
+		RateLimits: []types.TransferRateLimit{
+			{ChannelId: "channel-0", Amount: sdk.NewInt(1000), Window: time.Hour},
+		},
+	}
+
+	captureArgsMock := func(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error) {
+		gotIssuer, gotPolicy = issuer, policy
+		return &sdk.Result{}, nil
+	}
+	specs := map[string]struct {
+		req    *types.MsgSetTransferPolicy
+		mockFn func(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error)
+		expErr bool
+	}{
+		"all good": {
+			req:    &types.MsgSetTransferPolicy{Issuer: issuerAddr.String(), Policy: policy},
+			mockFn: captureArgsMock,
+		},
+		"issuer missing": {
+			req:    &types.MsgSetTransferPolicy{Policy: policy},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetTransferPolicy{Issuer: issuerAddr.String(), Policy: policy},
+			mockFn: func(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetTransferPolicyFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+			_, gotErr := svr.SetTransferPolicy(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.req.Issuer, gotIssuer.String())
+			assert.Equal(t, spec.req.Policy, gotPolicy)
+		})
+	}
+}
+
 type issuerKeeperMock struct {
 	IncreaseMintableAmountOfLiquidityProviderFn func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error)
 	DecreaseMintableAmountOfLiquidityProviderFn func(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableDecrease sdk.Coins) (*sdk.Result, error)
 	RevokeLiquidityProviderFn                   func(ctx sdk.Context, liquidityProvider, issuerAddress sdk.AccAddress) (*sdk.Result, error)
 	SetInflationRateFn                          func(ctx sdk.Context, issuer sdk.AccAddress, inflationRate sdk.Dec, denom string) (*sdk.Result, error)
+	SetInflationDistributionFn                  func(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error)
+	SetTransferPolicyFn                         func(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error)
 }
 
 func (m issuerKeeperMock) IncreaseMintableAmountOfLiquidityProvider(ctx sdk.Context, liquidityProvider, issuer sdk.AccAddress, mintableIncrease sdk.Coins) (*sdk.Result, error) {
@@ -378,3 +544,17 @@ func (m issuerKeeperMock) SetInflationRate(ctx sdk.Context, issuer sdk.AccAddres
 	}
 	return m.SetInflationRateFn(ctx, issuer, inflationRate, denom)
 }
+
+func (m issuerKeeperMock) SetInflationDistribution(ctx sdk.Context, issuer sdk.AccAddress, denom string, recipients []inflationtypes.InflationRecipient) (*sdk.Result, error) {
+	if m.SetInflationDistributionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetInflationDistributionFn(ctx, issuer, denom, recipients)
+}
+
+func (m issuerKeeperMock) SetTransferPolicy(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error) {
+	if m.SetTransferPolicyFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetTransferPolicyFn(ctx, issuer, policy)
+}
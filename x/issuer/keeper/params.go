@@ -0,0 +1,22 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+// GetParams returns the parameters of the issuer module. Parameters that have not been set by the authority have their
+// default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+// SetTransferPolicy replaces the IBC transfer policy of a denomination. An empty policy removes it.
+func (k Keeper) SetTransferPolicy(ctx sdk.Context, issuer sdk.AccAddress, policy types.TransferPolicy) (*sdk.Result, error) {
+	_, err := k.mustBeIssuerOfDenom(ctx, issuer.String(), policy.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNotAnIssuer, issuer.String())
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTransferPolicy, err.Error())
+	}
+
+	k.setTransferPolicy(ctx, policy)
+	k.logger(ctx).Info("Transfer policy updated", "denom", policy.Denom, "issuer", issuer)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// InitTransferPolicy sets a policy from genesis.
+func (k Keeper) InitTransferPolicy(ctx sdk.Context, policy types.TransferPolicy) {
+	k.setTransferPolicy(ctx, policy)
+}
+
+// InitTransferOutflow sets the recorded outbound transfers of a channel from genesis.
+func (k Keeper) InitTransferOutflow(ctx sdk.Context, outflow types.TransferOutflow) {
+	k.setTransferOutflow(ctx, outflow)
+}
+
+func (k Keeper) GetTransferPolicy(ctx sdk.Context, denom string) (policy types.TransferPolicy, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTransferPolicyKey(denom))
+	if bz == nil {
+		return types.TransferPolicy{Denom: denom}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+func (k Keeper) GetTransferPolicies(ctx sdk.Context) (policies []types.TransferPolicy) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferPolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.TransferPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+
+	return
+}
+
+func (k Keeper) setTransferPolicy(ctx sdk.Context, policy types.TransferPolicy) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Outbound transfers are only recorded for the channels that are still rate limited
+	k.iterateTransferOutflows(ctx, policy.Denom, func(outflow types.TransferOutflow) bool {
+		if _, limited := policy.RateLimit(outflow.ChannelId); !limited {
+			store.Delete(types.GetTransferOutflowKey(outflow.Denom, outflow.ChannelId))
+		}
+		return false
+	})
+
+	if policy.IsEmpty() {
+		store.Delete(types.GetTransferPolicyKey(policy.Denom))
+		return
+	}
+
+	store.Set(types.GetTransferPolicyKey(policy.Denom), k.cdc.MustMarshal(&policy))
+}
+
+func (k Keeper) GetTransferOutflow(ctx sdk.Context, denom, channelID string) (outflow types.TransferOutflow) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTransferOutflowKey(denom, channelID))
+	if bz == nil {
+		return types.TransferOutflow{Denom: denom, ChannelId: channelID}
+	}
+
+	k.cdc.MustUnmarshal(bz, &outflow)
+	return outflow
+}
+
+func (k Keeper) setTransferOutflow(ctx sdk.Context, outflow types.TransferOutflow) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTransferOutflowKey(outflow.Denom, outflow.ChannelId)
+
+	if len(outflow.Buckets) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&outflow))
+}
+
+// GetTransferOutflows returns the recorded outbound transfers of all denominations.
+func (k Keeper) GetTransferOutflows(ctx sdk.Context) (outflows []types.TransferOutflow) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferOutflowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow types.TransferOutflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+		outflows = append(outflows, outflow)
+	}
+
+	return
+}
+
+func (k Keeper) iterateTransferOutflows(ctx sdk.Context, denom string, cb func(outflow types.TransferOutflow) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetTransferOutflowPrefix(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow types.TransferOutflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+		if cb(outflow) {
+			break
+		}
+	}
+}
+
+// GetChannelOutflows returns the amounts sent through the rate limited channels of a denomination within their windows.
+func (k Keeper) GetChannelOutflows(ctx sdk.Context, policy types.TransferPolicy) []types.ChannelOutflow {
+	outflows := make([]types.ChannelOutflow, len(policy.RateLimits))
+	for i, limit := range policy.RateLimits {
+		outflow := k.GetTransferOutflow(ctx, policy.Denom, limit.ChannelId)
+		outflow.Prune(ctx.BlockTime(), limit.Window)
+
+		outflows[i] = types.ChannelOutflow{
+			ChannelId: limit.ChannelId,
+			Amount:    outflow.Total(),
+			Limit:     limit.Amount,
+		}
+	}
+
+	return outflows
+}
+
+// CheckOutboundTransfer enforces the transfer policy of denom on a transfer through channelID and records it against the
+// rate limit of the channel. Transfers that are later refunded still count towards the limit.
+func (k Keeper) CheckOutboundTransfer(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	policy, found := k.GetTransferPolicy(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	if !policy.AllowsChannel(channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelNotAllowed, "%v on %v", denom, channelID)
+	}
+
+	limit, limited := policy.RateLimit(channelID)
+	if !limited {
+		return nil
+	}
+
+	outflow := k.GetTransferOutflow(ctx, denom, channelID)
+	outflow.Prune(ctx.BlockTime(), limit.Window)
+
+	total := outflow.Total().Add(amount)
+	if total.GT(limit.Amount) {
+		return sdkerrors.Wrapf(types.ErrTransferRateLimitExceeded, "%v%v on %v exceeds the limit of %v within %v", total, denom, channelID, limit.Amount, limit.Window)
+	}
+
+	outflow.Record(ctx.BlockTime(), limit.Window, amount)
+	k.setTransferOutflow(ctx, outflow)
+
+	return nil
+}
+
+// CheckInboundVoucher enforces the transfer policy of denom on a transfer through channelID that would mint a voucher of it.
+func (k Keeper) CheckInboundVoucher(ctx sdk.Context, channelID, denom string) error {
+	policy, found := k.GetTransferPolicy(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	if policy.BlockInboundVouchers {
+		return sdkerrors.Wrap(types.ErrInboundVouchersBlocked, denom)
+	}
+
+	if !policy.AllowsChannel(channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelNotAllowed, "%v on %v", denom, channelID)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/issuer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeperSetTransferPolicy(t *testing.T) {
+	ctx, _, _, keeper, _ := createTestComponents(t)
+
+	acc1, _ := sdk.AccAddressFromBech32("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+	acc2, _ := sdk.AccAddressFromBech32("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+
+	issuer := types.NewIssuer(acc1, "eeur", "ejpy")
+	_, err := keeper.AddIssuer(ctx, issuer, getDenomsMetadata(issuer.Denoms))
+	require.NoError(t, err)
+
+	policy := types.TransferPolicy{
+		Denom:           "eeur",
+		AllowedChannels: []string{"channel-0", "channel-1"},
+		RateLimits: []types.TransferRateLimit{
+			{ChannelId: "channel-0", Amount: sdk.NewInt(1000), Window: time.Hour},
+		},
+	}
+
+	_, err = keeper.SetTransferPolicy(ctx, acc2, policy)
+	require.ErrorIs(t, err, types.ErrNotAnIssuer)
+
+	invalid := policy
+	invalid.RateLimits = []types.TransferRateLimit{{ChannelId: "channel-2", Amount: sdk.NewInt(1000), Window: time.Hour}}
+	_, err = keeper.SetTransferPolicy(ctx, acc1, invalid)
+	require.ErrorIs(t, err, types.ErrInvalidTransferPolicy)
+
+	_, err = keeper.SetTransferPolicy(ctx, acc1, policy)
+	require.NoError(t, err)
+
+	stored, found := keeper.GetTransferPolicy(ctx, "eeur")
+	require.True(t, found)
+	require.Equal(t, policy, stored)
+	require.Equal(t, []types.TransferPolicy{policy}, keeper.GetTransferPolicies(ctx))
+
+	require.NoError(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(100)))
+	require.Len(t, keeper.GetTransferOutflows(ctx), 1)
+
+	// Lifting the rate limit drops the recorded transfers
+	policy.RateLimits = nil
+	_, err = keeper.SetTransferPolicy(ctx, acc1, policy)
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetTransferOutflows(ctx))
+
+	// An empty policy is removed
+	_, err = keeper.SetTransferPolicy(ctx, acc1, types.TransferPolicy{Denom: "eeur"})
+	require.NoError(t, err)
+
+	_, found = keeper.GetTransferPolicy(ctx, "eeur")
+	require.False(t, found)
+	require.Empty(t, keeper.GetTransferPolicies(ctx))
+}
+
+func TestCheckOutboundTransfer(t *testing.T) {
+	ctx, _, _, keeper, _ := createTestComponents(t)
+
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	keeper.InitTransferPolicy(ctx, types.TransferPolicy{
+		Denom:           "eeur",
+		AllowedChannels: []string{"channel-0", "channel-1"},
+		RateLimits: []types.TransferRateLimit{
+			{ChannelId: "channel-0", Amount: sdk.NewInt(1000), Window: time.Hour},
+		},
+	})
+
+	// Denominations without a policy are unrestricted
+	require.NoError(t, keeper.CheckOutboundTransfer(ctx, "channel-5", "echf", sdk.NewInt(1000000)))
+
+	require.ErrorIs(t, keeper.CheckOutboundTransfer(ctx, "channel-5", "eeur", sdk.NewInt(1)), types.ErrChannelNotAllowed)
+	require.NoError(t, keeper.CheckOutboundTransfer(ctx, "channel-1", "eeur", sdk.NewInt(1000000)))
+
+	require.NoError(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(600)))
+
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	require.NoError(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(400)))
+	require.ErrorIs(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(1)), types.ErrTransferRateLimitExceeded)
+
+	outflows := keeper.GetChannelOutflows(ctx, types.TransferPolicy{
+		Denom:      "eeur",
+		RateLimits: []types.TransferRateLimit{{ChannelId: "channel-0", Amount: sdk.NewInt(1000), Window: time.Hour}},
+	})
+	require.Equal(t, []types.ChannelOutflow{{ChannelId: "channel-0", Amount: sdk.NewInt(1000), Limit: sdk.NewInt(1000)}}, outflows)
+
+	// The first transfer leaves the window with its bucket
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	require.ErrorIs(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(1)), types.ErrTransferRateLimitExceeded)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour + time.Minute))
+	require.ErrorIs(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(601)), types.ErrTransferRateLimitExceeded)
+	require.NoError(t, keeper.CheckOutboundTransfer(ctx, "channel-0", "eeur", sdk.NewInt(600)))
+
+	outflow := keeper.GetTransferOutflow(ctx, "eeur", "channel-0")
+	require.Len(t, outflow.Buckets, 2)
+	require.Equal(t, sdk.NewInt(1000), outflow.Total())
+}
+
+func TestCheckInboundVoucher(t *testing.T) {
+	ctx, _, _, keeper, _ := createTestComponents(t)
+
+	keeper.InitTransferPolicy(ctx, types.TransferPolicy{
+		Denom:           "eeur",
+		AllowedChannels: []string{"channel-0"},
+	})
+	keeper.InitTransferPolicy(ctx, types.TransferPolicy{
+		Denom:                "ejpy",
+		BlockInboundVouchers: true,
+	})
+
+	require.NoError(t, keeper.CheckInboundVoucher(ctx, "channel-3", "echf"))
+	require.NoError(t, keeper.CheckInboundVoucher(ctx, "channel-0", "eeur"))
+	require.ErrorIs(t, keeper.CheckInboundVoucher(ctx, "channel-3", "eeur"), types.ErrChannelNotAllowed)
+	require.ErrorIs(t, keeper.CheckInboundVoucher(ctx, "channel-0", "ejpy"), types.ErrInboundVouchersBlocked)
+}
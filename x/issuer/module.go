@@ -52,7 +52,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
-	return nil
+	return data.Validate()
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
@@ -92,8 +92,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	issuers := am.keeper.GetIssuers(ctx)
-	gs := types.GenesisState{Issuers: issuers}
+	gs := types.GenesisState{
+		Issuers:          am.keeper.GetIssuers(ctx),
+		TransferPolicies: am.keeper.GetTransferPolicies(ctx),
+		TransferOutflows: am.keeper.GetTransferOutflows(ctx),
+		ChannelFlows:     am.keeper.GetChannelFlows(ctx),
+	}
 	return cdc.MustMarshalJSON(&gs)
 }
 
@@ -26,6 +26,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDecreaseMintable{}, "e-money/MsgDecreaseMintable", nil)
 	cdc.RegisterConcrete(&MsgRevokeLiquidityProvider{}, "e-money/MsgRevokeLiquidityProvider", nil)
 	cdc.RegisterConcrete(&MsgSetInflation{}, "e-money/MsgSetInflation", nil)
+	cdc.RegisterConcrete(&MsgSetInflationDistribution{}, "e-money/MsgSetInflationDistribution", nil)
+	cdc.RegisterConcrete(&MsgSetTransferPolicy{}, "e-money/MsgSetTransferPolicy", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -34,6 +36,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDecreaseMintable{},
 		&MsgRevokeLiquidityProvider{},
 		&MsgSetInflation{},
+		&MsgSetInflationDistribution{},
+		&MsgSetTransferPolicy{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -14,4 +14,9 @@ var (
 	ErrNotAnIssuer                 = sdkerrors.Register(ModuleName, 5, "Account is not an issuer")
 	ErrNegativeInflation           = sdkerrors.Register(ModuleName, 6, "Inflation can't be negative")
 	ErrDenomInflation              = sdkerrors.Register(ModuleName, 7, "Inflation denomination error")
+	ErrInvalidDistribution         = sdkerrors.Register(ModuleName, 8, "Invalid inflation distribution")
+	ErrInvalidTransferPolicy       = sdkerrors.Register(ModuleName, 9, "Invalid transfer policy")
+	ErrChannelNotAllowed           = sdkerrors.Register(ModuleName, 10, "Denomination may not be transferred through this channel")
+	ErrTransferRateLimitExceeded   = sdkerrors.Register(ModuleName, 11, "Transfer rate limit exceeded")
+	ErrInboundVouchersBlocked      = sdkerrors.Register(ModuleName, 12, "Inbound vouchers of this denomination are blocked")
 )
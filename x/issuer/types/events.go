@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeFlowThreshold = "ibc_flow_threshold"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyChannel   = "channel"
+	AttributeKeyInflow    = "inflow"
+	AttributeKeyOutflow   = "outflow"
+	AttributeKeyNet       = "net"
+	AttributeKeyThreshold = "threshold"
+	AttributeKeyWindow    = "window"
+)
@@ -7,12 +7,14 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 type (
 	InflationKeeper interface {
 		SetInflation(sdk.Context, sdk.Dec, string) (*sdk.Result, error)
 		AddDenoms(sdk.Context, []string) (*sdk.Result, error)
+		SetDistribution(sdk.Context, string, []inflationtypes.InflationRecipient) (*sdk.Result, error)
 	}
 
 	BankKeeper interface {
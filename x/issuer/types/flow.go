@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// FlowBucketDuration is the resolution of the recorded transfer flows. Windows start at a bucket boundary.
+	FlowBucketDuration = time.Hour
+	// FlowRetention is the longest window that flows can be summarized over.
+	FlowRetention = 7 * 24 * time.Hour
+	// DefaultFlowWindow is used by queries that do not specify a window.
+	DefaultFlowWindow = 24 * time.Hour
+)
+
+// Record adds a transfer to the bucket of now and drops the buckets that have passed the retention period.
+func (f *ChannelFlow) Record(now time.Time, inflow, outflow sdk.Int) {
+	start := now.Truncate(FlowBucketDuration)
+
+	if n := len(f.Buckets); n > 0 && f.Buckets[n-1].Start.Equal(start) {
+		f.Buckets[n-1].Inflow = f.Buckets[n-1].Inflow.Add(inflow)
+		f.Buckets[n-1].Outflow = f.Buckets[n-1].Outflow.Add(outflow)
+	} else {
+		f.Buckets = append(f.Buckets, FlowBucket{Start: start, Inflow: inflow, Outflow: outflow})
+	}
+
+	retained := now.Add(-FlowRetention).Truncate(FlowBucketDuration)
+
+	i := 0
+	for i < len(f.Buckets) && f.Buckets[i].Start.Before(retained) {
+		i++
+	}
+	f.Buckets = f.Buckets[i:]
+}
+
+// Summarize sums the buckets of the window ending at now.
+func (f ChannelFlow) Summarize(now time.Time, window time.Duration) FlowSummary {
+	summary := FlowSummary{
+		ChannelId: f.ChannelId,
+		Denom:     f.Denom,
+		Inflow:    sdk.ZeroInt(),
+		Outflow:   sdk.ZeroInt(),
+	}
+
+	start := now.Add(-window).Truncate(FlowBucketDuration)
+	for _, b := range f.Buckets {
+		if b.Start.Before(start) {
+			continue
+		}
+
+		summary.Inflow = summary.Inflow.Add(b.Inflow)
+		summary.Outflow = summary.Outflow.Add(b.Outflow)
+	}
+
+	summary.Net = summary.Inflow.Sub(summary.Outflow)
+	return summary
+}
+
+func (f ChannelFlow) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return err
+	}
+
+	for i, b := range f.Buckets {
+		if b.Inflow.IsNil() || b.Outflow.IsNil() {
+			return fmt.Errorf("flow of %v on channel %v is missing amounts", f.Denom, f.ChannelId)
+		}
+
+		if !b.Start.Equal(b.Start.Truncate(FlowBucketDuration)) {
+			return fmt.Errorf("flow bucket of %v on channel %v does not start on the hour", f.Denom, f.ChannelId)
+		}
+
+		if i > 0 && !b.Start.After(f.Buckets[i-1].Start) {
+			return fmt.Errorf("flow buckets of %v on channel %v must be sorted by time", f.Denom, f.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+func (a FlowAlert) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if a.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(a.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	if a.Threshold.IsNil() || !a.Threshold.IsPositive() {
+		return fmt.Errorf("flow alert threshold must be positive")
+	}
+
+	if a.Window <= 0 || a.Window > FlowRetention {
+		return fmt.Errorf("flow alert window must be positive and at most %v", FlowRetention)
+	}
+
+	return nil
+}
+
+// AppliesTo returns true if the alert covers the transfers of denom through channelID.
+func (a FlowAlert) AppliesTo(denom, channelID string) bool {
+	return a.Denom == denom && (a.ChannelId == "" || a.ChannelId == channelID)
+}
+
+// Crossed returns true if the net flow reached the threshold in either direction.
+func (a FlowAlert) Crossed(before, after FlowSummary) bool {
+	return before.Net.Abs().LT(a.Threshold) && after.Net.Abs().GTE(a.Threshold)
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (gs GenesisState) Validate() error {
+	issuers := make(map[string]bool)
+	denoms := make(map[string]bool)
+	for _, issuer := range gs.Issuers {
+		if _, err := sdk.AccAddressFromBech32(issuer.Address); err != nil {
+			return fmt.Errorf("invalid issuer address %v: %w", issuer.Address, err)
+		}
+
+		if issuers[issuer.Address] {
+			return fmt.Errorf("duplicate issuer %v", issuer.Address)
+		}
+		issuers[issuer.Address] = true
+
+		for _, denom := range issuer.Denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid denomination of issuer %v: %w", issuer.Address, err)
+			}
+
+			if denoms[denom] {
+				return fmt.Errorf("denomination %v is assigned more than once", denom)
+			}
+			denoms[denom] = true
+		}
+	}
+
+	policies := make(map[string]TransferPolicy)
+	for _, policy := range gs.TransferPolicies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer policy of %v: %w", policy.Denom, err)
+		}
+
+		if _, found := policies[policy.Denom]; found {
+			return fmt.Errorf("duplicate transfer policy for %v", policy.Denom)
+		}
+		policies[policy.Denom] = policy
+	}
+
+	seen := make(map[string]bool)
+	for _, outflow := range gs.TransferOutflows {
+		if err := outflow.Validate(); err != nil {
+			return err
+		}
+
+		key := outflow.Denom + "/" + outflow.ChannelId
+		if seen[key] {
+			return fmt.Errorf("duplicate outbound transfers of %v on channel %v", outflow.Denom, outflow.ChannelId)
+		}
+		seen[key] = true
+
+		if _, limited := policies[outflow.Denom].RateLimit(outflow.ChannelId); !limited {
+			return fmt.Errorf("outbound transfers of %v on channel %v without a rate limit", outflow.Denom, outflow.ChannelId)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, flow := range gs.ChannelFlows {
+		if err := flow.Validate(); err != nil {
+			return err
+		}
+
+		key := flow.Denom + "/" + flow.ChannelId
+		if seen[key] {
+			return fmt.Errorf("duplicate flow of %v on channel %v", flow.Denom, flow.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
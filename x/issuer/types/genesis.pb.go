@@ -24,7 +24,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Issuers []Issuer `protobuf:"bytes,1,rep,name=issuers,proto3" json:"issuers" yaml:"issuers"`
+	Issuers          []Issuer          `protobuf:"bytes,1,rep,name=issuers,proto3" json:"issuers" yaml:"issuers"`
+	TransferPolicies []TransferPolicy  `protobuf:"bytes,2,rep,name=transfer_policies,json=transferPolicies,proto3" json:"transfer_policies" yaml:"transfer_policies"`
+	TransferOutflows []TransferOutflow `protobuf:"bytes,3,rep,name=transfer_outflows,json=transferOutflows,proto3" json:"transfer_outflows" yaml:"transfer_outflows"`
+	ChannelFlows     []ChannelFlow     `protobuf:"bytes,4,rep,name=channel_flows,json=channelFlows,proto3" json:"channel_flows" yaml:"channel_flows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,6 +70,27 @@ func (m *GenesisState) GetIssuers() []Issuer {
 	return nil
 }
 
+func (m *GenesisState) GetTransferPolicies() []TransferPolicy {
+	if m != nil {
+		return m.TransferPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferOutflows() []TransferOutflow {
+	if m != nil {
+		return m.TransferOutflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelFlows() []ChannelFlow {
+	if m != nil {
+		return m.ChannelFlows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "em.issuer.v1.GenesisState")
 }
@@ -74,21 +98,28 @@ func init() {
 func init() { proto.RegisterFile("em/issuer/v1/genesis.proto", fileDescriptor_871df1e5fa6f8b20) }
 
 var fileDescriptor_871df1e5fa6f8b20 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xcd, 0xd5, 0xcf,
-	0x2c, 0x2e, 0x2e, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x49, 0xcd, 0xd5, 0x83, 0xc8, 0xe9, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x49, 0x14,
-	0xfd, 0x50, 0xd5, 0x60, 0x29, 0xa5, 0x30, 0x2e, 0x1e, 0x77, 0x88, 0x79, 0xc1, 0x25, 0x89, 0x25,
-	0xa9, 0x42, 0x6e, 0x5c, 0xec, 0x10, 0xf9, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x11,
-	0x3d, 0x64, 0x0b, 0xf4, 0x3c, 0xc1, 0x2c, 0x27, 0xb1, 0x13, 0xf7, 0xe4, 0x19, 0x3e, 0xdd, 0x93,
-	0xe7, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x82, 0x6a, 0x51, 0x0a, 0x82, 0x69, 0x76, 0x72, 0x3d,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x54, 0xdd, 0xdc, 0xfc, 0xbc, 0xd4, 0x4a, 0xfd, 0xd4, 0x5c,
-	0xdd, 0x9c, 0xd4, 0x94, 0xf4, 0xd4, 0x22, 0xfd, 0x0a, 0x98, 0x43, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0xae, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xca, 0xde, 0x5a, 0x02,
-	0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x13, 0x2b, 0x0a, 0xb1, 0x8a, 0x86, 0x22, 0x69, 0xa9, 0x69, 0xc9, 0x4a, 0x90, 0x66,
+	0xa8, 0xee, 0x5c, 0x56, 0xac, 0xb8, 0x52, 0xaa, 0x2b, 0x11, 0x4a, 0x1a, 0x5f, 0xd3, 0x60, 0x26,
+	0x53, 0xf2, 0xa6, 0xd5, 0x9c, 0x42, 0x8f, 0xd5, 0x65, 0x97, 0xae, 0x8a, 0xb4, 0x37, 0xf0, 0x04,
+	0xd2, 0x99, 0x09, 0x26, 0xa8, 0xbb, 0x81, 0xf7, 0xfd, 0xdf, 0xb7, 0x18, 0xa3, 0x06, 0x94, 0x84,
+	0x88, 0x13, 0x48, 0xc8, 0xb4, 0x4d, 0x02, 0x88, 0x01, 0x43, 0x74, 0xc7, 0x09, 0xe3, 0xcc, 0x2c,
+	0x03, 0x75, 0xe5, 0xcd, 0x9d, 0xb6, 0x6b, 0x95, 0x80, 0x05, 0x4c, 0x1c, 0xc8, 0xfa, 0x25, 0x99,
+	0x5a, 0xb5, 0xb0, 0x57, 0xb4, 0x38, 0x39, 0x6f, 0x25, 0xa3, 0x7c, 0x25, 0x85, 0x77, 0xdc, 0xe3,
+	0x60, 0x76, 0x8d, 0x6d, 0x09, 0xa0, 0xa5, 0x37, 0x4b, 0xc7, 0x3b, 0xa7, 0x15, 0x37, 0x5f, 0x70,
+	0xaf, 0xc5, 0xab, 0x73, 0x38, 0x5b, 0x34, 0xb4, 0xaf, 0x45, 0x63, 0x2f, 0xf5, 0x68, 0x74, 0xee,
+	0xa8, 0x89, 0xd3, 0xcb, 0xc6, 0xe6, 0xb3, 0x71, 0xc0, 0x13, 0x2f, 0xc6, 0x21, 0x24, 0xfd, 0x31,
+	0x8b, 0x42, 0x3f, 0x04, 0xb4, 0x36, 0x84, 0xb1, 0x5e, 0x34, 0xde, 0x2b, 0xec, 0x76, 0x4d, 0xa5,
+	0x9d, 0xa6, 0x32, 0x5b, 0xd2, 0xfc, 0x4b, 0xe2, 0xf4, 0xf6, 0x79, 0x7e, 0x11, 0x02, 0x9a, 0x51,
+	0x2e, 0xc6, 0x26, 0x7c, 0x18, 0xb1, 0x17, 0xb4, 0x4a, 0x22, 0x76, 0xf4, 0x77, 0xec, 0x46, 0x52,
+	0xff, 0xd6, 0x32, 0x4b, 0xae, 0xa6, 0x26, 0x68, 0x3e, 0x1a, 0xbb, 0xfe, 0xc8, 0x8b, 0x63, 0x88,
+	0xfa, 0xb2, 0xb4, 0x29, 0x4a, 0xd5, 0x62, 0xe9, 0x42, 0x22, 0xdd, 0x75, 0xa5, 0xae, 0x2a, 0x15,
+	0x59, 0x29, 0xac, 0x9d, 0x5e, 0xd9, 0xff, 0x41, 0xb1, 0x73, 0x39, 0x5b, 0xda, 0xfa, 0x7c, 0x69,
+	0xeb, 0x9f, 0x4b, 0x5b, 0x7f, 0x5f, 0xd9, 0xda, 0x7c, 0x65, 0x6b, 0x1f, 0x2b, 0x5b, 0x7b, 0x38,
+	0x09, 0x42, 0x3e, 0x9a, 0x0c, 0x5c, 0x9f, 0x51, 0x02, 0x2d, 0xca, 0x62, 0x48, 0x09, 0xd0, 0x56,
+	0x04, 0x4f, 0x01, 0x24, 0xe4, 0x35, 0xfb, 0x62, 0x9e, 0x8e, 0x01, 0x07, 0x5b, 0xe2, 0x7f, 0xcf,
+	0xbe, 0x07, 0x00, 0xd7, 0x25, 0x3e, 0xbc, 0x3c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +142,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelFlows) > 0 {
+		for iNdEx := len(m.ChannelFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TransferOutflows) > 0 {
+		for iNdEx := len(m.TransferOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransferPolicies) > 0 {
+		for iNdEx := len(m.TransferPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Issuers) > 0 {
 		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +224,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferPolicies) > 0 {
+		for _, e := range m.TransferPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferOutflows) > 0 {
+		for _, e := range m.TransferOutflows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelFlows) > 0 {
+		for _, e := range m.ChannelFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -223,6 +314,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPolicies = append(m.TransferPolicies, TransferPolicy{})
+			if err := m.TransferPolicies[len(m.TransferPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferOutflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferOutflows = append(m.TransferOutflows, TransferOutflow{})
+			if err := m.TransferOutflows[len(m.TransferOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFlows = append(m.ChannelFlows, ChannelFlow{})
+			if err := m.ChannelFlows[len(m.ChannelFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
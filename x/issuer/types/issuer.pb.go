@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -118,32 +124,537 @@ func (m *Issuers) GetIssuers() []Issuer {
 	return nil
 }
 
+// TransferPolicy restricts the IBC transfers of a denomination controlled by
+// an issuer.
+type TransferPolicy struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Channels the denomination may be sent through. An empty list allows all
+	// channels.
+	AllowedChannels []string            `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty" yaml:"allowed_channels"`
+	RateLimits      []TransferRateLimit `protobuf:"bytes,3,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	// Reject incoming transfers that would mint vouchers of the denomination
+	// issued on other chains.
+	BlockInboundVouchers bool `protobuf:"varint,4,opt,name=block_inbound_vouchers,json=blockInboundVouchers,proto3" json:"block_inbound_vouchers,omitempty" yaml:"block_inbound_vouchers"`
+}
+
+func (m *TransferPolicy) Reset()         { *m = TransferPolicy{} }
+func (m *TransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*TransferPolicy) ProtoMessage()    {}
+func (*TransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{2}
+}
+func (m *TransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPolicy.Merge(m, src)
+}
+func (m *TransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPolicy proto.InternalMessageInfo
+
+func (m *TransferPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferPolicy) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *TransferPolicy) GetRateLimits() []TransferRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *TransferPolicy) GetBlockInboundVouchers() bool {
+	if m != nil {
+		return m.BlockInboundVouchers
+	}
+	return false
+}
+
+// Params defines the parameters of the issuer module.
+type Params struct {
+	// flow_alerts covers any denomination, including vouchers of tokens issued
+	// on other chains.
+	FlowAlerts []FlowAlert `protobuf:"bytes,1,rep,name=flow_alerts,json=flowAlerts,proto3" json:"flow_alerts" yaml:"flow_alerts"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFlowAlerts() []FlowAlert {
+	if m != nil {
+		return m.FlowAlerts
+	}
+	return nil
+}
+
+// FlowAlert emits an event when the net amount of a denomination transferred
+// through a channel within the window reaches the threshold in either
+// direction.
+type FlowAlert struct {
+	// The denomination held on this chain, so vouchers are given by their ibc/
+	// hash.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// An empty channel applies the alert to every channel.
+	ChannelId string                                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Threshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold" yaml:"threshold"`
+	Window    time.Duration                          `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+}
+
+func (m *FlowAlert) Reset()         { *m = FlowAlert{} }
+func (m *FlowAlert) String() string { return proto.CompactTextString(m) }
+func (*FlowAlert) ProtoMessage()    {}
+func (*FlowAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{4}
+}
+func (m *FlowAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowAlert.Merge(m, src)
+}
+func (m *FlowAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowAlert proto.InternalMessageInfo
+
+func (m *FlowAlert) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FlowAlert) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *FlowAlert) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// TransferRateLimit caps the amount sent through a channel within a rolling
+// window.
+type TransferRateLimit struct {
+	ChannelId string                                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	Window    time.Duration                          `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+}
+
+func (m *TransferRateLimit) Reset()         { *m = TransferRateLimit{} }
+func (m *TransferRateLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimit) ProtoMessage()    {}
+func (*TransferRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{5}
+}
+func (m *TransferRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimit.Merge(m, src)
+}
+func (m *TransferRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimit proto.InternalMessageInfo
+
+func (m *TransferRateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TransferRateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// OutflowBucket sums the outbound transfers through a rate limited channel
+// within a fraction of the window of the limit.
+type OutflowBucket struct {
+	Start  time.Time                              `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start" yaml:"start"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *OutflowBucket) Reset()         { *m = OutflowBucket{} }
+func (m *OutflowBucket) String() string { return proto.CompactTextString(m) }
+func (*OutflowBucket) ProtoMessage()    {}
+func (*OutflowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{6}
+}
+func (m *OutflowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutflowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowBucket.Merge(m, src)
+}
+func (m *OutflowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowBucket proto.InternalMessageInfo
+
+func (m *OutflowBucket) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+// TransferOutflow holds the outbound transfers of a denomination through a
+// rate limited channel that are still within the window of the limit.
+type TransferOutflow struct {
+	Denom     string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ChannelId string          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Buckets   []OutflowBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets" yaml:"buckets"`
+}
+
+func (m *TransferOutflow) Reset()         { *m = TransferOutflow{} }
+func (m *TransferOutflow) String() string { return proto.CompactTextString(m) }
+func (*TransferOutflow) ProtoMessage()    {}
+func (*TransferOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{7}
+}
+func (m *TransferOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferOutflow.Merge(m, src)
+}
+func (m *TransferOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferOutflow proto.InternalMessageInfo
+
+func (m *TransferOutflow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferOutflow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TransferOutflow) GetBuckets() []OutflowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// FlowBucket sums the transfers of a channel and denomination within an
+// hour.
+type FlowBucket struct {
+	Start   time.Time                              `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start" yaml:"start"`
+	Inflow  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow" yaml:"inflow"`
+	Outflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow" yaml:"outflow"`
+}
+
+func (m *FlowBucket) Reset()         { *m = FlowBucket{} }
+func (m *FlowBucket) String() string { return proto.CompactTextString(m) }
+func (*FlowBucket) ProtoMessage()    {}
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{8}
+}
+func (m *FlowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowBucket.Merge(m, src)
+}
+func (m *FlowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowBucket proto.InternalMessageInfo
+
+func (m *FlowBucket) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+// ChannelFlow holds the recent transfers of a denomination through a channel.
+// The denomination is the one held on this chain, so vouchers appear by their
+// ibc/ hash.
+type ChannelFlow struct {
+	ChannelId string       `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Denom     string       `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Buckets   []FlowBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets" yaml:"buckets"`
+}
+
+func (m *ChannelFlow) Reset()         { *m = ChannelFlow{} }
+func (m *ChannelFlow) String() string { return proto.CompactTextString(m) }
+func (*ChannelFlow) ProtoMessage()    {}
+func (*ChannelFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0215b6b8fa8ee15b, []int{9}
+}
+func (m *ChannelFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFlow.Merge(m, src)
+}
+func (m *ChannelFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFlow proto.InternalMessageInfo
+
+func (m *ChannelFlow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFlow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChannelFlow) GetBuckets() []FlowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Issuer)(nil), "em.issuer.v1.Issuer")
 	proto.RegisterType((*Issuers)(nil), "em.issuer.v1.Issuers")
+	proto.RegisterType((*TransferPolicy)(nil), "em.issuer.v1.TransferPolicy")
+	proto.RegisterType((*Params)(nil), "em.issuer.v1.Params")
+	proto.RegisterType((*FlowAlert)(nil), "em.issuer.v1.FlowAlert")
+	proto.RegisterType((*TransferRateLimit)(nil), "em.issuer.v1.TransferRateLimit")
+	proto.RegisterType((*OutflowBucket)(nil), "em.issuer.v1.OutflowBucket")
+	proto.RegisterType((*TransferOutflow)(nil), "em.issuer.v1.TransferOutflow")
+	proto.RegisterType((*FlowBucket)(nil), "em.issuer.v1.FlowBucket")
+	proto.RegisterType((*ChannelFlow)(nil), "em.issuer.v1.ChannelFlow")
 }
 
 func init() { proto.RegisterFile("em/issuer/v1/issuer.proto", fileDescriptor_0215b6b8fa8ee15b) }
 
 var fileDescriptor_0215b6b8fa8ee15b = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xcd, 0xd5, 0xcf,
-	0x2c, 0x2e, 0x2e, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0x78, 0x52, 0x73, 0xf5, 0xa0, 0x02, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x09, 0x7d, 0x10, 0x0b, 0xa2, 0x46, 0x29, 0x91, 0x8b, 0xcd, 0x13, 0xac, 0x44, 0x48, 0x87, 0x8b,
-	0x3d, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe8,
-	0xd3, 0x3d, 0x79, 0xbe, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xa8, 0x84, 0x52, 0x10, 0x4c, 0x89,
-	0x90, 0x26, 0x17, 0x5b, 0x4a, 0x6a, 0x5e, 0x7e, 0x6e, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xa7,
-	0x93, 0xe0, 0xa7, 0x7b, 0xf2, 0xbc, 0x10, 0xc5, 0x10, 0x71, 0xa5, 0x20, 0xa8, 0x02, 0xa5, 0x70,
-	0x2e, 0x76, 0x88, 0x15, 0xc5, 0x42, 0x6e, 0x5c, 0xec, 0x10, 0x07, 0x81, 0xec, 0x60, 0xd6, 0xe0,
-	0x36, 0x12, 0xd1, 0x43, 0x76, 0xa3, 0x1e, 0x44, 0x9d, 0x93, 0xd8, 0x89, 0x7b, 0xf2, 0x0c, 0x08,
-	0xdb, 0xa1, 0x5a, 0x94, 0x82, 0x60, 0x9a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x72, 0x3d,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x54, 0xdd, 0xdc, 0xfc, 0xbc, 0xd4, 0x4a, 0xfd, 0xd4, 0x5c,
-	0xdd, 0x9c, 0xd4, 0x94, 0xf4, 0xd4, 0x22, 0xfd, 0x0a, 0x58, 0x80, 0x95, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0x43, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x27, 0xb4, 0x11, 0x17, 0x4a,
-	0x01, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x13, 0x48, 0x6e, 0x26, 0xfc, 0x5a, 0x5c, 0x08, 0x41, 0x37, 0xe6, 0xce, 0x02, 0xe5,
+	0xea, 0x5e, 0x6c, 0xc1, 0xed, 0x8a, 0x4d, 0x5b, 0xb7, 0x8d, 0x14, 0x44, 0x55, 0x34, 0x42, 0x45,
+	0x42, 0x55, 0x53, 0x27, 0x9e, 0x24, 0x16, 0xb6, 0x07, 0x79, 0xc6, 0xa4, 0x79, 0x88, 0x4a, 0x2c,
+	0xe9, 0xae, 0x0f, 0x51, 0x89, 0x5d, 0xd7, 0x2c, 0x59, 0x56, 0x5d, 0xb8, 0x15, 0xbc, 0x40, 0x95,
+	0x27, 0xa8, 0xec, 0x99, 0x81, 0x24, 0x54, 0x15, 0xb4, 0x65, 0x45, 0x3c, 0xe7, 0x3b, 0xdf, 0x39,
+	0xdf, 0x37, 0xe7, 0x8c, 0x00, 0x8b, 0xd8, 0x33, 0x1c, 0x4a, 0x43, 0x1c, 0x18, 0x87, 0x6b, 0xe2,
+	0x97, 0x7e, 0x10, 0x10, 0x46, 0xd4, 0x09, 0xec, 0xe9, 0xe2, 0xe0, 0x70, 0xad, 0x34, 0xd7, 0x26,
+	0x6d, 0x92, 0x04, 0x8c, 0xf8, 0x17, 0xc7, 0x94, 0xca, 0x6d, 0x42, 0xda, 0x2e, 0x36, 0x92, 0xaf,
+	0x46, 0xd8, 0x32, 0xec, 0x30, 0xb0, 0x98, 0x43, 0x7c, 0x11, 0xd7, 0x46, 0xe3, 0xcc, 0xf1, 0x30,
+	0x65, 0x96, 0x77, 0xc0, 0x01, 0xd0, 0x02, 0xd9, 0x5a, 0x52, 0x43, 0xfd, 0x0f, 0xe4, 0x2c, 0xdb,
+	0x0e, 0x30, 0xa5, 0x45, 0x65, 0x59, 0xa9, 0xe4, 0x4d, 0xb5, 0x1f, 0x69, 0x53, 0x3d, 0xcb, 0x73,
+	0x37, 0xa0, 0x08, 0x40, 0x24, 0x21, 0xea, 0x3f, 0x20, 0x6b, 0x63, 0x9f, 0x78, 0xb4, 0x98, 0x5e,
+	0xce, 0x54, 0xf2, 0xe6, 0x6c, 0x3f, 0xd2, 0x26, 0x39, 0x98, 0x9f, 0x43, 0x24, 0x00, 0x70, 0x17,
+	0xe4, 0x78, 0x09, 0xaa, 0x56, 0x41, 0x8e, 0x2b, 0x8a, 0x6b, 0x64, 0x2a, 0x85, 0xf5, 0x39, 0x7d,
+	0x50, 0xa4, 0xce, 0x71, 0xe6, 0xfc, 0x69, 0xa4, 0xa5, 0xae, 0xaa, 0x8b, 0x14, 0x88, 0x64, 0xf2,
+	0xc6, 0xd8, 0xf1, 0x3b, 0x2d, 0x05, 0x4f, 0xd2, 0x60, 0x6a, 0x27, 0xb0, 0x7c, 0xda, 0xc2, 0xc1,
+	0x36, 0x71, 0x9d, 0x66, 0x4f, 0x5d, 0x01, 0xe3, 0x49, 0x55, 0x21, 0x61, 0xa6, 0x1f, 0x69, 0x13,
+	0x03, 0x5d, 0x41, 0xc4, 0xc3, 0x6a, 0x15, 0xcc, 0x58, 0xae, 0x4b, 0xba, 0xd8, 0xae, 0x37, 0x3b,
+	0x96, 0xef, 0x63, 0x57, 0x0a, 0x59, 0xea, 0x47, 0xda, 0x82, 0x50, 0x3d, 0x82, 0x80, 0x68, 0x5a,
+	0x1c, 0x3d, 0x12, 0x27, 0xea, 0x0b, 0x50, 0x08, 0x2c, 0x86, 0xeb, 0xae, 0xe3, 0x39, 0x8c, 0x16,
+	0x33, 0x89, 0x28, 0x6d, 0x58, 0x94, 0x6c, 0x11, 0x59, 0x0c, 0x6f, 0xc5, 0x38, 0xb3, 0x24, 0xf4,
+	0xa9, 0xbc, 0xce, 0x00, 0x03, 0x44, 0x20, 0x90, 0x30, 0xaa, 0xee, 0x82, 0xf9, 0x86, 0x4b, 0x9a,
+	0xfb, 0x75, 0xc7, 0x6f, 0x90, 0xd0, 0xb7, 0xeb, 0x87, 0x24, 0x6c, 0x76, 0x62, 0xf7, 0xc6, 0x96,
+	0x95, 0xca, 0x1f, 0xe6, 0xdf, 0xfd, 0x48, 0xfb, 0x8b, 0x73, 0x7c, 0x1f, 0x07, 0xd1, 0x5c, 0x12,
+	0xa8, 0xf1, 0xf3, 0xe7, 0xf2, 0xf8, 0x25, 0xc8, 0x6e, 0x5b, 0x81, 0xe5, 0x51, 0x75, 0x07, 0x14,
+	0x5a, 0x2e, 0xe9, 0xd6, 0x2d, 0x17, 0x07, 0x4c, 0xde, 0xca, 0xc2, 0xb0, 0x80, 0xaa, 0x4b, 0xba,
+	0x0f, 0xe3, 0xf8, 0x68, 0xe3, 0x03, 0x99, 0x10, 0x81, 0x96, 0x84, 0x51, 0xf8, 0x36, 0x0d, 0xf2,
+	0x97, 0x59, 0x37, 0xbe, 0x94, 0x7b, 0x00, 0x08, 0xab, 0xeb, 0x8e, 0x5d, 0x4c, 0x27, 0xe0, 0x3f,
+	0xfb, 0x91, 0x36, 0xcb, 0xc1, 0x57, 0x31, 0x88, 0xf2, 0xe2, 0xa3, 0x66, 0xab, 0xaf, 0x40, 0x9e,
+	0x75, 0x02, 0x4c, 0x3b, 0xc4, 0xb5, 0x8b, 0x99, 0x24, 0xc9, 0x8c, 0xdb, 0xfc, 0x14, 0x69, 0x2b,
+	0x6d, 0x87, 0x75, 0xc2, 0x86, 0xde, 0x24, 0x9e, 0xd1, 0x24, 0xd4, 0x23, 0x54, 0xfc, 0x59, 0xa5,
+	0xf6, 0xbe, 0xc1, 0x7a, 0x07, 0x98, 0xea, 0x35, 0x9f, 0xf5, 0x23, 0x6d, 0x86, 0x97, 0xb8, 0x24,
+	0x82, 0xe8, 0x8a, 0x54, 0xdd, 0x02, 0xd9, 0xae, 0xe3, 0xdb, 0xa4, 0x9b, 0xd8, 0x5e, 0x58, 0x5f,
+	0xd4, 0xf9, 0x56, 0xe9, 0x72, 0xab, 0xf4, 0xc7, 0x62, 0xeb, 0xcc, 0x45, 0x61, 0x90, 0x58, 0x05,
+	0x9e, 0x06, 0x8f, 0x3f, 0x6b, 0x0a, 0x12, 0x1c, 0xf0, 0xab, 0x02, 0x66, 0xaf, 0x8d, 0xc4, 0x88,
+	0x76, 0xe5, 0x86, 0xda, 0x77, 0x41, 0xd6, 0xf2, 0x48, 0xe8, 0x33, 0xe1, 0xd6, 0xfd, 0x5b, 0x0b,
+	0x17, 0x8d, 0x72, 0x16, 0x88, 0x04, 0xdd, 0x80, 0xe4, 0xcc, 0x6f, 0x90, 0xfc, 0x5e, 0x01, 0x93,
+	0xcf, 0x42, 0x16, 0x0f, 0x88, 0x19, 0x36, 0xf7, 0x31, 0x53, 0x37, 0xc1, 0x38, 0x65, 0x56, 0xc0,
+	0x12, 0xa5, 0x85, 0xf5, 0xd2, 0x35, 0xfa, 0x1d, 0xf9, 0x4e, 0x99, 0x45, 0xc1, 0x2f, 0x46, 0x26,
+	0x49, 0x83, 0x47, 0x31, 0x3d, 0xa7, 0xb8, 0x33, 0x13, 0xe0, 0x07, 0x05, 0x4c, 0xcb, 0x9b, 0x12,
+	0xed, 0xdf, 0xf1, 0x2c, 0x3f, 0x05, 0xb9, 0x46, 0x62, 0x90, 0x7c, 0x4a, 0x96, 0x86, 0x37, 0x71,
+	0xc8, 0xc4, 0xd1, 0x67, 0x52, 0x64, 0x42, 0x24, 0x39, 0xe0, 0x9b, 0x34, 0x00, 0xd5, 0x3b, 0x33,
+	0xdd, 0xf1, 0xe3, 0x5e, 0x7e, 0xd5, 0x74, 0xce, 0x02, 0x91, 0xa0, 0x53, 0xf7, 0x40, 0x8e, 0x70,
+	0x95, 0x62, 0x99, 0x1f, 0xdc, 0x9a, 0x59, 0xf8, 0x21, 0x68, 0x20, 0x92, 0x84, 0xf0, 0x44, 0x01,
+	0x05, 0xf1, 0x74, 0xc7, 0xb6, 0xfc, 0xe4, 0xd2, 0x5d, 0x8e, 0x40, 0xfa, 0xc7, 0x23, 0xb0, 0x39,
+	0x7a, 0x99, 0xc5, 0xeb, 0xcf, 0xea, 0x0d, 0x6f, 0xd2, 0x7c, 0x72, 0x7a, 0x5e, 0x56, 0xce, 0xce,
+	0xcb, 0xca, 0x97, 0xf3, 0xb2, 0x72, 0x74, 0x51, 0x4e, 0x9d, 0x5d, 0x94, 0x53, 0x1f, 0x2f, 0xca,
+	0xa9, 0xbd, 0x7f, 0x07, 0x6c, 0xc1, 0xab, 0x1e, 0xf1, 0x71, 0xcf, 0xc0, 0xde, 0xaa, 0x8b, 0xed,
+	0x36, 0x0e, 0x8c, 0xd7, 0xf2, 0x9f, 0x8b, 0xc4, 0x9f, 0x46, 0x36, 0xb9, 0xea, 0xff, 0xbf, 0x0d,
+	0x00, 0xa7, 0x9d, 0x54, 0x51, 0x76, 0x08, 0x00, 0x00,
 }
 
 func (m *Issuer) Marshal() (dAtA []byte, err error) {
@@ -212,68 +723,1665 @@ func (m *Issuers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintIssuer(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintIssuer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInboundVouchers {
+		i--
+		if m.BlockInboundVouchers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssuer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintIssuer(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlowAlerts) > 0 {
+		for iNdEx := len(m.FlowAlerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlowAlerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssuer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIssuer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIssuer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIssuer(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIssuer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIssuer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintIssuer(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssuer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIssuer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIssuer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintIssuer(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssuer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIssuer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIssuer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIssuer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Issuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Issuers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for _, e := range m.Issuers {
+			l = e.Size()
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	if m.BlockInboundVouchers {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FlowAlerts) > 0 {
+		for _, e := range m.FlowAlerts {
+			l = e.Size()
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlowAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovIssuer(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovIssuer(uint64(l))
+	return n
+}
+
+func (m *TransferRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIssuer(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovIssuer(uint64(l))
+	return n
+}
+
+func (m *OutflowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovIssuer(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovIssuer(uint64(l))
+	return n
+}
+
+func (m *TransferOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovIssuer(uint64(l))
+	l = m.Inflow.Size()
+	n += 1 + l + sovIssuer(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovIssuer(uint64(l))
+	return n
+}
+
+func (m *ChannelFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIssuer(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovIssuer(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIssuer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIssuer(x uint64) (n int) {
+	return sovIssuer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Issuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Issuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Issuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Issuers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Issuers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Issuers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuers = append(m.Issuers, Issuer{})
+			if err := m.Issuers[len(m.Issuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, TransferRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInboundVouchers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockInboundVouchers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowAlerts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlowAlerts = append(m.FlowAlerts, FlowAlert{})
+			if err := m.FlowAlerts[len(m.FlowAlerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssuer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintIssuer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIssuer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Issuer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovIssuer(uint64(l))
-	}
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovIssuer(uint64(l))
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, OutflowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssuer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Issuers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Issuers) > 0 {
-		for _, e := range m.Issuers {
-			l = e.Size()
-			n += 1 + l + sovIssuer(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovIssuer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIssuer(x uint64) (n int) {
-	return sovIssuer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Issuer) Unmarshal(dAtA []byte) error {
+func (m *FlowBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -296,15 +2404,48 @@ func (m *Issuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Issuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlowBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Issuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -332,11 +2473,13 @@ func (m *Issuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -364,7 +2507,9 @@ func (m *Issuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -387,7 +2532,7 @@ func (m *Issuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Issuers) Unmarshal(dAtA []byte) error {
+func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -410,15 +2555,79 @@ func (m *Issuers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Issuers: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Issuers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssuer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssuer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -445,8 +2654,8 @@ func (m *Issuers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuers = append(m.Issuers, Issuer{})
-			if err := m.Issuers[len(m.Issuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, FlowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4,6 +4,8 @@
 
 package types
 
+import "github.com/cosmos/cosmos-sdk/types/address"
+
 const (
 	// module name
 	ModuleName = "issuer"
@@ -14,3 +16,29 @@ const (
 
 	QueryIssuers = "issuers"
 )
+
+var (
+	TransferPolicyKeyPrefix  = []byte{0x01}
+	TransferOutflowKeyPrefix = []byte{0x02}
+	ChannelFlowKeyPrefix     = []byte{0x03}
+)
+
+func GetTransferPolicyKey(denom string) []byte {
+	return append(TransferPolicyKeyPrefix, []byte(denom)...)
+}
+
+func GetTransferOutflowPrefix(denom string) []byte {
+	return append(TransferOutflowKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+func GetTransferOutflowKey(denom, channelID string) []byte {
+	return append(GetTransferOutflowPrefix(denom), []byte(channelID)...)
+}
+
+func GetChannelFlowPrefix(denom string) []byte {
+	return append(ChannelFlowKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+func GetChannelFlowKey(denom, channelID string) []byte {
+	return append(GetChannelFlowPrefix(denom), []byte(channelID)...)
+}
@@ -7,6 +7,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	inflationtypes "github.com/e-money/em-ledger/x/inflation/types"
 )
 
 var (
@@ -14,8 +15,70 @@ var (
 	_ sdk.Msg = &MsgDecreaseMintable{}
 	_ sdk.Msg = &MsgRevokeLiquidityProvider{}
 	_ sdk.Msg = &MsgSetInflation{}
+	_ sdk.Msg = &MsgSetInflationDistribution{}
+	_ sdk.Msg = &MsgSetTransferPolicy{}
 )
 
+func (msg MsgSetTransferPolicy) Route() string { return ModuleName }
+
+func (msg MsgSetTransferPolicy) Type() string { return "set_transfer_policy" }
+
+func (msg MsgSetTransferPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTransferPolicy, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgSetTransferPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetTransferPolicy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetInflationDistribution) Route() string { return ModuleName }
+
+func (msg MsgSetInflationDistribution) Type() string { return "set_inflation_distribution" }
+
+func (msg MsgSetInflationDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(ErrDenomInflation, err.Error())
+	}
+
+	if err := inflationtypes.ValidateDistribution(msg.Recipients); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDistribution, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgSetInflationDistribution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetInflationDistribution) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (msg MsgSetInflation) Route() string { return ModuleName }
 
 func (msg MsgSetInflation) Type() string { return "set_inflation" }
@@ -0,0 +1,46 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const DefaultParamspace = ModuleName
+
+var KeyFlowAlerts = []byte("FlowAlerts")
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func DefaultParams() Params {
+	return Params{}
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFlowAlerts, &p.FlowAlerts, validateFlowAlerts),
+	}
+}
+
+func validateFlowAlerts(i interface{}) error {
+	v, ok := i.([]FlowAlert)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, alert := range v {
+		if err := alert.Validate(); err != nil {
+			return fmt.Errorf("invalid flow alert: %w", err)
+		}
+	}
+
+	return nil
+}
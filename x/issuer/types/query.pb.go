@@ -7,22 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,35 +115,375 @@ func (m *QueryIssuersResponse) GetIssuers() []Issuer {
 	return nil
 }
 
+type QueryTransferPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryTransferPolicyRequest) Reset()         { *m = QueryTransferPolicyRequest{} }
+func (m *QueryTransferPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferPolicyRequest) ProtoMessage()    {}
+func (*QueryTransferPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{2}
+}
+func (m *QueryTransferPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferPolicyRequest.Merge(m, src)
+}
+func (m *QueryTransferPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryTransferPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryTransferPolicyResponse struct {
+	Policy TransferPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+	// Amounts sent through the rate limited channels within their windows.
+	Outflows []ChannelOutflow `protobuf:"bytes,2,rep,name=outflows,proto3" json:"outflows" yaml:"outflows"`
+}
+
+func (m *QueryTransferPolicyResponse) Reset()         { *m = QueryTransferPolicyResponse{} }
+func (m *QueryTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferPolicyResponse) ProtoMessage()    {}
+func (*QueryTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{3}
+}
+func (m *QueryTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferPolicyResponse.Merge(m, src)
+}
+func (m *QueryTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryTransferPolicyResponse) GetPolicy() TransferPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return TransferPolicy{}
+}
+
+func (m *QueryTransferPolicyResponse) GetOutflows() []ChannelOutflow {
+	if m != nil {
+		return m.Outflows
+	}
+	return nil
+}
+
+type ChannelOutflow struct {
+	ChannelId string                                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	Limit     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit" yaml:"limit"`
+}
+
+func (m *ChannelOutflow) Reset()         { *m = ChannelOutflow{} }
+func (m *ChannelOutflow) String() string { return proto.CompactTextString(m) }
+func (*ChannelOutflow) ProtoMessage()    {}
+func (*ChannelOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{4}
+}
+func (m *ChannelOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOutflow.Merge(m, src)
+}
+func (m *ChannelOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOutflow proto.InternalMessageInfo
+
+func (m *ChannelOutflow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryFlowsRequest struct {
+	// Optional filters.
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// Defaults to 24 hours when zero.
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+}
+
+func (m *QueryFlowsRequest) Reset()         { *m = QueryFlowsRequest{} }
+func (m *QueryFlowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlowsRequest) ProtoMessage()    {}
+func (*QueryFlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{5}
+}
+func (m *QueryFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlowsRequest.Merge(m, src)
+}
+func (m *QueryFlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlowsRequest proto.InternalMessageInfo
+
+func (m *QueryFlowsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryFlowsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryFlowsRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type QueryFlowsResponse struct {
+	Flows []FlowSummary `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows" yaml:"flows"`
+}
+
+func (m *QueryFlowsResponse) Reset()         { *m = QueryFlowsResponse{} }
+func (m *QueryFlowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlowsResponse) ProtoMessage()    {}
+func (*QueryFlowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{6}
+}
+func (m *QueryFlowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlowsResponse.Merge(m, src)
+}
+func (m *QueryFlowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlowsResponse proto.InternalMessageInfo
+
+func (m *QueryFlowsResponse) GetFlows() []FlowSummary {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+type FlowSummary struct {
+	ChannelId string                                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Denom     string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Inflow    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow" yaml:"inflow"`
+	Outflow   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow" yaml:"outflow"`
+	// Inflow minus outflow.
+	Net github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=net,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"net" yaml:"net"`
+}
+
+func (m *FlowSummary) Reset()         { *m = FlowSummary{} }
+func (m *FlowSummary) String() string { return proto.CompactTextString(m) }
+func (*FlowSummary) ProtoMessage()    {}
+func (*FlowSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58837c42d7dad2b1, []int{7}
+}
+func (m *FlowSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowSummary.Merge(m, src)
+}
+func (m *FlowSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowSummary proto.InternalMessageInfo
+
+func (m *FlowSummary) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *FlowSummary) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryIssuersRequest)(nil), "em.issuer.v1.QueryIssuersRequest")
 	proto.RegisterType((*QueryIssuersResponse)(nil), "em.issuer.v1.QueryIssuersResponse")
+	proto.RegisterType((*QueryTransferPolicyRequest)(nil), "em.issuer.v1.QueryTransferPolicyRequest")
+	proto.RegisterType((*QueryTransferPolicyResponse)(nil), "em.issuer.v1.QueryTransferPolicyResponse")
+	proto.RegisterType((*ChannelOutflow)(nil), "em.issuer.v1.ChannelOutflow")
+	proto.RegisterType((*QueryFlowsRequest)(nil), "em.issuer.v1.QueryFlowsRequest")
+	proto.RegisterType((*QueryFlowsResponse)(nil), "em.issuer.v1.QueryFlowsResponse")
+	proto.RegisterType((*FlowSummary)(nil), "em.issuer.v1.FlowSummary")
 }
 
 func init() { proto.RegisterFile("em/issuer/v1/query.proto", fileDescriptor_58837c42d7dad2b1) }
 
 var fileDescriptor_58837c42d7dad2b1 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x3f, 0x4b, 0xfb, 0x40,
-	0x18, 0xc7, 0x93, 0xdf, 0x0f, 0x2d, 0x44, 0x71, 0x88, 0x55, 0x6a, 0x28, 0x57, 0xbd, 0x49, 0x90,
-	0xe6, 0x48, 0xdd, 0x1c, 0x0b, 0x0a, 0x8e, 0x76, 0x74, 0x10, 0x92, 0xfa, 0x70, 0x06, 0x7a, 0xf7,
-	0xa4, 0x79, 0x2e, 0xc5, 0x0c, 0x2e, 0xbe, 0x02, 0xc1, 0x37, 0xd5, 0xb1, 0xe0, 0xe2, 0x54, 0xa4,
-	0xf5, 0x15, 0xf8, 0x0a, 0xa4, 0x49, 0x2a, 0x06, 0xc5, 0x2d, 0x3c, 0x9f, 0xef, 0xbf, 0x9c, 0xd3,
-	0x02, 0x25, 0x62, 0xa2, 0x0c, 0x52, 0x31, 0x09, 0xc4, 0x38, 0x83, 0x34, 0xf7, 0x93, 0x14, 0x0d,
-	0xba, 0xdb, 0xa0, 0xfc, 0x92, 0xf8, 0x93, 0xc0, 0x6b, 0x4a, 0x94, 0x58, 0x00, 0xb1, 0xfa, 0x2a,
-	0x35, 0x1e, 0x1b, 0x22, 0x29, 0x24, 0x11, 0x85, 0x04, 0x62, 0x12, 0x44, 0x60, 0xc2, 0x40, 0x0c,
-	0x31, 0xd6, 0x15, 0x6f, 0x4b, 0x44, 0x39, 0x02, 0x11, 0x26, 0xb1, 0x08, 0xb5, 0x46, 0x13, 0x9a,
-	0x18, 0x35, 0x55, 0xf4, 0xa0, 0xd6, 0x5d, 0x75, 0x15, 0x88, 0xef, 0x39, 0xbb, 0x57, 0xab, 0x2d,
-	0x97, 0xc5, 0x91, 0x06, 0x30, 0xce, 0x80, 0x0c, 0xbf, 0x71, 0x9a, 0xf5, 0x33, 0x25, 0xa8, 0x09,
-	0xdc, 0x0b, 0xa7, 0x51, 0xda, 0xa9, 0x65, 0x1f, 0xfe, 0x3f, 0xde, 0xea, 0x35, 0xfd, 0xef, 0xeb,
-	0xfd, 0x52, 0xdf, 0xdf, 0x9f, 0xce, 0x3b, 0xd6, 0xc7, 0xbc, 0xb3, 0x93, 0x87, 0x6a, 0x74, 0xc6,
-	0x2b, 0x0b, 0x1f, 0xac, 0xcd, 0xbd, 0x07, 0x67, 0xa3, 0xc8, 0x77, 0x8d, 0xd3, 0xa8, 0x3a, 0xdc,
-	0xa3, 0x7a, 0xd4, 0x2f, 0xb3, 0x3c, 0xfe, 0x97, 0xa4, 0x9c, 0xc8, 0xf9, 0xe3, 0xcb, 0xfb, 0xf3,
-	0xbf, 0xb6, 0xeb, 0x09, 0xe8, 0x2a, 0xd4, 0x90, 0xff, 0xf8, 0x75, 0xea, 0x9f, 0x4f, 0x17, 0xcc,
-	0x9e, 0x2d, 0x98, 0xfd, 0xb6, 0x60, 0xf6, 0xd3, 0x92, 0x59, 0xb3, 0x25, 0xb3, 0x5e, 0x97, 0xcc,
-	0xba, 0x3e, 0x91, 0xb1, 0xb9, 0xcb, 0x22, 0x7f, 0x88, 0xea, 0xcb, 0x0f, 0xaa, 0x3b, 0x82, 0x5b,
-	0x09, 0xa9, 0xb8, 0x5f, 0x67, 0x99, 0x3c, 0x01, 0x8a, 0x36, 0x8b, 0x37, 0x3c, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0x2e, 0x20, 0x27, 0x41, 0xdc, 0x01, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6f, 0x13, 0x39,
+	0x14, 0xc7, 0x33, 0xc9, 0x26, 0xdd, 0x3a, 0xdd, 0xec, 0xd6, 0x9b, 0xee, 0x4e, 0x66, 0xab, 0x24,
+	0xeb, 0x43, 0xd5, 0xfd, 0xd1, 0xb1, 0x12, 0x38, 0x21, 0x04, 0x28, 0xb4, 0x95, 0x2a, 0x10, 0xd0,
+	0xa1, 0x52, 0xa5, 0x22, 0x51, 0x4d, 0x12, 0x27, 0x1d, 0x31, 0x63, 0xa7, 0x63, 0x4f, 0x4b, 0x84,
+	0xb8, 0x70, 0xe1, 0x8a, 0xc4, 0x05, 0xfe, 0x18, 0xc4, 0xb5, 0xc7, 0x4a, 0x5c, 0x10, 0x87, 0x80,
+	0x5a, 0xfe, 0x00, 0x54, 0x89, 0x3b, 0x8a, 0xed, 0xb4, 0x19, 0x1a, 0xaa, 0x06, 0x4e, 0xc9, 0xf8,
+	0xbd, 0xef, 0xe7, 0x3d, 0xdb, 0xdf, 0x67, 0x60, 0x92, 0x00, 0x7b, 0x9c, 0x47, 0x24, 0xc4, 0x3b,
+	0x15, 0xbc, 0x1d, 0x91, 0xb0, 0x6b, 0x77, 0x42, 0x26, 0x18, 0x9c, 0x22, 0x81, 0xad, 0x22, 0xf6,
+	0x4e, 0xc5, 0xca, 0xb7, 0x59, 0x9b, 0xc9, 0x00, 0xee, 0xff, 0x53, 0x39, 0x56, 0xb1, 0xc1, 0x78,
+	0xc0, 0x38, 0xae, 0xbb, 0x9c, 0xe0, 0x9d, 0x4a, 0x9d, 0x08, 0xb7, 0x82, 0x1b, 0xcc, 0xa3, 0x3a,
+	0x3e, 0xdb, 0x66, 0xac, 0xed, 0x13, 0xec, 0x76, 0x3c, 0xec, 0x52, 0xca, 0x84, 0x2b, 0x3c, 0x46,
+	0xf9, 0x40, 0xad, 0xa3, 0xf2, 0xab, 0x1e, 0xb5, 0x70, 0x33, 0x0a, 0x65, 0x82, 0x8e, 0x17, 0x62,
+	0xbd, 0xe9, 0x5e, 0x64, 0x08, 0xcd, 0x80, 0xdf, 0x57, 0xfb, 0xbd, 0xae, 0xc8, 0x45, 0xee, 0x90,
+	0xed, 0x88, 0x70, 0x81, 0xee, 0x83, 0x7c, 0x7c, 0x99, 0x77, 0x18, 0xe5, 0x04, 0x2e, 0x83, 0x09,
+	0x25, 0xe7, 0xa6, 0x51, 0x4e, 0xcd, 0x67, 0xab, 0x79, 0x7b, 0x78, 0x77, 0xb6, 0xca, 0xaf, 0xfd,
+	0xb1, 0xd7, 0x2b, 0x25, 0x8e, 0x7a, 0xa5, 0x5c, 0xd7, 0x0d, 0xfc, 0x4b, 0x48, 0x4b, 0x90, 0x33,
+	0x10, 0xa3, 0x45, 0x60, 0x49, 0xfe, 0x5a, 0xe8, 0x52, 0xde, 0x22, 0xe1, 0x1d, 0xe6, 0x7b, 0x8d,
+	0xae, 0xae, 0x0e, 0xe7, 0x40, 0xba, 0x49, 0x28, 0x0b, 0x4c, 0xa3, 0x6c, 0xcc, 0x4f, 0xd6, 0x7e,
+	0x3b, 0xea, 0x95, 0xa6, 0x14, 0x49, 0x2e, 0x23, 0x47, 0x85, 0xd1, 0x2b, 0x03, 0xfc, 0x35, 0x12,
+	0xa3, 0xbb, 0xbd, 0x01, 0x32, 0x1d, 0xb9, 0x22, 0x41, 0xd9, 0xea, 0x6c, 0xbc, 0xd9, 0xb8, 0xaa,
+	0x36, 0xa3, 0x9b, 0xfe, 0x45, 0x95, 0x52, 0x4a, 0xe4, 0x68, 0x04, 0x5c, 0x05, 0x3f, 0xb3, 0x48,
+	0xb4, 0x7c, 0xb6, 0xcb, 0xcd, 0x64, 0x39, 0x75, 0x1a, 0x77, 0x7d, 0xcb, 0xa5, 0x94, 0xf8, 0xb7,
+	0x55, 0x52, 0xed, 0x4f, 0x8d, 0xfb, 0x55, 0xe1, 0x06, 0x5a, 0xe4, 0x1c, 0x63, 0xd0, 0x67, 0x03,
+	0xe4, 0xe2, 0x2a, 0x78, 0x11, 0x80, 0x86, 0x5a, 0xd9, 0xf4, 0x9a, 0x7a, 0xff, 0x33, 0x47, 0xbd,
+	0xd2, 0xb4, 0xa2, 0x9c, 0xc4, 0x90, 0x33, 0xa9, 0x3f, 0x56, 0x9a, 0x70, 0x1d, 0x64, 0xdc, 0x80,
+	0x45, 0x54, 0x98, 0x49, 0xa9, 0xb8, 0xda, 0xaf, 0xfd, 0xae, 0x57, 0x9a, 0x6b, 0x7b, 0x62, 0x2b,
+	0xaa, 0xdb, 0x0d, 0x16, 0x60, 0xed, 0x30, 0xf5, 0xb3, 0xc0, 0x9b, 0x0f, 0xb0, 0xe8, 0x76, 0x08,
+	0xb7, 0x57, 0xa8, 0x38, 0xd9, 0xb4, 0xa2, 0x20, 0x47, 0xe3, 0xe0, 0x1a, 0x48, 0xfb, 0x5e, 0xe0,
+	0x09, 0x33, 0x25, 0xb9, 0x57, 0xc6, 0xe6, 0xea, 0x7b, 0x93, 0x10, 0xe4, 0x28, 0x18, 0x7a, 0x6d,
+	0x80, 0x69, 0x79, 0x6f, 0xcb, 0xfd, 0x63, 0x18, 0xf3, 0xd6, 0xbf, 0x3a, 0xa2, 0xe4, 0x39, 0x8f,
+	0xe8, 0x26, 0xc8, 0xec, 0x7a, 0xb4, 0xc9, 0x76, 0xe5, 0x56, 0xb2, 0xd5, 0x82, 0xad, 0x86, 0xc6,
+	0x1e, 0x0c, 0x8d, 0xbd, 0xa8, 0x87, 0xa6, 0x56, 0x88, 0x1b, 0x41, 0xc9, 0xd0, 0x8b, 0xf7, 0x25,
+	0xc3, 0xd1, 0x0c, 0x74, 0x0f, 0xc0, 0xe1, 0x0d, 0x68, 0xbf, 0x2d, 0x81, 0xb4, 0xf2, 0x87, 0x9a,
+	0x8d, 0x42, 0xdc, 0x1f, 0xfd, 0xdc, 0xbb, 0x51, 0x10, 0xb8, 0x61, 0xb7, 0x96, 0xd7, 0x25, 0xf4,
+	0x06, 0xb5, 0x33, 0x94, 0x1a, 0x7d, 0x4a, 0x82, 0xec, 0x50, 0xf2, 0x77, 0x7a, 0xe2, 0xf8, 0x38,
+	0x93, 0x67, 0x1f, 0xe7, 0x3a, 0xc8, 0x78, 0xb4, 0xe5, 0xeb, 0x83, 0xf9, 0x01, 0xef, 0x28, 0x0a,
+	0x72, 0x34, 0x0e, 0x6e, 0x80, 0x09, 0xed, 0x74, 0xf3, 0x27, 0x49, 0xbe, 0x36, 0x36, 0x39, 0x17,
+	0x9b, 0x1d, 0xe4, 0x0c, 0x80, 0xf0, 0x16, 0x48, 0x51, 0x22, 0xcc, 0xb4, 0xe4, 0x5e, 0x1e, 0x9b,
+	0x0b, 0x14, 0x97, 0x12, 0x81, 0x9c, 0x3e, 0xa8, 0xfa, 0x34, 0x05, 0xd2, 0xf2, 0x42, 0xa1, 0x00,
+	0x13, 0xfa, 0xd1, 0x83, 0x7f, 0xc7, 0xef, 0x6f, 0xc4, 0x3b, 0x69, 0xa1, 0xb3, 0x52, 0x94, 0x2b,
+	0x10, 0x7a, 0xf2, 0xe6, 0xe3, 0xf3, 0xe4, 0x2c, 0xb4, 0x30, 0x59, 0x08, 0x18, 0x25, 0xdd, 0x53,
+	0x6f, 0x31, 0x87, 0x2f, 0x0d, 0x90, 0x8b, 0x3f, 0x47, 0x70, 0x7e, 0x04, 0x7a, 0xe4, 0x73, 0x69,
+	0xfd, 0x73, 0x8e, 0x4c, 0xdd, 0x4b, 0x55, 0xf6, 0xf2, 0x3f, 0xfc, 0x77, 0x44, 0x2f, 0x42, 0x4b,
+	0x36, 0xd5, 0x83, 0x87, 0x1f, 0x49, 0x7f, 0x3c, 0x86, 0x3e, 0x48, 0x4b, 0x9b, 0xc3, 0xd2, 0x88,
+	0x3a, 0xc3, 0x13, 0x6c, 0x95, 0xbf, 0x9d, 0xa0, 0xeb, 0x97, 0x65, 0x7d, 0x0b, 0x9a, 0x23, 0xea,
+	0x4b, 0xf3, 0xd7, 0x96, 0xf6, 0x0e, 0x8a, 0xc6, 0xfe, 0x41, 0xd1, 0xf8, 0x70, 0x50, 0x34, 0x9e,
+	0x1d, 0x16, 0x13, 0xfb, 0x87, 0xc5, 0xc4, 0xdb, 0xc3, 0x62, 0x62, 0xe3, 0xbf, 0xa1, 0xeb, 0x1d,
+	0xa8, 0x49, 0xb0, 0xe0, 0x93, 0x66, 0x9b, 0x84, 0xf8, 0xe1, 0x80, 0x24, 0xef, 0xb9, 0x9e, 0x91,
+	0x63, 0x7d, 0xe1, 0xcb, 0x00, 0x56, 0x5d, 0x5d, 0xa1, 0x97, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +499,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Issuers(ctx context.Context, in *QueryIssuersRequest, opts ...grpc.CallOption) (*QueryIssuersResponse, error)
+	TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error)
+	Flows(ctx context.Context, in *QueryFlowsRequest, opts ...grpc.CallOption) (*QueryFlowsResponse, error)
 }
 
 type queryClient struct {
@@ -173,9 +520,29 @@ func (c *queryClient) Issuers(ctx context.Context, in *QueryIssuersRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error) {
+	out := new(QueryTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/em.issuer.v1.Query/TransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Flows(ctx context.Context, in *QueryFlowsRequest, opts ...grpc.CallOption) (*QueryFlowsResponse, error) {
+	out := new(QueryFlowsResponse)
+	err := c.cc.Invoke(ctx, "/em.issuer.v1.Query/Flows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Issuers(context.Context, *QueryIssuersRequest) (*QueryIssuersResponse, error)
+	TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error)
+	Flows(context.Context, *QueryFlowsRequest) (*QueryFlowsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +552,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Issuers(ctx context.Context, req *QueryIssuersRequest) (*QueryIssuersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Issuers not implemented")
 }
+func (*UnimplementedQueryServer) TransferPolicy(ctx context.Context, req *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicy not implemented")
+}
+func (*UnimplementedQueryServer) Flows(ctx context.Context, req *QueryFlowsRequest) (*QueryFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flows not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +581,42 @@ func _Query_Issuers_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.issuer.v1.Query/TransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicy(ctx, req.(*QueryTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Flows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Flows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.issuer.v1.Query/Flows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Flows(ctx, req.(*QueryFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.issuer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +625,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Issuers",
 			Handler:    _Query_Issuers_Handler,
 		},
+		{
+			MethodName: "TransferPolicy",
+			Handler:    _Query_TransferPolicy_Handler,
+		},
+		{
+			MethodName: "Flows",
+			Handler:    _Query_Flows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/issuer/v1/query.proto",
@@ -281,47 +698,427 @@ func (m *QueryIssuersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTransferPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIssuersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryTransferPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTransferPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Issuers) > 0 {
-		for _, e := range m.Issuers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outflows) > 0 {
+		for iNdEx := len(m.Outflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Net.Size()
+		i -= size
+		if _, err := m.Net.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIssuersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIssuersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for _, e := range m.Issuers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTransferPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Outflows) > 0 {
+		for _, e := range m.Outflows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlowSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Net.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryIssuersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -456,6 +1253,802 @@ func (m *QueryIssuersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTransferPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outflows = append(m.Outflows, ChannelOutflow{})
+			if err := m.Outflows[len(m.Outflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, FlowSummary{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Net.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
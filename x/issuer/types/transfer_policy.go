@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+func (p TransferPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, channelID := range p.AllowedChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return err
+		}
+
+		if allowed[channelID] {
+			return fmt.Errorf("duplicate allowed channel %v", channelID)
+		}
+		allowed[channelID] = true
+	}
+
+	limited := make(map[string]bool)
+	for _, limit := range p.RateLimits {
+		if err := host.ChannelIdentifierValidator(limit.ChannelId); err != nil {
+			return err
+		}
+
+		if limited[limit.ChannelId] {
+			return fmt.Errorf("duplicate rate limit for channel %v", limit.ChannelId)
+		}
+		limited[limit.ChannelId] = true
+
+		if len(allowed) > 0 && !allowed[limit.ChannelId] {
+			return fmt.Errorf("rate limit for channel %v which is not allowed", limit.ChannelId)
+		}
+
+		if limit.Amount.IsNil() || !limit.Amount.IsPositive() {
+			return fmt.Errorf("rate limit amount of channel %v must be positive", limit.ChannelId)
+		}
+
+		if limit.Window <= 0 {
+			return fmt.Errorf("rate limit window of channel %v must be positive", limit.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the policy places no restrictions on transfers.
+func (p TransferPolicy) IsEmpty() bool {
+	return len(p.AllowedChannels) == 0 && len(p.RateLimits) == 0 && !p.BlockInboundVouchers
+}
+
+func (p TransferPolicy) AllowsChannel(channelID string) bool {
+	if len(p.AllowedChannels) == 0 {
+		return true
+	}
+
+	for _, c := range p.AllowedChannels {
+		if c == channelID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p TransferPolicy) RateLimit(channelID string) (TransferRateLimit, bool) {
+	for _, limit := range p.RateLimits {
+		if limit.ChannelId == channelID {
+			return limit, true
+		}
+	}
+
+	return TransferRateLimit{}, false
+}
+
+// OutflowBuckets is the number of buckets that the outbound transfers within the window of a rate limit are summed in.
+// Transfers count towards the limit until their whole bucket has left the window.
+const OutflowBuckets = 60
+
+func outflowBucketDuration(window time.Duration) time.Duration {
+	return window / OutflowBuckets
+}
+
+// Total returns the amount sent through the channel within the window.
+func (o TransferOutflow) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, b := range o.Buckets {
+		total = total.Add(b.Amount)
+	}
+
+	return total
+}
+
+// Record adds an outbound transfer to the bucket of now.
+func (o *TransferOutflow) Record(now time.Time, window time.Duration, amount sdk.Int) {
+	start := now.Truncate(outflowBucketDuration(window))
+
+	// Buckets that were started with a longer window may extend past the start of the current one
+	if n := len(o.Buckets); n > 0 && !o.Buckets[n-1].Start.Before(start) {
+		o.Buckets[n-1].Amount = o.Buckets[n-1].Amount.Add(amount)
+		return
+	}
+
+	o.Buckets = append(o.Buckets, OutflowBucket{Start: start, Amount: amount})
+}
+
+// Prune drops the buckets that ended before the start of the window ending at now.
+func (o *TransferOutflow) Prune(now time.Time, window time.Duration) {
+	start := now.Add(-window).Truncate(outflowBucketDuration(window))
+
+	i := 0
+	for i < len(o.Buckets) && o.Buckets[i].Start.Before(start) {
+		i++
+	}
+
+	o.Buckets = o.Buckets[i:]
+}
+
+func (o TransferOutflow) Validate() error {
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(o.ChannelId); err != nil {
+		return err
+	}
+
+	for i, b := range o.Buckets {
+		if b.Amount.IsNil() || !b.Amount.IsPositive() {
+			return fmt.Errorf("outbound transfer amounts of %v on channel %v must be positive", o.Denom, o.ChannelId)
+		}
+
+		if i > 0 && !b.Start.After(o.Buckets[i-1].Start) {
+			return fmt.Errorf("outbound transfers of %v on channel %v must be sorted by time", o.Denom, o.ChannelId)
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/e-money/em-ledger/x/inflation/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -399,6 +400,192 @@ func (m *MsgSetInflationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetInflationResponse proto.InternalMessageInfo
 
+type MsgSetInflationDistribution struct {
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty" yaml:"issuer"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// An empty list restores the default destination of the minted tokens.
+	Recipients []types1.InflationRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients" yaml:"recipients"`
+}
+
+func (m *MsgSetInflationDistribution) Reset()         { *m = MsgSetInflationDistribution{} }
+func (m *MsgSetInflationDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInflationDistribution) ProtoMessage()    {}
+func (*MsgSetInflationDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053b6c8b132112fd, []int{8}
+}
+func (m *MsgSetInflationDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInflationDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInflationDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInflationDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInflationDistribution.Merge(m, src)
+}
+func (m *MsgSetInflationDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInflationDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInflationDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInflationDistribution proto.InternalMessageInfo
+
+func (m *MsgSetInflationDistribution) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgSetInflationDistribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetInflationDistribution) GetRecipients() []types1.InflationRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+type MsgSetInflationDistributionResponse struct {
+}
+
+func (m *MsgSetInflationDistributionResponse) Reset()         { *m = MsgSetInflationDistributionResponse{} }
+func (m *MsgSetInflationDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInflationDistributionResponse) ProtoMessage()    {}
+func (*MsgSetInflationDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053b6c8b132112fd, []int{9}
+}
+func (m *MsgSetInflationDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInflationDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInflationDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInflationDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInflationDistributionResponse.Merge(m, src)
+}
+func (m *MsgSetInflationDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInflationDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInflationDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInflationDistributionResponse proto.InternalMessageInfo
+
+type MsgSetTransferPolicy struct {
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty" yaml:"issuer"`
+	// An empty policy removes the policy of the denomination.
+	Policy TransferPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+}
+
+func (m *MsgSetTransferPolicy) Reset()         { *m = MsgSetTransferPolicy{} }
+func (m *MsgSetTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferPolicy) ProtoMessage()    {}
+func (*MsgSetTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053b6c8b132112fd, []int{10}
+}
+func (m *MsgSetTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferPolicy.Merge(m, src)
+}
+func (m *MsgSetTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferPolicy proto.InternalMessageInfo
+
+func (m *MsgSetTransferPolicy) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgSetTransferPolicy) GetPolicy() TransferPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return TransferPolicy{}
+}
+
+type MsgSetTransferPolicyResponse struct {
+}
+
+func (m *MsgSetTransferPolicyResponse) Reset()         { *m = MsgSetTransferPolicyResponse{} }
+func (m *MsgSetTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferPolicyResponse) ProtoMessage()    {}
+func (*MsgSetTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053b6c8b132112fd, []int{11}
+}
+func (m *MsgSetTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgIncreaseMintable)(nil), "em.issuer.v1.MsgIncreaseMintable")
 	proto.RegisterType((*MsgIncreaseMintableResponse)(nil), "em.issuer.v1.MsgIncreaseMintableResponse")
@@ -408,48 +595,62 @@ func init() {
 	proto.RegisterType((*MsgRevokeLiquidityProviderResponse)(nil), "em.issuer.v1.MsgRevokeLiquidityProviderResponse")
 	proto.RegisterType((*MsgSetInflation)(nil), "em.issuer.v1.MsgSetInflation")
 	proto.RegisterType((*MsgSetInflationResponse)(nil), "em.issuer.v1.MsgSetInflationResponse")
+	proto.RegisterType((*MsgSetInflationDistribution)(nil), "em.issuer.v1.MsgSetInflationDistribution")
+	proto.RegisterType((*MsgSetInflationDistributionResponse)(nil), "em.issuer.v1.MsgSetInflationDistributionResponse")
+	proto.RegisterType((*MsgSetTransferPolicy)(nil), "em.issuer.v1.MsgSetTransferPolicy")
+	proto.RegisterType((*MsgSetTransferPolicyResponse)(nil), "em.issuer.v1.MsgSetTransferPolicyResponse")
 }
 
 func init() { proto.RegisterFile("em/issuer/v1/tx.proto", fileDescriptor_053b6c8b132112fd) }
 
 var fileDescriptor_053b6c8b132112fd = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x1b, 0x11, 0x89, 0x25, 0x85, 0xd4, 0x50, 0x35, 0x31, 0x8a, 0x5d, 0x2c, 0xa8, 0x52,
-	0xa1, 0xec, 0x92, 0x72, 0xe3, 0x18, 0x8a, 0x50, 0xa5, 0x46, 0x42, 0x06, 0x2e, 0x5c, 0x8a, 0x93,
-	0x0c, 0x66, 0xd5, 0x78, 0x37, 0x78, 0x37, 0x51, 0xf3, 0x07, 0x1c, 0xb9, 0x80, 0xc4, 0x2f, 0xf0,
-	0x25, 0x3d, 0xf6, 0xc0, 0x01, 0x71, 0x30, 0x28, 0xf9, 0x83, 0x7c, 0x01, 0x8a, 0xd7, 0x36, 0x69,
-	0x9c, 0x40, 0x91, 0x90, 0x90, 0x38, 0x25, 0x9e, 0x79, 0xfb, 0x66, 0xde, 0xdb, 0xd9, 0x5d, 0xb4,
-	0x09, 0x3e, 0xa1, 0x42, 0x0c, 0x20, 0x20, 0xc3, 0x06, 0x91, 0x27, 0xb8, 0x1f, 0x70, 0xc9, 0xf5,
-	0x22, 0xf8, 0x58, 0x85, 0xf1, 0xb0, 0x61, 0xdc, 0xf0, 0xb8, 0xc7, 0xa3, 0x04, 0x99, 0xfd, 0x53,
-	0x18, 0xc3, 0xec, 0x70, 0xe1, 0x73, 0x41, 0xda, 0xae, 0x00, 0x32, 0x6c, 0xb4, 0x41, 0xba, 0x0d,
-	0xd2, 0xe1, 0x94, 0xa9, 0xbc, 0xfd, 0x71, 0x0d, 0x5d, 0x6f, 0x09, 0xef, 0x80, 0x75, 0x02, 0x70,
-	0x05, 0xb4, 0x28, 0x93, 0x6e, 0xbb, 0x07, 0xfa, 0x2e, 0x2a, 0x28, 0xea, 0xb2, 0xb6, 0xad, 0xd5,
-	0x2e, 0x37, 0x37, 0xa6, 0xa1, 0xb5, 0x3e, 0x72, 0xfd, 0xde, 0x03, 0x5b, 0xc5, 0x6d, 0x27, 0x06,
-	0xe8, 0x87, 0x48, 0xef, 0xd1, 0x37, 0x03, 0xda, 0xa5, 0x72, 0x74, 0xd4, 0x0f, 0xf8, 0x90, 0x76,
-	0x21, 0x28, 0xaf, 0x45, 0xcb, 0xaa, 0xd3, 0xd0, 0xaa, 0xa8, 0x65, 0x59, 0x8c, 0xed, 0x6c, 0xa4,
-	0xc1, 0x27, 0x71, 0x4c, 0x7f, 0xab, 0xa1, 0x82, 0xeb, 0xf3, 0x01, 0x93, 0xe5, 0xfc, 0x76, 0xbe,
-	0x76, 0x65, 0xaf, 0x82, 0x95, 0x04, 0x3c, 0x93, 0x80, 0x63, 0x09, 0xf8, 0x21, 0xa7, 0xac, 0xf9,
-	0xfc, 0x34, 0xb4, 0x72, 0xe3, 0xd0, 0x2a, 0x25, 0x6d, 0x27, 0x32, 0x7e, 0x36, 0xab, 0xa8, 0xec,
-	0x4f, 0xdf, 0xac, 0x9a, 0x47, 0xe5, 0xeb, 0x41, 0x1b, 0x77, 0xb8, 0x4f, 0x62, 0x53, 0xd4, 0x4f,
-	0x5d, 0x74, 0x8f, 0x89, 0x1c, 0xf5, 0x41, 0x44, 0xac, 0xc2, 0x89, 0xeb, 0xdb, 0x55, 0x74, 0x73,
-	0x89, 0x35, 0x0e, 0x88, 0x3e, 0x67, 0x02, 0x12, 0xeb, 0xf6, 0xe1, 0xbf, 0xb0, 0x2e, 0x91, 0xf1,
-	0x37, 0xad, 0x5b, 0xb4, 0x26, 0xb5, 0xee, 0xbd, 0x86, 0x8c, 0x96, 0xf0, 0x1c, 0x18, 0xf2, 0x63,
-	0x38, 0xcc, 0x08, 0xf9, 0x57, 0x0e, 0xda, 0xb7, 0x91, 0xbd, 0xba, 0xad, 0xb4, 0xfb, 0xcf, 0x1a,
-	0xba, 0xd6, 0x12, 0xde, 0x53, 0x90, 0x07, 0xec, 0x55, 0xcf, 0x95, 0x94, 0xb3, 0x3f, 0x69, 0x79,
-	0x07, 0x5d, 0xea, 0x02, 0xe3, 0x7e, 0xdc, 0x65, 0x69, 0x1a, 0x5a, 0x45, 0x85, 0x8c, 0xc2, 0xb6,
-	0xa3, 0xd2, 0x3a, 0x43, 0x57, 0x69, 0xc2, 0x7f, 0x14, 0xb8, 0x12, 0xca, 0xf9, 0x68, 0xc1, 0xe3,
-	0xd9, 0xd6, 0x7d, 0x0d, 0xad, 0x9d, 0x0b, 0xec, 0xca, 0x3e, 0x74, 0xa6, 0xa1, 0xb5, 0x19, 0x37,
-	0x72, 0x8e, 0xcd, 0x76, 0xd6, 0xd3, 0x80, 0x33, 0xfb, 0xae, 0xa0, 0xad, 0x05, 0x55, 0x89, 0xe2,
-	0xbd, 0x0f, 0x79, 0x94, 0x6f, 0x09, 0x4f, 0x7f, 0x89, 0x4a, 0x99, 0x9b, 0xe2, 0x16, 0x9e, 0xbf,
-	0x86, 0xf0, 0x92, 0x13, 0x63, 0xec, 0xfe, 0x16, 0x92, 0x54, 0x9a, 0x55, 0xc8, 0x1c, 0xa8, 0x6c,
-	0x85, 0x45, 0xc8, 0x92, 0x0a, 0xab, 0x66, 0x4f, 0x1f, 0xa0, 0xad, 0x55, 0x73, 0x57, 0xcb, 0xb0,
-	0xac, 0x40, 0x1a, 0xf7, 0x2e, 0x8a, 0x4c, 0xcb, 0x3e, 0x43, 0xc5, 0x73, 0x03, 0x53, 0xcd, 0x30,
-	0xcc, 0xa7, 0x8d, 0x3b, 0xbf, 0x4c, 0x27, 0xac, 0xcd, 0x47, 0xa7, 0x63, 0x53, 0x3b, 0x1b, 0x9b,
-	0xda, 0xf7, 0xb1, 0xa9, 0xbd, 0x9b, 0x98, 0xb9, 0xb3, 0x89, 0x99, 0xfb, 0x32, 0x31, 0x73, 0x2f,
-	0xee, 0xce, 0x4d, 0x07, 0xd4, 0x7d, 0xce, 0x60, 0x44, 0xc0, 0xaf, 0xf7, 0xa0, 0xeb, 0x41, 0x40,
-	0x4e, 0x92, 0xf7, 0x24, 0x1a, 0x93, 0x76, 0x21, 0x7a, 0x0c, 0xee, 0xff, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x34, 0xbb, 0x83, 0xd7, 0x69, 0x06, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x5f, 0x4f, 0xd3, 0x5c,
+	0x18, 0x5f, 0xd9, 0xfb, 0x2e, 0xf1, 0x00, 0xca, 0x2a, 0x84, 0xad, 0x40, 0x8b, 0x07, 0x21, 0x43,
+	0x43, 0xeb, 0xf0, 0xce, 0xcb, 0x39, 0x63, 0x88, 0x2c, 0x21, 0x15, 0x6f, 0xbc, 0x10, 0xbb, 0xee,
+	0xa1, 0x9e, 0xb0, 0xf6, 0xcc, 0x9e, 0x6e, 0x61, 0xdf, 0xc0, 0x2b, 0xe3, 0x8d, 0x17, 0x7e, 0x05,
+	0x3f, 0x09, 0x97, 0x5c, 0x78, 0x61, 0xbc, 0xa8, 0x66, 0x7c, 0x83, 0x25, 0xde, 0x9b, 0xb6, 0xa7,
+	0x65, 0x63, 0x2b, 0x83, 0x44, 0x63, 0xe2, 0x15, 0xec, 0x79, 0x7e, 0xe7, 0xf7, 0xe7, 0x69, 0xcf,
+	0x93, 0xa2, 0x05, 0xb0, 0x35, 0xc2, 0x58, 0x1b, 0x5c, 0xad, 0x53, 0xd6, 0xbc, 0x63, 0xb5, 0xe5,
+	0x52, 0x8f, 0x8a, 0x33, 0x60, 0xab, 0x51, 0x59, 0xed, 0x94, 0xa5, 0x79, 0x8b, 0x5a, 0x34, 0x6c,
+	0x68, 0xc1, 0x7f, 0x11, 0x46, 0x92, 0x4d, 0xca, 0x6c, 0xca, 0xb4, 0xba, 0xc1, 0x40, 0xeb, 0x94,
+	0xeb, 0xe0, 0x19, 0x65, 0xcd, 0xa4, 0xc4, 0xe1, 0x7d, 0x25, 0xa0, 0x76, 0x0e, 0x9b, 0x86, 0x47,
+	0xa8, 0x13, 0xb0, 0x27, 0x3f, 0x38, 0xa0, 0x38, 0xa4, 0xcd, 0xe5, 0xc2, 0x16, 0xfe, 0x34, 0x85,
+	0x6e, 0xd7, 0x98, 0xb5, 0xe3, 0x98, 0x2e, 0x18, 0x0c, 0x6a, 0xc4, 0xf1, 0x8c, 0x7a, 0x13, 0xc4,
+	0x4d, 0x94, 0x8b, 0x70, 0x05, 0x61, 0x55, 0x28, 0xdd, 0xa8, 0xe4, 0xfb, 0xbe, 0x32, 0xdb, 0x35,
+	0xec, 0xe6, 0x23, 0x1c, 0xd5, 0xb1, 0xce, 0x01, 0xe2, 0x2e, 0x12, 0x9b, 0xe4, 0x6d, 0x9b, 0x34,
+	0x88, 0xd7, 0x3d, 0x68, 0xb9, 0xb4, 0x43, 0x1a, 0xe0, 0x16, 0xa6, 0xc2, 0x63, 0x2b, 0x7d, 0x5f,
+	0x29, 0x46, 0xc7, 0x46, 0x31, 0x58, 0xcf, 0x27, 0xc5, 0x3d, 0x5e, 0x13, 0xdf, 0x09, 0x28, 0x67,
+	0xd8, 0xb4, 0xed, 0x78, 0x85, 0xec, 0x6a, 0xb6, 0x34, 0xbd, 0x5d, 0x54, 0xa3, 0xf8, 0x6a, 0x10,
+	0x5f, 0xe5, 0xf1, 0xd5, 0xc7, 0x94, 0x38, 0x95, 0x17, 0x27, 0xbe, 0x92, 0xe9, 0xf9, 0xca, 0x5c,
+	0x6c, 0x3b, 0x8e, 0x71, 0x6e, 0x36, 0xa2, 0xc2, 0x9f, 0xbf, 0x2b, 0x25, 0x8b, 0x78, 0x6f, 0xda,
+	0x75, 0xd5, 0xa4, 0xb6, 0xc6, 0x07, 0x1a, 0xfd, 0xd9, 0x62, 0x8d, 0x23, 0xcd, 0xeb, 0xb6, 0x80,
+	0x85, 0xac, 0x4c, 0xe7, 0xfa, 0x78, 0x05, 0x2d, 0x8d, 0x19, 0x8d, 0x0e, 0xac, 0x45, 0x1d, 0x06,
+	0xf1, 0xe8, 0xaa, 0xf0, 0x4f, 0x8c, 0x2e, 0x8e, 0xf1, 0x3b, 0x47, 0x57, 0x85, 0x94, 0xd1, 0x7d,
+	0x14, 0x90, 0x54, 0x63, 0x96, 0x0e, 0x1d, 0x7a, 0x04, 0xbb, 0x23, 0x41, 0xfe, 0xd6, 0x04, 0xf1,
+	0x5d, 0x84, 0xd3, 0x6d, 0x25, 0xee, 0xbf, 0x08, 0xe8, 0x56, 0x8d, 0x59, 0xcf, 0xc1, 0xdb, 0x89,
+	0x2f, 0xda, 0x75, 0x2c, 0x6f, 0xa0, 0xff, 0x1b, 0xe0, 0x50, 0x9b, 0xbb, 0x9c, 0xeb, 0xfb, 0xca,
+	0x4c, 0x84, 0x0c, 0xcb, 0x58, 0x8f, 0xda, 0xa2, 0x83, 0x6e, 0x26, 0x17, 0xf9, 0xc0, 0x35, 0x3c,
+	0x28, 0x64, 0xc3, 0x03, 0x4f, 0x83, 0x47, 0xf7, 0xcd, 0x57, 0x36, 0xae, 0xf0, 0x54, 0xaa, 0x60,
+	0xf6, 0x7d, 0x65, 0x81, 0x1b, 0x19, 0x62, 0xc3, 0xfa, 0x6c, 0x52, 0xd0, 0x83, 0xdf, 0x45, 0xb4,
+	0x78, 0x21, 0x55, 0x92, 0xf8, 0x54, 0x40, 0x4b, 0x17, 0x7a, 0x55, 0xc2, 0x3c, 0x97, 0xd4, 0xdb,
+	0x7f, 0x2a, 0xfd, 0x2b, 0x84, 0x5c, 0x30, 0x49, 0x8b, 0x80, 0xe3, 0x31, 0xfe, 0x3e, 0xaf, 0xa9,
+	0xc1, 0xb6, 0x4c, 0x96, 0x5b, 0xa7, 0xac, 0x0e, 0x58, 0xe5, 0xd8, 0x4a, 0x31, 0x18, 0x4f, 0xdf,
+	0x57, 0xf2, 0x11, 0xeb, 0x39, 0x09, 0xd6, 0x07, 0x18, 0xf1, 0x3a, 0x5a, 0xbb, 0x24, 0x51, 0x92,
+	0xfc, 0xbd, 0x80, 0xe6, 0x23, 0xdc, 0xbe, 0x6b, 0x38, 0xec, 0x10, 0xdc, 0x3d, 0xda, 0x24, 0x66,
+	0xf7, 0x3a, 0x91, 0x9f, 0xa1, 0x5c, 0x2b, 0x3c, 0x14, 0x66, 0x9e, 0xde, 0x5e, 0x56, 0x07, 0x97,
+	0xbe, 0x3a, 0x4c, 0x5c, 0x59, 0xe0, 0xfe, 0x39, 0x59, 0x74, 0x12, 0xeb, 0x9c, 0x02, 0xcb, 0x68,
+	0x79, 0x9c, 0x9f, 0xd8, 0xf0, 0xf6, 0xcf, 0xff, 0x50, 0xb6, 0xc6, 0x2c, 0xf1, 0x35, 0x9a, 0x1b,
+	0x59, 0xea, 0x77, 0x86, 0x85, 0xc7, 0x2c, 0x37, 0x69, 0x73, 0x22, 0x24, 0x56, 0x0a, 0x14, 0xaa,
+	0x30, 0x51, 0xa1, 0x0a, 0x13, 0x15, 0xd2, 0xd6, 0x84, 0xd8, 0x46, 0x8b, 0x69, 0x2b, 0xa2, 0x34,
+	0xc2, 0x92, 0x82, 0x94, 0x1e, 0x5c, 0x15, 0x99, 0xc8, 0xee, 0xa3, 0x99, 0xa1, 0xbb, 0xbd, 0x32,
+	0xc2, 0x30, 0xd8, 0x96, 0xd6, 0x2f, 0x6d, 0x27, 0xac, 0xc7, 0xa8, 0x90, 0x7e, 0x7f, 0x2e, 0xa5,
+	0x18, 0x84, 0x4a, 0xe5, 0x2b, 0x43, 0x13, 0x65, 0x13, 0xe5, 0x47, 0xdf, 0x5f, 0x3c, 0x8e, 0x67,
+	0x18, 0x23, 0xdd, 0x9b, 0x8c, 0x89, 0x45, 0x2a, 0x4f, 0x4e, 0x7a, 0xb2, 0x70, 0xda, 0x93, 0x85,
+	0x1f, 0x3d, 0x59, 0xf8, 0x70, 0x26, 0x67, 0x4e, 0xcf, 0xe4, 0xcc, 0xd7, 0x33, 0x39, 0xf3, 0xf2,
+	0xfe, 0xc0, 0x9e, 0x82, 0x2d, 0x9b, 0x3a, 0xd0, 0xd5, 0xc0, 0xde, 0x6a, 0x42, 0xc3, 0x02, 0x57,
+	0x3b, 0x8e, 0xbf, 0x4c, 0xc2, 0x85, 0x55, 0xcf, 0x85, 0x9f, 0x25, 0x0f, 0x7f, 0x0d, 0x00, 0x25,
+	0xb9, 0x39, 0xb1, 0x2f, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -468,6 +669,8 @@ type MsgClient interface {
 	DecreaseMintable(ctx context.Context, in *MsgDecreaseMintable, opts ...grpc.CallOption) (*MsgDecreaseMintableResponse, error)
 	RevokeLiquidityProvider(ctx context.Context, in *MsgRevokeLiquidityProvider, opts ...grpc.CallOption) (*MsgRevokeLiquidityProviderResponse, error)
 	SetInflation(ctx context.Context, in *MsgSetInflation, opts ...grpc.CallOption) (*MsgSetInflationResponse, error)
+	SetInflationDistribution(ctx context.Context, in *MsgSetInflationDistribution, opts ...grpc.CallOption) (*MsgSetInflationDistributionResponse, error)
+	SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -514,12 +717,32 @@ func (c *msgClient) SetInflation(ctx context.Context, in *MsgSetInflation, opts
 	return out, nil
 }
 
+func (c *msgClient) SetInflationDistribution(ctx context.Context, in *MsgSetInflationDistribution, opts ...grpc.CallOption) (*MsgSetInflationDistributionResponse, error) {
+	out := new(MsgSetInflationDistributionResponse)
+	err := c.cc.Invoke(ctx, "/em.issuer.v1.Msg/SetInflationDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error) {
+	out := new(MsgSetTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/em.issuer.v1.Msg/SetTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	IncreaseMintable(context.Context, *MsgIncreaseMintable) (*MsgIncreaseMintableResponse, error)
 	DecreaseMintable(context.Context, *MsgDecreaseMintable) (*MsgDecreaseMintableResponse, error)
 	RevokeLiquidityProvider(context.Context, *MsgRevokeLiquidityProvider) (*MsgRevokeLiquidityProviderResponse, error)
 	SetInflation(context.Context, *MsgSetInflation) (*MsgSetInflationResponse, error)
+	SetInflationDistribution(context.Context, *MsgSetInflationDistribution) (*MsgSetInflationDistributionResponse, error)
+	SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -538,6 +761,12 @@ func (*UnimplementedMsgServer) RevokeLiquidityProvider(ctx context.Context, req
 func (*UnimplementedMsgServer) SetInflation(ctx context.Context, req *MsgSetInflation) (*MsgSetInflationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetInflation not implemented")
 }
+func (*UnimplementedMsgServer) SetInflationDistribution(ctx context.Context, req *MsgSetInflationDistribution) (*MsgSetInflationDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInflationDistribution not implemented")
+}
+func (*UnimplementedMsgServer) SetTransferPolicy(ctx context.Context, req *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -615,6 +844,42 @@ func _Msg_SetInflation_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetInflationDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInflationDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInflationDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.issuer.v1.Msg/SetInflationDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInflationDistribution(ctx, req.(*MsgSetInflationDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.issuer.v1.Msg/SetTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferPolicy(ctx, req.(*MsgSetTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.issuer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -635,6 +900,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetInflation",
 			Handler:    _Msg_SetInflation_Handler,
 		},
+		{
+			MethodName: "SetInflationDistribution",
+			Handler:    _Msg_SetInflationDistribution_Handler,
+		},
+		{
+			MethodName: "SetTransferPolicy",
+			Handler:    _Msg_SetTransferPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/issuer/v1/tx.proto",
@@ -918,59 +1191,196 @@ func (m *MsgSetInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetInflationDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgIncreaseMintable) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetInflationDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.LiquidityProvider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MintableIncrease) > 0 {
-		for _, e := range m.MintableIncrease {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgIncreaseMintableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetInflationDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInflationDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInflationDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDecreaseMintable) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgIncreaseMintable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LiquidityProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MintableIncrease) > 0 {
+		for _, e := range m.MintableIncrease {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgIncreaseMintableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecreaseMintable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.LiquidityProvider)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1007,53 +1417,505 @@ func (m *MsgRevokeLiquidityProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRevokeLiquidityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InflationRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetInflationDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgIncreaseMintable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseMintable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseMintable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintableIncrease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintableIncrease = append(m.MintableIncrease, types.Coin{})
+			if err := m.MintableIncrease[len(m.MintableIncrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseMintableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseMintableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseMintableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseMintable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseMintable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintableDecrease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintableDecrease = append(m.MintableDecrease, types.Coin{})
+			if err := m.MintableDecrease[len(m.MintableDecrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRevokeLiquidityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetInflation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDecreaseMintableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseMintableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseMintableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.InflationRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSetInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgIncreaseMintable) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeLiquidityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1076,10 +1938,10 @@ func (m *MsgIncreaseMintable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIncreaseMintable: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeLiquidityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIncreaseMintable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeLiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1146,40 +2008,6 @@ func (m *MsgIncreaseMintable) Unmarshal(dAtA []byte) error {
 			}
 			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintableIncrease", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintableIncrease = append(m.MintableIncrease, types.Coin{})
-			if err := m.MintableIncrease[len(m.MintableIncrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1201,7 +2029,7 @@ func (m *MsgIncreaseMintable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIncreaseMintableResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,10 +2052,10 @@ func (m *MsgIncreaseMintableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIncreaseMintableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeLiquidityProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIncreaseMintableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1251,7 +2079,7 @@ func (m *MsgIncreaseMintableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInflation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,10 +2102,10 @@ func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecreaseMintable: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInflation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecreaseMintable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInflation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1314,7 +2142,7 @@ func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1342,13 +2170,13 @@ func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintableDecrease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1358,23 +2186,23 @@ func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintableDecrease = append(m.MintableDecrease, types.Coin{})
-			if err := m.MintableDecrease[len(m.MintableDecrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1399,7 +2227,7 @@ func (m *MsgDecreaseMintable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecreaseMintableResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInflationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1422,10 +2250,10 @@ func (m *MsgDecreaseMintableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecreaseMintableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInflationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecreaseMintableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1449,7 +2277,7 @@ func (m *MsgDecreaseMintableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeLiquidityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInflationDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1472,10 +2300,10 @@ func (m *MsgRevokeLiquidityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeLiquidityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInflationDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeLiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1512,7 +2340,7 @@ func (m *MsgRevokeLiquidityProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1540,7 +2368,41 @@ func (m *MsgRevokeLiquidityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProvider = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, types1.InflationRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1563,7 +2425,7 @@ func (m *MsgRevokeLiquidityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInflationDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1586,10 +2448,10 @@ func (m *MsgRevokeLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeLiquidityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInflationDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInflationDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1613,7 +2475,7 @@ func (m *MsgRevokeLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetInflation) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1636,10 +2498,10 @@ func (m *MsgSetInflation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetInflation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1676,41 +2538,9 @@ func (m *MsgSetInflation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1720,23 +2550,22 @@ func (m *MsgSetInflation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1761,7 +2590,7 @@ func (m *MsgSetInflation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1784,10 +2613,10 @@ func (m *MsgSetInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,8 @@
 package liquidityprovider
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/e-money/em-ledger/x/liquidityprovider/types"
@@ -14,6 +16,27 @@ func defaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{}
 }
 
+// ValidateGenesis checks that every liquidity provider has a valid address, listed once, and valid mintable amounts.
+func ValidateGenesis(gs types.GenesisState) error {
+	seen := make(map[string]bool)
+	for _, lp := range gs.Accounts {
+		if _, err := sdk.AccAddressFromBech32(lp.Address); err != nil {
+			return sdkerrors.Wrapf(err, "address: %s", lp.Address)
+		}
+
+		if seen[lp.Address] {
+			return fmt.Errorf("duplicate liquidity provider %v", lp.Address)
+		}
+		seen[lp.Address] = true
+
+		if err := lp.Mintable.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "mintable amount of %v", lp.Address)
+		}
+	}
+
+	return nil
+}
+
 func InitGenesis(ctx sdk.Context, keeper Keeper, gs types.GenesisState) error {
 	for _, lp := range gs.Accounts {
 		// Assuming a bech32 address
@@ -15,6 +15,7 @@ const (
 	RouterKey        = types.RouterKey
 	StoreKey         = types.StoreKey
 	StoreKeyIdx      = types.StoreKeyIdx
+	TStoreKey        = types.TStoreKey
 	QuerierRoute     = types.QuerierRoute
 	QueryByAccount   = types.QueryByAccount
 	QueryInstrument  = types.QueryInstrument
@@ -23,6 +24,8 @@ const (
 	TimeInForce_GoodTillCancel    = types.TimeInForce_GoodTillCancel
 	TimeInForce_ImmediateOrCancel = types.TimeInForce_ImmediateOrCancel
 	TimeInForce_FillOrKill        = types.TimeInForce_FillOrKill
+
+	FlagOrderHistoryRetention = keeper.FlagOrderHistoryRetention
 )
 
 var (
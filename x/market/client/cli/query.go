@@ -5,6 +5,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetInstrumentsCmd(),
 		GetInstrumentCmd(),
 		GetByAccountCmd(),
+		GetQuoteCmd(),
+		GetOrderCmd(),
+		GetOrderHistoryCmd(),
 	)
 
 	return cmd
@@ -110,3 +116,122 @@ func GetInstrumentsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetQuoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [source-denom] [destination-amount] [market-slippage]",
+		Short: "Simulate a market order against the current order book",
+		Long: `Simulate a market order against the current order book without submitting it. Reports the
+expected fills, the average price, the slippage relative to the last traded price and whether
+a fill-or-kill order would succeed.
+
+Example:
+ emd query market quote eeur 300echf 0.05
+`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQuoteRequest{
+				Source:      args[0],
+				Destination: args[1],
+			}
+			if len(args) == 3 {
+				req.MaximumSlippage = args[2]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Quote(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [order-id]",
+		Short: "Query an order by its order id",
+		Long: `Query an order by its order id. Orders that are no longer in the order book are only found if
+the queried node retains them in its order history.
+
+Example:
+ emd query market order 42
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id %q: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Order(cmd.Context(), &types.QueryOrderByIDRequest{OrderId: orderId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetOrderHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [key_or_address]",
+		Short: "Query the filled, cancelled and expired orders of an account",
+		Long: `Query the orders of an account that are no longer in the order book, most recently closed first.
+The order history is retained by each node for a configurable number of orders per account.
+
+Example:
+ emd query market history emoney1...
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderHistory(cmd.Context(), &types.QueryOrderHistoryRequest{
+				Address:    addr.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
@@ -5,6 +5,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -14,9 +16,17 @@ import (
 )
 
 const (
-	flag_TimeInForce = "time-in-force"
-
-	flag_TimeInForceDescription = "Select the order's time-in-force value (GTC|IOC|FOK)"
+	flag_TimeInForce         = "time-in-force"
+	flag_SelfTradePrevention = "self-trade-prevention"
+	flag_MinOrderSize        = "min-order-size"
+	flag_TickSize            = "tick-size"
+	flag_LotSize             = "lot-size"
+	flag_PriceBand           = "price-band"
+	flag_CoolingPeriod       = "cooling-period"
+	flag_BatchInterval       = "batch-interval"
+
+	flag_TimeInForceDescription         = "Select the order's time-in-force value (GTC|IOC|FOK)"
+	flag_SelfTradePreventionDescription = "Select what happens if the order would trade with an order of the same account (none|cancel-newest|cancel-oldest|cancel-both|decrement). Uses the account default if not set"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -34,6 +44,10 @@ func GetTxCmd() *cobra.Command {
 		AddMarketOrderCmd(),
 		CancelOrderCmd(),
 		CancelReplaceOrder(),
+		AddStopLimitOrderCmd(),
+		AddStopMarketOrderCmd(),
+		SetSelfTradePreventionCmd(),
+		SetInstrumentCmd(),
 	)
 	return txCmd
 }
@@ -70,12 +84,22 @@ func AddLimitOrderCmd() *cobra.Command {
 				return err
 			}
 
+			stp, err := cmd.Flags().GetString(flag_SelfTradePrevention)
+			if err != nil {
+				return err
+			}
+			selfTradePrevention, err := types.SelfTradePreventionFromString(stp)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddLimitOrder{
-				Owner:         clientCtx.GetFromAddress().String(),
-				TimeInForce:   timeInForce,
-				Source:        src,
-				Destination:   dst,
-				ClientOrderId: clientOrderID,
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              src,
+				Destination:         dst,
+				ClientOrderId:       clientOrderID,
+				SelfTradePrevention: selfTradePrevention,
 			}
 
 			err = msg.ValidateBasic()
@@ -88,6 +112,7 @@ func AddLimitOrderCmd() *cobra.Command {
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	cmd.Flags().String(flag_SelfTradePrevention, "", flag_SelfTradePreventionDescription)
 	return cmd
 }
 
@@ -130,13 +155,23 @@ Example:
 				return err
 			}
 
+			stp, err := cmd.Flags().GetString(flag_SelfTradePrevention)
+			if err != nil {
+				return err
+			}
+			selfTradePrevention, err := types.SelfTradePreventionFromString(stp)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddMarketOrder{
-				Owner:         clientCtx.GetFromAddress().String(),
-				TimeInForce:   timeInForce,
-				Source:        srcDenom,
-				Destination:   dst,
-				ClientOrderId: clientOrderID,
-				MaxSlippage:   slippage,
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              srcDenom,
+				Destination:         dst,
+				ClientOrderId:       clientOrderID,
+				MaxSlippage:         slippage,
+				SelfTradePrevention: selfTradePrevention,
 			}
 
 			err = msg.ValidateBasic()
@@ -149,6 +184,7 @@ Example:
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	cmd.Flags().String(flag_SelfTradePrevention, "", flag_SelfTradePreventionDescription)
 	return cmd
 }
 
@@ -239,3 +275,307 @@ func CancelReplaceOrder() *cobra.Command {
 
 	return cmd
 }
+
+func AddStopLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-stop-limit [source-amount] [destination-amount] [trigger-price] [client-orderid]",
+		Short: "Create a limit order that is sent to the market once the last price falls to the trigger price",
+		Long: `Create a limit order that is sent to the market once the last traded price of the instrument,
+expressed in destination per source, falls to or below the trigger price.
+
+Example:
+ emd tx market add-stop-limit 1000eeur 1150echf 1.16 order12345
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			src, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return
+			}
+
+			dst, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientOrderID := args[3]
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
+
+			stp, err := cmd.Flags().GetString(flag_SelfTradePrevention)
+			if err != nil {
+				return err
+			}
+			selfTradePrevention, err := types.SelfTradePreventionFromString(stp)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddStopLimitOrder{
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              src,
+				Destination:         dst,
+				TriggerPrice:        triggerPrice,
+				ClientOrderId:       clientOrderID,
+				SelfTradePrevention: selfTradePrevention,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	cmd.Flags().String(flag_SelfTradePrevention, "", flag_SelfTradePreventionDescription)
+	return cmd
+}
+
+func AddStopMarketOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-stop-market [source-denom] [destination-amount] [market-slippage] [trigger-price] [client-orderid]",
+		Short: "Create a market order that is sent to the market once the last price falls to the trigger price",
+		Long: `Create a market order that is sent to the market once the last traded price of the instrument,
+expressed in destination per source, falls to or below the trigger price. The source amount is
+based on the last traded price when the order is triggered.
+
+Example:
+ emd tx market add-stop-market eeur 300echf 0.05 1.16 order12345
+`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcDenom := args[0]
+
+			dst, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientOrderID := args[4]
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
+
+			stp, err := cmd.Flags().GetString(flag_SelfTradePrevention)
+			if err != nil {
+				return err
+			}
+			selfTradePrevention, err := types.SelfTradePreventionFromString(stp)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddStopMarketOrder{
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              srcDenom,
+				Destination:         dst,
+				MaxSlippage:         slippage,
+				TriggerPrice:        triggerPrice,
+				ClientOrderId:       clientOrderID,
+				SelfTradePrevention: selfTradePrevention,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	cmd.Flags().String(flag_SelfTradePrevention, "", flag_SelfTradePreventionDescription)
+	return cmd
+}
+
+func SetSelfTradePreventionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-self-trade-prevention [none|cancel-newest|cancel-oldest|cancel-both|decrement]",
+		Short: "Set the self-trade prevention of the account's orders that do not specify one",
+		Long: `Set what happens when an order would trade with a resting order of the same account:
+
+none          : Orders trade with each other
+cancel-newest : The incoming order is cancelled
+cancel-oldest : The resting order is cancelled
+cancel-both   : Both orders are cancelled
+decrement     : The quantity of the smaller order is removed from both orders, cancelling the smaller order`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention, err := types.SelfTradePreventionFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetSelfTradePrevention{
+				Owner:               clientCtx.GetFromAddress().String(),
+				SelfTradePrevention: selfTradePrevention,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func SetInstrumentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-instrument [authority_key_or_address] [source-denom] [destination-denom] [active|halted|delisted]",
+		Example: "emd tx market set-instrument masterkey eeur echf active --min-order-size 100 --tick-size 0.0001 --lot-size 10 --price-band 0.1 --cooling-period 5m --batch-interval 5",
+		Short:   "List an instrument or update its listing",
+		Long: `List the instrument of orders selling the source denomination for the destination denomination, or update its listing.
+
+The minimum order size and the lot size apply to the destination amount of an order. The tick size applies to the order
+price, i.e. destination / source. Sizes of zero are not enforced. Delisting an instrument cancels its orders.
+
+A positive price band enables the circuit breaker, which halts the instrument for the cooling period when a trade would
+deviate more than the price band from the rolling average of traded prices.
+
+A positive batch interval clears the orders of the instrument in a batch auction every interval blocks, at a single
+price, instead of matching them continuously.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := types.InstrumentStatusFromString(args[3])
+			if err != nil {
+				return err
+			}
+
+			minOrderSize, err := getIntFlag(cmd, flag_MinOrderSize)
+			if err != nil {
+				return err
+			}
+
+			lotSize, err := getIntFlag(cmd, flag_LotSize)
+			if err != nil {
+				return err
+			}
+
+			tick, err := cmd.Flags().GetString(flag_TickSize)
+			if err != nil {
+				return err
+			}
+			tickSize, err := sdk.NewDecFromStr(tick)
+			if err != nil {
+				return err
+			}
+
+			band, err := cmd.Flags().GetString(flag_PriceBand)
+			if err != nil {
+				return err
+			}
+			priceBand, err := sdk.NewDecFromStr(band)
+			if err != nil {
+				return err
+			}
+
+			coolingPeriod, err := cmd.Flags().GetDuration(flag_CoolingPeriod)
+			if err != nil {
+				return err
+			}
+
+			batchInterval, err := cmd.Flags().GetUint32(flag_BatchInterval)
+			if err != nil {
+				return err
+			}
+
+			instrument := types.NewInstrument(args[1], args[2], status, minOrderSize, tickSize, lotSize).
+				WithCircuitBreaker(priceBand, coolingPeriod).
+				WithBatchAuction(batchInterval)
+
+			msg := &types.MsgSetInstrument{
+				Authority:  clientCtx.GetFromAddress().String(),
+				Instrument: instrument,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_MinOrderSize, "0", "Minimum destination amount of an order")
+	cmd.Flags().String(flag_TickSize, "0", "Order prices must be a multiple of the tick size")
+	cmd.Flags().String(flag_LotSize, "0", "Destination amounts must be a multiple of the lot size")
+	cmd.Flags().String(flag_PriceBand, "0", "Halt the instrument when a trade deviates more than this fraction from the reference price")
+	cmd.Flags().Duration(flag_CoolingPeriod, 0, "Duration of a halt by the circuit breaker")
+	cmd.Flags().Uint32(flag_BatchInterval, 0, "Number of blocks between batch auctions of the instrument. Zero matches orders continuously")
+	return cmd
+}
+
+func getIntFlag(cmd *cobra.Command, flag string) (sdk.Int, error) {
+	v, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	i, ok := sdk.NewIntFromString(v)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid %v: %v", flag, v)
+	}
+
+	return i, nil
+}
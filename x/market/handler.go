@@ -35,6 +35,22 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 			res, err := msgServer.CancelReplaceLimitOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgAddStopLimitOrder:
+			res, err := msgServer.AddStopLimitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddStopMarketOrder:
+			res, err := msgServer.AddStopMarketOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetSelfTradePrevention:
+			res, err := msgServer.SetSelfTradePrevention(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetInstrument:
+			res, err := msgServer.SetInstrument(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized market message type: %T", msg)
 		}
@@ -0,0 +1,361 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Orders of instruments listed with a batch interval are not matched when they are placed. They rest in the book until
+// the end of the interval, where a batch auction clears both directions of the instrument at a single price.
+
+// batchInterval returns the number of blocks between the batch auctions of the instrument, or zero if its orders are
+// matched continuously. Listing either direction for batch auctions applies to both.
+func (k Keeper) batchInterval(ctx sdk.Context, src, dst string) uint32 {
+	var interval uint32
+	for _, listing := range []*types.Instrument{k.GetListing(ctx, src, dst), k.GetListing(ctx, dst, src)} {
+		if listing != nil && listing.BatchInterval > interval {
+			interval = listing.BatchInterval
+		}
+	}
+
+	return interval
+}
+
+// RunBatchAuctions holds the auctions of the instruments whose batch interval ends in the current block.
+func (k *Keeper) RunBatchAuctions(ctx sdk.Context) {
+	auctioned := make(map[string]bool)
+
+	for _, listing := range k.GetListings(ctx) {
+		src, dst := listing.Source, listing.Destination
+		if src > dst {
+			src, dst = dst, src
+		}
+
+		instrument := src + "/" + dst
+		if auctioned[instrument] {
+			continue
+		}
+		auctioned[instrument] = true
+
+		interval := k.batchInterval(ctx, src, dst)
+		if interval == 0 || ctx.BlockHeight()%int64(interval) != 0 {
+			continue
+		}
+
+		k.runBatchAuction(ctx, src, dst)
+	}
+}
+
+// runBatchAuction clears the orders of the instrument unless it is halted or delisted. Immediate-or-cancel orders
+// expire afterwards, whether or not they were filled.
+func (k *Keeper) runBatchAuction(ctx sdk.Context, src, dst string) {
+	if k.isTradable(ctx, src, dst) && k.isTradable(ctx, dst, src) {
+		k.clearBatch(ctx, src, dst)
+	}
+
+	k.expireImmediateOrCancelOrders(ctx, src, dst)
+	k.expireImmediateOrCancelOrders(ctx, dst, src)
+}
+
+// batchFill accumulates the fills of an order during an auction.
+type batchFill struct {
+	order             *types.Order
+	sourceFilled      sdk.Int
+	destinationFilled sdk.Int
+}
+
+// clearBatch matches the asks (src -> dst) and bids (dst -> src) of the instrument at the uniform clearing price. Both
+// sides are filled in price-time priority, so the orders at the limit of the clearing price may be partially filled.
+//
+// Self-trade prevention deliberately does not apply. Auction orders have no aggressive and resting side for the modes to
+// refer to, and an account's crossing orders cannot move the price of the auction, as every order trades at the single
+// clearing price determined by all of the orders.
+func (k *Keeper) clearBatch(ctx sdk.Context, src, dst string) {
+	asks, bids := k.GetInstrumentOrders(ctx, src, dst), k.GetInstrumentOrders(ctx, dst, src)
+
+	var reference *sdk.Dec
+	if md := k.GetInstrument(ctx, src, dst); md != nil {
+		reference = md.LastPrice
+	}
+
+	price, found := clearingPrice(asks, bids, reference)
+	if !found {
+		return
+	}
+
+	// Auctions outside the price band of a listed instrument halt it instead of executing
+	if k.checkPriceBand(ctx, src, dst, price) {
+		return
+	}
+
+	var (
+		fills             []*batchFill
+		fillsByID         = make(map[uint64]*batchFill)
+		sourceTraded      = sdk.ZeroInt()
+		destinationTraded = sdk.ZeroInt()
+		askIdx, bidIdx    = 0, 0
+	)
+
+	fillOf := func(order *types.Order) *batchFill {
+		fill, found := fillsByID[order.ID]
+		if !found {
+			fill = &batchFill{sourceFilled: sdk.ZeroInt(), destinationFilled: sdk.ZeroInt()}
+			fillsByID[order.ID] = fill
+			fills = append(fills, fill)
+		}
+		fill.order = order
+		return fill
+	}
+
+	for askIdx < len(asks) && bidIdx < len(bids) {
+		if asks[askIdx].Price().GT(price) || sdk.OneDec().Quo(bids[bidIdx].Price()).LT(price) {
+			break
+		}
+
+		// Orders are reloaded, as settling previous fills may have reduced or expired them
+		ask, bid := k.GetOrderByID(ctx, asks[askIdx].ID), k.GetOrderByID(ctx, bids[bidIdx].ID)
+		if ask == nil {
+			askIdx++
+			continue
+		}
+		if bid == nil {
+			bidIdx++
+			continue
+		}
+
+		// Capacities in units of the source denomination
+		askDestinationRemaining := ask.Destination.Amount.Sub(ask.DestinationFilled)
+		askCapacity := sdk.MinInt(ask.SourceRemaining, askDestinationRemaining.ToDec().Quo(price).RoundInt())
+		bidCapacity := sdk.MinInt(bid.Destination.Amount.Sub(bid.DestinationFilled), bid.SourceRemaining.ToDec().Quo(price).RoundInt())
+
+		sourceFilled := sdk.MinInt(askCapacity, bidCapacity)
+		destinationFilled := sdk.MinInt(sourceFilled.ToDec().Mul(price).RoundInt(), sdk.MinInt(askDestinationRemaining, bid.SourceRemaining))
+
+		if sourceFilled.IsPositive() && destinationFilled.IsPositive() {
+			ask.SourceRemaining = ask.SourceRemaining.Sub(sourceFilled)
+			ask.SourceFilled = ask.SourceFilled.Add(sourceFilled)
+			ask.DestinationFilled = ask.DestinationFilled.Add(destinationFilled)
+
+			bid.SourceRemaining = bid.SourceRemaining.Sub(destinationFilled)
+			bid.SourceFilled = bid.SourceFilled.Add(destinationFilled)
+			bid.DestinationFilled = bid.DestinationFilled.Add(sourceFilled)
+
+			askFill, bidFill := fillOf(ask), fillOf(bid)
+			askFill.sourceFilled = askFill.sourceFilled.Add(sourceFilled)
+			askFill.destinationFilled = askFill.destinationFilled.Add(destinationFilled)
+			bidFill.sourceFilled = bidFill.sourceFilled.Add(destinationFilled)
+			bidFill.destinationFilled = bidFill.destinationFilled.Add(sourceFilled)
+
+			// Store the orders before settling, so that balance changes are applied to their current state. Filled orders
+			// leave the book and are closed once their fills have been reported.
+			for _, o := range []*types.Order{ask, bid} {
+				if o.IsFilled() {
+					k.deleteOrder(ctx, o)
+				} else {
+					k.setOrder(ctx, o)
+				}
+			}
+
+			sourceCoin := sdk.NewCoin(src, sourceFilled)
+			destinationCoin := sdk.NewCoin(dst, destinationFilled)
+			if err := k.transferTradedAmounts(ctx, destinationCoin, sourceCoin, ask.Owner, bid.Owner); err != nil {
+				panic(err)
+			}
+
+			sourceTraded = sourceTraded.Add(sourceFilled)
+			destinationTraded = destinationTraded.Add(destinationFilled)
+		}
+
+		// Move on from the orders that cannot be filled further at the clearing price
+		if askCapacity.Equal(sourceFilled) {
+			askIdx++
+		}
+		if bidCapacity.Equal(sourceFilled) {
+			bidIdx++
+		}
+	}
+
+	if !sourceTraded.IsPositive() {
+		return
+	}
+
+	for _, fill := range fills {
+		k.emitFillEvent(ctx, *fill.order, false, fill.sourceFilled, fill.destinationFilled)
+
+		if fill.order.IsFilled() {
+			k.emitCloseEvent(ctx, *fill.order, types.OrderState_Filled)
+			k.recordTerminalOrder(ctx, *fill.order, types.OrderState_Filled)
+			k.refundDeposit(ctx, fill.order)
+		}
+	}
+
+	// Register the auction in market data
+	k.setMarketData(ctx, src, dst, price)
+	k.setMarketData(ctx, dst, src, sdk.OneDec().Quo(price))
+
+	types.EmitBatchAuctionEvent(ctx, price, sdk.NewCoin(src, sourceTraded), sdk.NewCoin(dst, destinationTraded))
+}
+
+// clearingPrice returns the price (dst per src of the asks) that maximizes the volume traded in the auction. Ties are
+// broken by the smallest imbalance between the supply and demand at the price, then by the distance to the reference
+// price and finally by the lowest price.
+//
+// The supply and demand of each candidate price are computed from cumulative sums over the order prices, so that the
+// auction takes O(n log n) time in the number of orders. An ask accepting the candidate price c supplies its source
+// remaining S, or D/c if its destination remaining D is reached first, i.e. if c > D/S. Likewise a bid demands its
+// destination remaining D, or S/c of its source remaining S if c > S/D.
+func clearingPrice(asks, bids []*types.Order, reference *sdk.Dec) (price sdk.Dec, found bool) {
+	candidates := make([]sdk.Dec, 0, len(asks)+len(bids))
+
+	var askPrices, askLimits priceSums
+	for _, ask := range asks {
+		askPrice := ask.Price()
+		candidates = append(candidates, askPrice)
+
+		sourceRemaining := ask.SourceRemaining.ToDec()
+		destinationRemaining := ask.Destination.Amount.Sub(ask.DestinationFilled).ToDec()
+		if !sourceRemaining.IsPositive() {
+			continue
+		}
+
+		// Asks supply from their price upwards, limited by their destination above the limit price
+		limit := sdk.MaxDec(askPrice, destinationRemaining.Quo(sourceRemaining))
+		askPrices.add(askPrice, sourceRemaining, sdk.ZeroDec())
+		askLimits.add(limit, sourceRemaining, destinationRemaining)
+	}
+
+	var bidPrices, bidLimits, bidEnds priceSums
+	for _, bid := range bids {
+		bidPrice := sdk.OneDec().Quo(bid.Price())
+		candidates = append(candidates, bidPrice)
+
+		sourceRemaining := bid.SourceRemaining.ToDec()
+		destinationRemaining := bid.Destination.Amount.Sub(bid.DestinationFilled).ToDec()
+		if !destinationRemaining.IsPositive() {
+			continue
+		}
+
+		// Bids demand up to their price, limited by their source above the limit price
+		limit := sourceRemaining.Quo(destinationRemaining)
+		bidPrices.add(bidPrice, destinationRemaining, sdk.ZeroDec())
+		bidLimits.add(limit, destinationRemaining, sourceRemaining)
+		bidEnds.add(sdk.MaxDec(limit, bidPrice), destinationRemaining, sourceRemaining)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].LT(candidates[j])
+	})
+
+	for _, sums := range []*priceSums{&askPrices, &askLimits, &bidPrices, &bidLimits, &bidEnds} {
+		sums.accumulate()
+	}
+
+	var bestVolume, bestImbalance sdk.Dec
+	for _, candidate := range candidates {
+		// Asks at or below the candidate price supply their source, or their destination past their limit
+		askSource, _ := askPrices.upTo(candidate, true)
+		limitedSource, limitedDestination := askLimits.upTo(candidate, true)
+		supply := askSource.Sub(limitedSource).Add(limitedDestination.Quo(candidate))
+
+		// Bids at or above the candidate price demand their destination, or their source past their limit
+		expiredDestination, _ := bidPrices.upTo(candidate, false)
+		limitedDestination, limitedSource = bidLimits.upTo(candidate, false)
+		endedDestination, endedSource := bidEnds.upTo(candidate, false)
+		demand := bidPrices.total().Sub(expiredDestination).
+			Sub(limitedDestination.Sub(endedDestination)).
+			Add(limitedSource.Sub(endedSource).Quo(candidate))
+
+		volume := sdk.MinDec(supply, demand)
+		if !volume.IsPositive() {
+			continue
+		}
+
+		imbalance := supply.Sub(demand).Abs()
+
+		switch {
+		case !found, volume.GT(bestVolume):
+		case volume.Equal(bestVolume) && imbalance.LT(bestImbalance):
+		case volume.Equal(bestVolume) && imbalance.Equal(bestImbalance) && reference != nil &&
+			candidate.Sub(*reference).Abs().LT(price.Sub(*reference).Abs()):
+		default:
+			continue
+		}
+
+		price, bestVolume, bestImbalance, found = candidate, volume, imbalance, true
+	}
+
+	return price, found
+}
+
+// priceSums holds the cumulative amounts of orders ordered by a price, so that the sums over the orders up to a price
+// are found by binary search.
+type priceSums struct {
+	prices                []sdk.Dec
+	amounts, otherAmounts []sdk.Dec
+}
+
+func (s *priceSums) add(price, amount, otherAmount sdk.Dec) {
+	s.prices = append(s.prices, price)
+	s.amounts = append(s.amounts, amount)
+	s.otherAmounts = append(s.otherAmounts, otherAmount)
+}
+
+// accumulate sorts the entries by price and replaces their amounts by the sums of the amounts up to and including them.
+func (s *priceSums) accumulate() {
+	sort.Stable(s)
+
+	for i := 1; i < len(s.prices); i++ {
+		s.amounts[i] = s.amounts[i-1].Add(s.amounts[i])
+		s.otherAmounts[i] = s.otherAmounts[i-1].Add(s.otherAmounts[i])
+	}
+}
+
+// upTo returns the sums of the amounts of the entries with a price below the given price, including those at the price
+// if inclusive.
+func (s priceSums) upTo(price sdk.Dec, inclusive bool) (amount, otherAmount sdk.Dec) {
+	n := sort.Search(len(s.prices), func(i int) bool {
+		if inclusive {
+			return s.prices[i].GT(price)
+		}
+		return s.prices[i].GTE(price)
+	})
+
+	if n == 0 {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	return s.amounts[n-1], s.otherAmounts[n-1]
+}
+
+func (s priceSums) total() sdk.Dec {
+	if len(s.amounts) == 0 {
+		return sdk.ZeroDec()
+	}
+	return s.amounts[len(s.amounts)-1]
+}
+
+func (s *priceSums) Len() int           { return len(s.prices) }
+func (s *priceSums) Less(i, j int) bool { return s.prices[i].LT(s.prices[j]) }
+func (s *priceSums) Swap(i, j int) {
+	s.prices[i], s.prices[j] = s.prices[j], s.prices[i]
+	s.amounts[i], s.amounts[j] = s.amounts[j], s.amounts[i]
+	s.otherAmounts[i], s.otherAmounts[j] = s.otherAmounts[j], s.otherAmounts[i]
+}
+
+func (k *Keeper) expireImmediateOrCancelOrders(ctx sdk.Context, src, dst string) {
+	for _, order := range k.GetInstrumentOrders(ctx, src, dst) {
+		if order.TimeInForce != types.TimeInForce_ImmediateOrCancel {
+			continue
+		}
+
+		k.emitCloseEvent(ctx, *order, types.OrderState_Expired)
+		k.deleteOrder(ctx, order)
+		k.recordTerminalOrder(ctx, *order, types.OrderState_Expired)
+		k.refundDeposit(ctx, order)
+	}
+}
@@ -0,0 +1,245 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchAuction(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithBatchAuction(2)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// Crossing orders are not matched when placed
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "110usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "220usd", "200eur")))
+	require.Empty(t, typedEvents(t, ctx, &types.EventOrderFilled{}))
+	require.Equal(t, coins("10000eur"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	// ... nor before the end of the batch interval
+	k.RunBatchAuctions(ctx)
+	require.Empty(t, typedEvents(t, ctx, &types.EventOrderFilled{}))
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.RunBatchAuctions(ctx)
+
+	// All orders trade at the price that maximizes the volume
+	price := sdk.MustNewDecFromStr("1.1")
+	require.True(t, findEventAttr(ctx, "batch_auction"))
+	require.True(t, epsEqual(price, *k.GetInstrument(ctx, "eur", "usd").LastPrice))
+
+	require.Equal(t, coins("9809eur,210usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+	require.Equal(t, coins("100eur,9890usd"), bk.GetAllBalances(ctx, acc2.GetAddress()))
+	require.Equal(t, coins("91eur,9900usd"), bk.GetAllBalances(ctx, acc3.GetAddress()))
+
+	// One fill is reported per order and batch
+	fills := typedEvents(t, ctx, &types.EventOrderFilled{})
+	require.Len(t, fills, 4)
+	for _, fill := range fills {
+		require.False(t, fill.(*types.EventOrderFilled).Aggressive)
+	}
+	require.Equal(t, coin("100eur"), fills[2].(*types.EventOrderFilled).SourceFilled)
+	require.Equal(t, coin("110usd"), fills[2].(*types.EventOrderFilled).DestinationFilled)
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	remaining := k.GetOrdersByOwner(ctx, acc3.GetAddress())
+	require.Len(t, remaining, 1)
+	require.Equal(t, sdk.NewInt(120), remaining[0].SourceRemaining)
+	require.Equal(t, sdk.NewInt(91), remaining[0].DestinationFilled)
+}
+
+func TestBatchAuctionTimeInForce(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	listing := types.NewInstrument("usd", "eur", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithBatchAuction(1)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// The listing of either direction applies to both
+	fok, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_FillOrKill, coin("100eur"), coin("100usd"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, fok), types.ErrBatchAuctionFillOrKill)
+
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("100usd"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "50usd", "50eur")))
+
+	// Immediate-or-cancel orders expire after the auction
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.RunBatchAuctions(ctx)
+
+	require.Equal(t, coins("9950eur,50usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	expired := typedEvents(t, ctx, &types.EventOrderExpired{})
+	require.Len(t, expired, 1)
+	require.Equal(t, ioc.ClientOrderID, expired[0].(*types.EventOrderExpired).ClientOrderID)
+	require.Equal(t, coin("50eur"), expired[0].(*types.EventOrderExpired).SourceFilled)
+}
+
+func TestBatchAuctionSwitchToContinuous(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithBatchAuction(10)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	// The collected orders are cleared before the instrument is matched continuously
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing.WithBatchAuction(0)))
+	require.True(t, findEventAttr(ctx, "batch_auction"))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+	require.Equal(t, coins("9900eur,100usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestClearingPrice(t *testing.T) {
+	ctx, _, ak, bk := createTestComponents(t)
+
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	ask := order(ctx.BlockTime(), acc, "100eur", "100usd")
+
+	// Orders that do not cross do not trade
+	_, found := clearingPrice([]*types.Order{&ask}, nil, nil)
+	require.False(t, found)
+
+	noCross := order(ctx.BlockTime(), acc, "90usd", "100eur")
+	_, found = clearingPrice([]*types.Order{&ask}, []*types.Order{&noCross}, nil)
+	require.False(t, found)
+
+	// 100eur trade at both 1 and 2 without imbalance. Ties are decided by the reference price, if any.
+	ask2 := order(ctx.BlockTime(), acc, "50eur", "100usd")
+	asks := []*types.Order{&ask, &ask2}
+	bid := order(ctx.BlockTime(), acc, "300usd", "100eur")
+
+	price, found := clearingPrice(asks, []*types.Order{&bid}, nil)
+	require.True(t, found)
+	require.True(t, epsEqual(sdk.OneDec(), price))
+
+	reference := sdk.MustNewDecFromStr("1.9")
+	price, found = clearingPrice(asks, []*types.Order{&bid}, &reference)
+	require.True(t, found)
+	require.True(t, epsEqual(sdk.NewDec(2), price))
+}
+
+func TestClearingPriceVolume(t *testing.T) {
+	ctx, _, ak, bk := createTestComponents(t)
+
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+	r := rand.New(rand.NewSource(1))
+
+	// Orders are partially filled at random, which may limit them by their destination rather than their source
+	randomOrders := func(src, dst string) (res []*types.Order) {
+		for i := r.Intn(20); i >= 0; i-- {
+			o := order(ctx.BlockTime(), acc, fmt.Sprintf("%d%v", 50+r.Intn(100), src), fmt.Sprintf("%d%v", 50+r.Intn(100), dst))
+			if r.Intn(2) == 0 {
+				o.SourceFilled = sdk.NewInt(r.Int63n(o.Source.Amount.Int64()))
+				o.SourceRemaining = o.Source.Amount.Sub(o.SourceFilled)
+				o.DestinationFilled = sdk.NewInt(r.Int63n(o.Destination.Amount.Int64()))
+			}
+			res = append(res, &o)
+		}
+		return
+	}
+
+	// volume computes the traded volume at a price directly from the orders
+	volume := func(asks, bids []*types.Order, price sdk.Dec) sdk.Dec {
+		supply, demand := sdk.ZeroDec(), sdk.ZeroDec()
+		for _, ask := range asks {
+			if ask.Price().LTE(price) {
+				destinationRemaining := ask.Destination.Amount.Sub(ask.DestinationFilled).ToDec()
+				supply = supply.Add(sdk.MinDec(ask.SourceRemaining.ToDec(), destinationRemaining.Quo(price)))
+			}
+		}
+		for _, bid := range bids {
+			if sdk.OneDec().Quo(bid.Price()).GTE(price) {
+				destinationRemaining := bid.Destination.Amount.Sub(bid.DestinationFilled).ToDec()
+				demand = demand.Add(sdk.MinDec(destinationRemaining, bid.SourceRemaining.ToDec().Quo(price)))
+			}
+		}
+		return sdk.MinDec(supply, demand)
+	}
+
+	eps := sdk.NewDecWithPrec(1, 9)
+	for i := 0; i < 200; i++ {
+		asks, bids := randomOrders("eur", "usd"), randomOrders("usd", "eur")
+
+		bestVolume := sdk.ZeroDec()
+		for _, o := range append(asks, bids...) {
+			for _, candidate := range []sdk.Dec{o.Price(), sdk.OneDec().Quo(o.Price())} {
+				bestVolume = sdk.MaxDec(bestVolume, volume(asks, bids, candidate))
+			}
+		}
+
+		price, found := clearingPrice(asks, bids, nil)
+		require.Equal(t, bestVolume.IsPositive(), found)
+		if found {
+			require.True(t, volume(asks, bids, price).Sub(bestVolume).Abs().LTE(eps), "%v != %v", volume(asks, bids, price), bestVolume)
+		}
+	}
+}
+
+func TestBatchAuctionSelfTrade(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithBatchAuction(2)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+	require.NoError(t, k.SetSelfTradePrevention(ctx, acc1.GetAddress(), types.SelfTradePrevention_CancelBoth))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100usd", "100eur")))
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.RunBatchAuctions(ctx)
+
+	// Crossing orders of the same owner trade at the clearing price regardless of their self-trade prevention
+	require.True(t, findEventAttr(ctx, "batch_auction"))
+	require.Empty(t, typedEvents(t, ctx, &types.EventSelfTradePrevented{}))
+	require.Empty(t, typedEvents(t, ctx, &types.EventOrderCancelled{}))
+	require.Len(t, typedEvents(t, ctx, &types.EventOrderFilled{}), 2)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Equal(t, coins("10000eur,10000usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+}
@@ -0,0 +1,99 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Number of blocks weighted by the rolling average that serves as reference price.
+const referencePriceWindow = 10
+
+// tripCircuitBreaker checks the prices that executing the plan would register in market data. If any of them deviates
+// more than the price band of its instrument from the reference price, the instrument is halted and true is returned.
+func (k *Keeper) tripCircuitBreaker(ctx sdk.Context, aggressiveOrder *types.Order, plan types.ExecutionPlan) bool {
+	tripped := k.checkPriceBand(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom, plan.Price)
+
+	for _, passiveOrder := range []*types.Order{plan.FirstOrder, plan.SecondOrder} {
+		if passiveOrder == nil {
+			continue
+		}
+
+		if k.checkPriceBand(ctx, passiveOrder.Source.Denom, passiveOrder.Destination.Denom, passiveOrder.Price()) {
+			tripped = true
+		}
+	}
+
+	return tripped
+}
+
+// checkPriceBand halts the instrument in both directions if trading at the price breaches the price band of either
+// listing.
+func (k *Keeper) checkPriceBand(ctx sdk.Context, src, dst string, price sdk.Dec) bool {
+	return k.checkListingPriceBand(ctx, src, dst, price) ||
+		k.checkListingPriceBand(ctx, dst, src, sdk.OneDec().Quo(price))
+}
+
+func (k *Keeper) checkListingPriceBand(ctx sdk.Context, src, dst string, price sdk.Dec) bool {
+	listing := k.GetListing(ctx, src, dst)
+	if listing == nil {
+		return false
+	}
+
+	reference := nextReferencePrice(k.GetInstrument(ctx, src, dst), price, ctx.BlockTime())
+	if !listing.BreachesPriceBand(price, reference) {
+		return false
+	}
+
+	haltedUntil := ctx.BlockTime().Add(listing.CoolingPeriod)
+	k.haltInstrument(ctx, src, dst, haltedUntil)
+	k.haltInstrument(ctx, dst, src, haltedUntil)
+
+	k.emitCircuitBreakerEvent(ctx, src, dst, price, reference, haltedUntil)
+	return true
+}
+
+func (k Keeper) haltInstrument(ctx sdk.Context, src, dst string, haltedUntil time.Time) {
+	md := k.GetInstrument(ctx, src, dst)
+	if md == nil {
+		md = &types.MarketData{Source: src, Destination: dst}
+	}
+
+	md.HaltedUntil = &haltedUntil
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Set(types.GetMarketDataKey(src, dst), k.cdc.MustMarshal(md))
+}
+
+// getHaltedUntil returns the end of the instrument's halt, or nil if it is not halted.
+func (k Keeper) getHaltedUntil(ctx sdk.Context, src, dst string) *time.Time {
+	md := k.GetInstrument(ctx, src, dst)
+	if md == nil || !md.IsHalted(ctx.BlockTime()) {
+		return nil
+	}
+
+	return md.HaltedUntil
+}
+
+// nextReferencePrice returns the reference price of the instrument when trading at the price. The reference price is a
+// rolling average of the last traded price of each block, so trades within a block cannot move it.
+func nextReferencePrice(md *types.MarketData, price sdk.Dec, blockTime time.Time) sdk.Dec {
+	if md == nil || md.LastPrice == nil {
+		return price
+	}
+
+	if md.ReferencePrice == nil {
+		return *md.LastPrice
+	}
+
+	if md.Timestamp == nil || !md.Timestamp.Before(blockTime) {
+		return *md.ReferencePrice
+	}
+
+	return md.ReferencePrice.Add(md.LastPrice.Sub(*md.ReferencePrice).QuoInt64(referencePriceWindow))
+}
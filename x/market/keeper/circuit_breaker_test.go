@@ -0,0 +1,149 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferencePrice(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.True(t, epsEqual(sdk.OneDec(), *k.GetInstrument(ctx, "eur", "usd").ReferencePrice))
+
+	// Trades within the same block do not move the reference price
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "200usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "200usd", "100eur")))
+
+	md := k.GetInstrument(ctx, "eur", "usd")
+	require.True(t, md.LastPrice.GT(sdk.MustNewDecFromStr("1.99")))
+	require.True(t, epsEqual(sdk.OneDec(), *md.ReferencePrice))
+
+	// The last price of the previous block moves the rolling average
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "200usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "200usd", "100eur")))
+
+	md = k.GetInstrument(ctx, "eur", "usd")
+	require.True(t, epsEqual(sdk.MustNewDecFromStr("1.1"), *md.ReferencePrice))
+	require.Nil(t, md.HaltedUntil)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a reference price of 1
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithCircuitBreaker(sdk.MustNewDecFromStr("0.1"), time.Hour)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// A trade at 1.5 would breach the price band
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "150usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "150usd", "100eur")))
+	require.True(t, findEventAttr(ctx, "circuit_breaker"))
+
+	haltedUntil := ctx.BlockTime().Add(time.Hour)
+	for _, instr := range [][2]string{{"eur", "usd"}, {"usd", "eur"}} {
+		md := k.GetInstrument(ctx, instr[0], instr[1])
+		require.True(t, epsEqual(sdk.OneDec(), *md.LastPrice))
+		require.Equal(t, haltedUntil, *md.HaltedUntil)
+	}
+
+	// Orders rest during the halt, but nothing matches
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "105usd")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+
+	res, err := k.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd"})
+	require.NoError(t, err)
+	require.Equal(t, haltedUntil, *res.HaltedUntil)
+
+	instruments, err := k.Instruments(sdk.WrapSDKContext(ctx), &types.QueryInstrumentsRequest{})
+	require.NoError(t, err)
+	for _, instr := range instruments.Instruments {
+		if instr.Source == "eur" && instr.Destination == "usd" {
+			require.Equal(t, haltedUntil, *instr.HaltedUntil)
+			require.True(t, epsEqual(sdk.OneDec(), *instr.ReferencePrice))
+		}
+	}
+
+	// Trading within the band resumes after the cooling period
+	ctx = ctx.WithBlockTime(haltedUntil)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "105usd", "100eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	md := k.GetInstrument(ctx, "eur", "usd")
+	require.True(t, epsEqual(sdk.MustNewDecFromStr("1.05"), *md.LastPrice))
+	require.Nil(t, md.HaltedUntil)
+
+	res, err = k.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd"})
+	require.NoError(t, err)
+	require.Nil(t, res.HaltedUntil)
+}
+
+func TestCircuitBreakerWithoutReferencePrice(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithCircuitBreaker(sdk.MustNewDecFromStr("0.1"), time.Hour)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// The first trade establishes the reference price
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "150usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "150usd", "100eur")))
+	require.False(t, findEventAttr(ctx, "circuit_breaker"))
+	require.True(t, epsEqual(sdk.MustNewDecFromStr("1.5"), *k.GetInstrument(ctx, "eur", "usd").ReferencePrice))
+}
+
+func TestCircuitBreakerFillOrKill(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a reference price of 1
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()).
+		WithCircuitBreaker(sdk.MustNewDecFromStr("0.1"), time.Hour)
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "150usd")))
+
+	// The fill-or-kill order is killed, but the instrument is halted
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	fok, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_FillOrKill, coin("150usd"), coin("100eur"), acc2.GetAddress(), "fok")
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, fok))
+	require.True(t, findEventAttr(ctx, "circuit_breaker"))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	haltedUntil := ctx.BlockTime().Add(time.Hour)
+	for _, instr := range [][2]string{{"eur", "usd"}, {"usd", "eur"}} {
+		md := k.GetInstrument(ctx, instr[0], instr[1])
+		require.NotNil(t, md.HaltedUntil)
+		require.Equal(t, haltedUntil, *md.HaltedUntil)
+	}
+}
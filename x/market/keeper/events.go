@@ -0,0 +1,79 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Order events are emitted as typed events. The legacy events are emitted alongside them while enabled by the
+// LegacyEvents parameter.
+
+func (k Keeper) emitAcceptEvent(ctx sdk.Context, order types.Order) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitAcceptEvent(ctx, order)
+	}
+	types.EmitOrderAcceptedEvent(ctx, order)
+}
+
+func (k Keeper) emitFillEvent(ctx sdk.Context, order types.Order, aggressive bool, sourceFilled sdk.Int, destinationFilled sdk.Int) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitFillEvent(ctx, order, aggressive, sourceFilled, destinationFilled)
+	}
+	types.EmitOrderFilledEvent(ctx, order, aggressive, sourceFilled, destinationFilled)
+}
+
+// emitCloseEvent reports an order that left the order book in the given state.
+func (k Keeper) emitCloseEvent(ctx sdk.Context, order types.Order, state types.OrderState) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitExpireEvent(ctx, order)
+	}
+	types.EmitOrderClosedEvent(ctx, order, state)
+}
+
+func (k Keeper) emitUpdateEvent(ctx sdk.Context, order types.Order) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitUpdateEvent(ctx, order)
+	}
+	types.EmitOrderUpdatedEvent(ctx, order)
+}
+
+func (k Keeper) emitStopAcceptEvent(ctx sdk.Context, order types.StopOrder) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitStopAcceptEvent(ctx, order)
+	}
+	types.EmitStopOrderAcceptedEvent(ctx, order)
+}
+
+func (k Keeper) emitStopTriggerEvent(ctx sdk.Context, order types.StopOrder, price sdk.Dec) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitStopTriggerEvent(ctx, order, price)
+	}
+	types.EmitStopOrderTriggeredEvent(ctx, order, price)
+}
+
+func (k Keeper) emitStopExpireEvent(ctx sdk.Context, order types.StopOrder) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitStopExpireEvent(ctx, order)
+	}
+	types.EmitStopOrderExpiredEvent(ctx, order)
+}
+
+func (k Keeper) emitSelfTradeEvent(ctx sdk.Context, mode types.SelfTradePrevention, aggressive, resting types.Order) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitSelfTradeEvent(ctx, mode, aggressive, resting)
+	}
+	types.EmitSelfTradePreventedEvent(ctx, mode, aggressive, resting)
+}
+
+func (k Keeper) emitCircuitBreakerEvent(ctx sdk.Context, src, dst string, price, reference sdk.Dec, haltedUntil time.Time) {
+	if k.GetParams(ctx).LegacyEvents {
+		types.EmitCircuitBreakerEvent(ctx, src, dst, price, reference, haltedUntil)
+	}
+	types.EmitCircuitBreakerTrippedEvent(ctx, src, dst, price, reference, haltedUntil)
+}
@@ -0,0 +1,161 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedEvents(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	passive := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, passive))
+	orderID := k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].ID
+
+	accepted := typedEvents(t, ctx, &types.EventOrderAccepted{})
+	require.Len(t, accepted, 1)
+	require.Equal(t, &types.EventOrderAccepted{
+		ID:            orderID,
+		Owner:         acc1.GetAddress().String(),
+		ClientOrderID: passive.ClientOrderID,
+		Source:        coin("100eur"),
+		Destination:   coin("120usd"),
+		Created:       passive.Created,
+	}, accepted[0])
+
+	// Partially fill the passive order
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	fills := typedEvents(t, ctx, &types.EventOrderFilled{})
+	require.Len(t, fills, 2)
+
+	passiveFill := fills[0].(*types.EventOrderFilled)
+	require.False(t, passiveFill.Aggressive)
+	require.False(t, passiveFill.Filled)
+	require.Equal(t, coin("50eur"), passiveFill.SourceFilled)
+	require.Equal(t, coin("60usd"), passiveFill.DestinationFilled)
+
+	aggressiveFill := fills[1].(*types.EventOrderFilled)
+	require.True(t, aggressiveFill.Aggressive)
+	require.True(t, aggressiveFill.Filled)
+	require.Equal(t, acc2.GetAddress().String(), aggressiveFill.Owner)
+	require.Equal(t, coin("60usd"), aggressiveFill.SourceFilled)
+	require.Equal(t, coin("50eur"), aggressiveFill.DestinationFilled)
+
+	// Filled orders are not reported as expired
+	require.Empty(t, typedEvents(t, ctx, &types.EventOrderExpired{}))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), passive.ClientOrderID))
+
+	cancelled := typedEvents(t, ctx, &types.EventOrderCancelled{})
+	require.Len(t, cancelled, 1)
+	require.Equal(t, &types.EventOrderCancelled{
+		ID:                orderID,
+		Owner:             acc1.GetAddress().String(),
+		ClientOrderID:     passive.ClientOrderID,
+		Source:            coin("100eur"),
+		SourceFilled:      coin("50eur"),
+		Destination:       coin("120usd"),
+		DestinationFilled: coin("60usd"),
+	}, cancelled[0])
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100usd"), coin("50eur"), acc2.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	expired := typedEvents(t, ctx, &types.EventOrderExpired{})
+	require.Len(t, expired, 1)
+	require.Equal(t, ioc.ClientOrderID, expired[0].(*types.EventOrderExpired).ClientOrderID)
+	require.True(t, expired[0].(*types.EventOrderExpired).SourceFilled.IsZero())
+}
+
+func TestLegacyEventsParameter(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	// Legacy events are enabled by default
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.True(t, findEventAttr(ctx, "accept"))
+	require.Len(t, typedEvents(t, ctx, &types.EventOrderAccepted{}), 1)
+
+	k.SetParams(ctx, types.Params{LegacyEvents: false})
+	require.False(t, k.GetParams(ctx).LegacyEvents)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+
+	require.False(t, findEventAttr(ctx, "accept"))
+	require.False(t, findEventAttr(ctx, "expire"))
+	require.Len(t, typedEvents(t, ctx, &types.EventOrderAccepted{}), 1)
+	require.Len(t, typedEvents(t, ctx, &types.EventOrderCancelled{}), 1)
+}
+
+func TestOrderUpdatedEvent(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "")
+
+	o := order(ctx.BlockTime(), acc1, "5000eur", "6000usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	orderID := k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].ID
+
+	k.SetParams(ctx, types.Params{LegacyEvents: false})
+
+	// Reducing the balance below the remaining order amount updates the order
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("7000eur")))
+
+	require.False(t, findEventAttr(ctx, "update"))
+
+	updated := typedEvents(t, ctx, &types.EventOrderUpdated{})
+	require.Len(t, updated, 1)
+	require.Equal(t, &types.EventOrderUpdated{
+		ID:              orderID,
+		Owner:           acc1.GetAddress().String(),
+		ClientOrderID:   o.ClientOrderID,
+		SourceRemaining: coin("3000eur"),
+	}, updated[0])
+
+	k.SetParams(ctx, types.DefaultParams())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, bk.SendCoins(ctx, acc2.GetAddress(), acc1.GetAddress(), coins("1000eur")))
+
+	require.True(t, findEventAttr(ctx, "update"))
+	require.Len(t, typedEvents(t, ctx, &types.EventOrderUpdated{}), 1)
+}
+
+// typedEvents returns the typed events emitted in the context of the same type as msg.
+func typedEvents(t *testing.T, ctx sdk.Context, msg proto.Message) (res []proto.Message) {
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(msg) {
+			continue
+		}
+
+		typed, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		res = append(res, typed)
+	}
+
+	return res
+}
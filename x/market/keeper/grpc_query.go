@@ -25,7 +25,16 @@ func (k Keeper) ByAccount(c context.Context, req *types.QueryByAccountRequest) (
 	}
 
 	orders := k.GetOrdersByOwner(ctx, account)
-	return &types.QueryByAccountResponse{Orders: orders}, nil
+	stopOrders := k.GetStopOrdersByOwner(ctx, account)
+	params := k.GetParams(ctx)
+	return &types.QueryByAccountResponse{
+		Orders:                     orders,
+		StopOrders:                 stopOrders,
+		SelfTradePrevention:        k.GetSelfTradePrevention(ctx, account),
+		MaxOpenOrders:              params.MaxOpenOrders,
+		MaxOpenOrdersPerInstrument: params.MaxOpenOrdersPerInstrument,
+		OrderDeposit:               params.OrderDeposit,
+	}, nil
 }
 
 func (k Keeper) Instruments(c context.Context, req *types.QueryInstrumentsRequest) (*types.QueryInstrumentsResponse, error) {
@@ -78,9 +87,136 @@ func (k Keeper) Instrument(c context.Context, req *types.QueryInstrumentRequest)
 		Source:      source,
 		Destination: destination,
 		Orders:      orders,
+		HaltedUntil: k.getHaltedUntil(ctx, source, destination),
 	}, nil
 }
 
+// Quote simulates a market order without committing the trades, so wallets can show the expected result of a
+// MsgAddMarketOrder.
+func (k Keeper) Quote(c context.Context, req *types.QueryQuoteRequest) (*types.QueryQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	destination, err := sdk.ParseCoinNormalized(req.Destination)
+	if err != nil || !destination.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid destination: %v", req.Destination)
+	}
+
+	if err := sdk.ValidateDenom(req.Source); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid source denomination: %v", req.Source)
+	}
+
+	if req.Source == destination.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInstrument, "'%v/%v' is not a valid instrument", req.Source, destination.Denom)
+	}
+
+	maxSlippage := sdk.ZeroDec()
+	if req.MaximumSlippage != "" {
+		maxSlippage, err = sdk.NewDecFromStr(req.MaximumSlippage)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSlippage, "%v", req.MaximumSlippage)
+		}
+	}
+
+	source, err := k.GetSrcFromSlippage(ctx, req.Source, destination, maxSlippage)
+	if err != nil {
+		return nil, err
+	}
+
+	order := types.Order{
+		TimeInForce:       types.TimeInForce_ImmediateOrCancel,
+		Source:            source,
+		SourceRemaining:   source.Amount,
+		SourceFilled:      sdk.ZeroInt(),
+		Destination:       destination,
+		DestinationFilled: sdk.ZeroInt(),
+		Created:           ctx.BlockTime(),
+	}
+	if err := order.IsValid(); err != nil {
+		return nil, err
+	}
+
+	// Quote only orders that meet the requirements of a listed instrument, as NewOrderSingle would reject them
+	if listing := k.GetListing(ctx, source.Denom, destination.Denom); listing != nil {
+		if err := order.IsValidForInstrument(*listing); err != nil {
+			return nil, err
+		}
+	}
+
+	// Nothing is written, as the cached context is discarded and the traded amounts are not settled.
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewInfiniteGasMeter())
+	fills, _, _ := k.matchOrder(cacheCtx, &order, false)
+
+	res := &types.QueryQuoteResponse{
+		Fills:             fills,
+		SourceFilled:      sdk.NewCoin(source.Denom, order.SourceFilled),
+		DestinationFilled: sdk.NewCoin(destination.Denom, order.DestinationFilled),
+		FillOrKill:        order.IsFilled(),
+	}
+
+	if res.Fills == nil {
+		res.Fills = []types.QuoteFill{}
+	}
+
+	md := k.GetInstrument(ctx, source.Denom, destination.Denom)
+	if md != nil && md.LastPrice != nil {
+		res.LastPrice = md.LastPrice
+	}
+
+	if order.SourceFilled.IsPositive() {
+		averagePrice := order.DestinationFilled.ToDec().Quo(order.SourceFilled.ToDec())
+		res.AveragePrice = &averagePrice
+
+		if res.LastPrice != nil {
+			slippage := res.LastPrice.Sub(averagePrice).Quo(*res.LastPrice)
+			res.Slippage = &slippage
+		}
+	}
+
+	return res, nil
+}
+
+// Order returns the order with the given orderID from the order book, or its final state if it is retained in the
+// node's order history.
+func (k Keeper) Order(c context.Context, req *types.QueryOrderByIDRequest) (*types.QueryOrderByIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if order := k.GetOrderByID(ctx, req.OrderId); order != nil {
+		return &types.QueryOrderByIDResponse{Order: order}, nil
+	}
+
+	if terminal := k.GetTerminalOrder(ctx, req.OrderId); terminal != nil {
+		return &types.QueryOrderByIDResponse{Terminal: terminal}, nil
+	}
+
+	return nil, status.Errorf(codes.NotFound, "order %d not found or not retained", req.OrderId)
+}
+
+func (k Keeper) OrderHistory(c context.Context, req *types.QueryOrderHistoryRequest) (*types.QueryOrderHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	orders, pageRes, err := k.GetOrderHistory(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryOrderHistoryResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
 func queryInstruments(ctx sdk.Context, k *Keeper) (*types.QueryInstrumentsResponse, error) {
 	instruments, err := k.GetAllInstruments(ctx)
 	if err != nil {
@@ -95,6 +231,17 @@ func queryInstruments(ctx sdk.Context, k *Keeper) (*types.QueryInstrumentsRespon
 			LastPrice:   v.LastPrice,
 			BestPrice:   k.GetBestPrice(ctx, v.Source, v.Destination),
 			LastTraded:  v.Timestamp,
+
+			ReferencePrice: v.ReferencePrice,
+			HaltedUntil:    v.HaltedUntil,
+			BatchInterval:  k.batchInterval(ctx, v.Source, v.Destination),
+		}
+
+		if listing := k.GetListing(ctx, v.Source, v.Destination); listing != nil {
+			response[i].Status = listing.Status
+			response[i].MinOrderSize = &listing.MinOrderSize
+			response[i].TickSize = &listing.TickSize
+			response[i].LotSize = &listing.LotSize
 		}
 	}
 
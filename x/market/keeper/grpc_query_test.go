@@ -242,3 +242,160 @@ func fundAccount(ctx sdk.Context, acc sdk.AccAddress, bk bankkeeper.Keeper,
 
 	return setAccBalance(ctx, acc, bk, balance)
 }
+
+func TestQuote(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	_, err := queryClient.Quote(sdk.WrapSDKContext(ctx), &types.QueryQuoteRequest{Source: "usd", Destination: "100eur"})
+	require.ErrorIs(t, err, types.ErrNoMarketDataAvailable)
+
+	// Establish a last price of 1 eur per usd and leave passive orders at 0.5 and 0.25
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "200usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "400usd")))
+
+	balances := bk.GetAllBalances(ctx, acc1.GetAddress())
+
+	specs := map[string]struct {
+		req           *types.QueryQuoteRequest
+		expErr        bool
+		expFills      int
+		expSource     string
+		expDest       string
+		expPrice      string
+		expSlippage   string
+		expFillOrKill bool
+	}{
+		"within first order": {
+			req:           &types.QueryQuoteRequest{Source: "usd", Destination: "50eur", MaximumSlippage: "1"},
+			expFills:      1,
+			expSource:     "100usd",
+			expDest:       "50eur",
+			expPrice:      "0.5",
+			expSlippage:   "0.5",
+			expFillOrKill: true,
+		},
+		"two orders": {
+			req:           &types.QueryQuoteRequest{Source: "usd", Destination: "150eur", MaximumSlippage: "3"},
+			expFills:      2,
+			expSource:     "400usd",
+			expDest:       "150eur",
+			expPrice:      "0.375",
+			expSlippage:   "0.625",
+			expFillOrKill: true,
+		},
+		"slippage too low": {
+			req:           &types.QueryQuoteRequest{Source: "usd", Destination: "150eur", MaximumSlippage: "1"},
+			expFills:      1,
+			expSource:     "200usd",
+			expDest:       "100eur",
+			expPrice:      "0.5",
+			expSlippage:   "0.5",
+			expFillOrKill: false,
+		},
+		"spread not crossed": {
+			req:           &types.QueryQuoteRequest{Source: "usd", Destination: "150eur"},
+			expSource:     "0usd",
+			expDest:       "0eur",
+			expFillOrKill: false,
+		},
+		"invalid destination": {
+			req:    &types.QueryQuoteRequest{Source: "usd", Destination: "eur"},
+			expErr: true,
+		},
+		"same denomination": {
+			req:    &types.QueryQuoteRequest{Source: "eur", Destination: "100eur"},
+			expErr: true,
+		},
+		"invalid slippage": {
+			req:    &types.QueryQuoteRequest{Source: "usd", Destination: "100eur", MaximumSlippage: "x"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := queryClient.Quote(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			assert.Len(t, gotRsp.Fills, spec.expFills)
+			assert.Equal(t, spec.expSource, gotRsp.SourceFilled.String())
+			assert.Equal(t, spec.expDest, gotRsp.DestinationFilled.String())
+			assert.Equal(t, spec.expFillOrKill, gotRsp.FillOrKill)
+			assert.True(t, epsEqual(sdk.OneDec(), *gotRsp.LastPrice))
+
+			if spec.expPrice == "" {
+				assert.Nil(t, gotRsp.AveragePrice)
+				assert.Nil(t, gotRsp.Slippage)
+				return
+			}
+			assert.Equal(t, sdk.MustNewDecFromStr(spec.expPrice), *gotRsp.AveragePrice)
+			assert.True(t, epsEqual(sdk.MustNewDecFromStr(spec.expSlippage), *gotRsp.Slippage), gotRsp.Slippage.String())
+		})
+	}
+
+	// Quotes leave the order book and balances untouched
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+	require.Equal(t, balances, bk.GetAllBalances(ctx, acc1.GetAddress()))
+}
+
+func TestQuoteListedInstrument(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a last price of 1 eur per usd and leave a passive order at the same price
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000usd")))
+
+	listing := types.NewInstrument("usd", "eur", types.InstrumentStatus_Active, sdk.NewInt(100), sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10))
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	specs := map[string]struct {
+		destination string
+		status      types.InstrumentStatus
+		expErr      error
+	}{
+		"valid":                 {destination: "100eur", status: types.InstrumentStatus_Active},
+		"below minimum":         {destination: "50eur", status: types.InstrumentStatus_Active, expErr: types.ErrInvalidOrderSize},
+		"not a multiple of lot": {destination: "105eur", status: types.InstrumentStatus_Active, expErr: types.ErrInvalidOrderSize},
+		"halted":                {destination: "100eur", status: types.InstrumentStatus_Halted, expErr: types.ErrInstrumentNotActive},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			listing.Status = spec.status
+			require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+			gotRsp, gotErr := queryClient.Quote(sdk.WrapSDKContext(ctx), &types.QueryQuoteRequest{
+				Source: "usd", Destination: spec.destination, MaximumSlippage: "0.05",
+			})
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, gotRsp.Fills, 1)
+			assert.Equal(t, spec.destination, gotRsp.DestinationFilled.String())
+			assert.True(t, gotRsp.FillOrKill)
+		})
+	}
+}
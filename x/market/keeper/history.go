@@ -0,0 +1,164 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
+
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// FlagOrderHistoryRetention is the app.toml setting for the number of terminal orders retained per account by this
+// node. Zero disables the order history.
+const FlagOrderHistoryRetention = "market.order-history-retention"
+
+// recordTerminalOrder retains the final state of an order that left the order book. Orders are kept in the transient
+// store until the end of the block, so that orders of reverted transactions are not recorded.
+func (k Keeper) recordTerminalOrder(ctx sdk.Context, order types.Order, state types.OrderState) {
+	if k.historyRetention == 0 {
+		return
+	}
+
+	entry := types.NewTerminalOrder(order, state, ctx.BlockHeight(), ctx.BlockTime())
+	ctx.TransientStore(k.tkey).Set(types.GetTerminalOrderKey(order.ID), k.cdc.MustMarshal(&entry))
+}
+
+// PersistOrderHistory moves the orders that left the order book during the block to the application database. The most
+// recently closed orders of each account are retained.
+func (k Keeper) PersistOrderHistory(ctx sdk.Context) {
+	if k.historyRetention == 0 {
+		return
+	}
+
+	batch := apptypes.GetCurrentBatch(ctx)
+	if batch == nil {
+		panic("batch object not found")
+	}
+
+	var (
+		tstore  = ctx.TransientStore(k.tkey)
+		keys    [][]byte
+		owners  []string
+		entries = make(map[string][]types.TerminalOrder)
+	)
+
+	it := sdk.KVStorePrefixIterator(tstore, types.GetTerminalOrderPrefix())
+	for ; it.Valid(); it.Next() {
+		var entry types.TerminalOrder
+		k.cdc.MustUnmarshal(it.Value(), &entry)
+
+		owner := entry.Order.Owner
+		if _, found := entries[owner]; !found {
+			owners = append(owners, owner)
+		}
+		entries[owner] = append(entries[owner], entry)
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		tstore.Delete(key)
+	}
+
+	for _, owner := range owners {
+		k.persistAccountHistory(batch, owner, entries[owner])
+	}
+}
+
+func (k Keeper) persistAccountHistory(batch dbm.Batch, owner string, entries []types.TerminalOrder) {
+	retention := int(k.historyRetention)
+	if len(entries) > retention {
+		entries = entries[len(entries)-retention:]
+	}
+
+	// Remove the oldest orders of the account to make room for the new ones
+	var retained [][]byte
+	it, err := k.database.Iterator(types.GetOrderHistoryPrefix(owner), sdk.PrefixEndBytes(types.GetOrderHistoryPrefix(owner)))
+	if err != nil {
+		panic(err)
+	}
+	for ; it.Valid(); it.Next() {
+		retained = append(retained, it.Key())
+	}
+	it.Close()
+
+	for i := 0; i < len(retained)+len(entries)-retention; i++ {
+		var entry types.TerminalOrder
+		bz, err := k.database.Get(retained[i])
+		if err != nil {
+			panic(err)
+		}
+		k.cdc.MustUnmarshal(bz, &entry)
+
+		batch.Delete(retained[i])
+		batch.Delete(types.GetOrderHistoryIDKey(entry.Order.ID))
+	}
+
+	for _, entry := range entries {
+		key := types.GetOrderHistoryKey(owner, entry.Height, entry.Order.ID)
+		batch.Set(key, k.cdc.MustMarshal(&entry))
+		batch.Set(types.GetOrderHistoryIDKey(entry.Order.ID), key)
+	}
+}
+
+// GetTerminalOrder returns the final state of the order if it is retained in the order history.
+func (k Keeper) GetTerminalOrder(ctx sdk.Context, orderId uint64) *types.TerminalOrder {
+	if k.database == nil {
+		return nil
+	}
+
+	key, err := k.database.Get(types.GetOrderHistoryIDKey(orderId))
+	if err != nil {
+		panic(err)
+	}
+	if key == nil {
+		return nil
+	}
+
+	bz, err := k.database.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+
+	entry := new(types.TerminalOrder)
+	k.cdc.MustUnmarshal(bz, entry)
+	return entry
+}
+
+// GetOrderHistory returns a page of the account's terminal orders, most recently closed first.
+func (k Keeper) GetOrderHistory(ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest) ([]types.TerminalOrder, *query.PageResponse, error) {
+	res := make([]types.TerminalOrder, 0)
+	if k.database == nil {
+		return res, &query.PageResponse{}, nil
+	}
+
+	// Reversing the order of the store lists the most recent orders first
+	req := query.PageRequest{Reverse: true}
+	if pageReq != nil {
+		req = *pageReq
+		req.Reverse = !pageReq.Reverse
+	}
+
+	store := prefix.NewStore(dbadapter.Store{DB: k.database}, types.GetOrderHistoryPrefix(owner.String()))
+	pageRes, err := query.Paginate(store, &req, func(_, value []byte) error {
+		var entry types.TerminalOrder
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		res = append(res, entry)
+		return nil
+	})
+
+	return res, pageRes, err
+}
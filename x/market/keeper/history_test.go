@@ -0,0 +1,221 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	apptypes "github.com/e-money/em-ledger/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetOrderByID(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, orders[0], k.GetOrderByID(ctx, orders[0].ID))
+	require.Nil(t, k.GetOrderByID(ctx, orders[0].ID+1))
+
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+	require.Nil(t, k.GetOrderByID(ctx, orders[0].ID))
+}
+
+func TestOrderHistory(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Filled passive and aggressive orders
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+
+	// Canceled order
+	canceled := order(ctx.BlockTime(), acc1, "100eur", "150usd")
+	require.NoError(t, k.NewOrderSingle(ctx, canceled))
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), canceled.ClientOrderID))
+
+	// Unmatched immediate-or-cancel order
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100usd"), coin("50eur"), acc2.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	// Orders are only retained at the end of the block
+	require.Empty(t, historyOf(t, k, ctx, acc1.GetAddress(), nil))
+	endBlock(t, k, ctx)
+
+	history := historyOf(t, k, ctx, acc1.GetAddress(), nil)
+	require.Len(t, history, 2)
+	require.Equal(t, types.OrderState_Cancelled, history[0].State)
+	require.Equal(t, canceled.ClientOrderID, history[0].Order.ClientOrderID)
+	require.Equal(t, types.OrderState_Filled, history[1].State)
+	require.Equal(t, sdk.NewInt(100), history[1].Order.DestinationFilled)
+	require.Equal(t, ctx.BlockHeight(), history[1].Height)
+	require.Equal(t, ctx.BlockTime(), history[1].Closed)
+
+	history = historyOf(t, k, ctx, acc2.GetAddress(), nil)
+	require.Len(t, history, 2)
+	require.Equal(t, types.OrderState_Expired, history[0].State)
+	require.Equal(t, ioc.ClientOrderID, history[0].Order.ClientOrderID)
+	require.True(t, history[0].Order.DestinationFilled.IsZero())
+	require.Equal(t, types.OrderState_Filled, history[1].State)
+	require.Equal(t, sdk.NewInt(100), history[1].Order.DestinationFilled)
+
+	// Entries are only persisted once
+	endBlock(t, k, ctx)
+	require.Len(t, historyOf(t, k, ctx, acc1.GetAddress(), nil), 2)
+}
+
+func TestOrderHistoryRetention(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	var clientOrderIds []string
+	for block := 0; block < 3; block++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+		for i := 0; i < 2; i++ {
+			o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+			require.NoError(t, k.NewOrderSingle(ctx, o))
+			require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+			clientOrderIds = append(clientOrderIds, o.ClientOrderID)
+		}
+
+		endBlock(t, k, ctx)
+	}
+
+	// Only the most recently closed orders are retained
+	history := historyOf(t, k, ctx, acc1.GetAddress(), nil)
+	require.Len(t, history, testHistoryRetention)
+	for i, entry := range history {
+		require.Equal(t, clientOrderIds[len(clientOrderIds)-1-i], entry.Order.ClientOrderID)
+	}
+
+	// Pruned orders can no longer be looked up
+	for _, entry := range history {
+		require.NotNil(t, k.GetTerminalOrder(ctx, entry.Order.ID))
+	}
+	require.Nil(t, k.GetTerminalOrder(ctx, history[len(history)-1].Order.ID-1))
+
+	// Pagination
+	page := historyOf(t, k, ctx, acc1.GetAddress(), &query.PageRequest{Limit: 2})
+	require.Equal(t, history[:2], page)
+
+	res, err := k.OrderHistory(sdk.WrapSDKContext(ctx), &types.QueryOrderHistoryRequest{
+		Address:    acc1.GetAddress().String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.OrderHistory(sdk.WrapSDKContext(ctx), &types.QueryOrderHistoryRequest{
+		Address:    acc1.GetAddress().String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, history[2:], res.Orders)
+	require.Nil(t, res.Pagination.NextKey)
+
+	_, err = k.OrderHistory(sdk.WrapSDKContext(ctx), &types.QueryOrderHistoryRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestOrderHistoryFillOrKill(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "50usd", "50eur")))
+
+	fok, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_FillOrKill, coin("100eur"), coin("100usd"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, fok))
+	endBlock(t, k, ctx)
+
+	// The killed order is retained without the fills that were rolled back
+	history := historyOf(t, k, ctx, acc1.GetAddress(), nil)
+	require.Len(t, history, 1)
+	require.Equal(t, types.OrderState_Expired, history[0].State)
+	require.True(t, history[0].Order.SourceFilled.IsZero())
+	require.True(t, history[0].Order.DestinationFilled.IsZero())
+
+	require.Empty(t, historyOf(t, k, ctx, acc2.GetAddress(), nil))
+}
+
+func TestQueryOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	active := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, active))
+	canceled := order(ctx.BlockTime(), acc1, "100eur", "150usd")
+	require.NoError(t, k.NewOrderSingle(ctx, canceled))
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), canceled.ClientOrderID))
+	endBlock(t, k, ctx)
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+
+	res, err := k.Order(sdk.WrapSDKContext(ctx), &types.QueryOrderByIDRequest{OrderId: orders[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, orders[0], res.Order)
+	require.Nil(t, res.Terminal)
+
+	history := historyOf(t, k, ctx, acc1.GetAddress(), nil)
+	require.Len(t, history, 1)
+
+	res, err = k.Order(sdk.WrapSDKContext(ctx), &types.QueryOrderByIDRequest{OrderId: history[0].Order.ID})
+	require.NoError(t, err)
+	require.Nil(t, res.Order)
+	require.Equal(t, history[0], *res.Terminal)
+
+	_, err = k.Order(sdk.WrapSDKContext(ctx), &types.QueryOrderByIDRequest{OrderId: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMigrateOrderIDIndex(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+
+	// Remove the index to simulate an order placed before the migration
+	ctx.KVStore(k.keyIndices).Delete(types.GetOrderIDKey(orders[0].ID))
+	require.Nil(t, k.GetOrderByID(ctx, orders[0].ID))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, orders[0], k.GetOrderByID(ctx, orders[0].ID))
+}
+
+// endBlock persists the order history of the block as done by the market's EndBlocker.
+func endBlock(t *testing.T, k *Keeper, ctx sdk.Context) {
+	batch := k.database.NewBatch()
+	k.PersistOrderHistory(apptypes.WithCurrentBatch(ctx, batch))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+}
+
+func historyOf(t *testing.T, k *Keeper, ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest) []types.TerminalOrder {
+	history, _, err := k.GetOrderHistory(ctx, owner, pageReq)
+	require.NoError(t, err)
+	return history
+}
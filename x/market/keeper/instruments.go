@@ -0,0 +1,124 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetInstrument lists an instrument or updates its listing. Delisting an instrument cancels its orders and switching it
+// from batch auctions to continuous matching holds a final auction.
+func (k *Keeper) SetInstrument(ctx sdk.Context, authority sdk.AccAddress, instrument types.Instrument) error {
+	if err := k.authorityk.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	if err := instrument.Validate(); err != nil {
+		return err
+	}
+
+	for _, denom := range []string{instrument.Source, instrument.Destination} {
+		if !k.assetExists(ctx, sdk.Coin{Denom: denom}) {
+			return sdkerrors.Wrap(types.ErrUnknownAsset, denom)
+		}
+	}
+
+	batch := k.batchInterval(ctx, instrument.Source, instrument.Destination) > 0
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.GetInstrumentKey(instrument.Source, instrument.Destination), k.cdc.MustMarshal(&instrument))
+
+	k.registerMarketData(ctx, instrument.Source, instrument.Destination)
+	k.registerMarketData(ctx, instrument.Destination, instrument.Source)
+	types.EmitInstrumentEvent(ctx, instrument)
+
+	if instrument.Status == types.InstrumentStatus_Delisted {
+		k.cancelInstrumentOrders(ctx, instrument.Source, instrument.Destination)
+	} else if batch && k.batchInterval(ctx, instrument.Source, instrument.Destination) == 0 {
+		// The orders collected for the next auction are cleared before matching continuously
+		k.runBatchAuction(ctx, instrument.Source, instrument.Destination)
+	}
+
+	return nil
+}
+
+// GetListing returns the listing of the instrument, or nil if it is not listed.
+func (k Keeper) GetListing(ctx sdk.Context, src, dst string) *types.Instrument {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.GetInstrumentKey(src, dst))
+	if bz == nil {
+		return nil
+	}
+
+	instrument := new(types.Instrument)
+	k.cdc.MustUnmarshal(bz, instrument)
+	return instrument
+}
+
+func (k Keeper) GetListings(ctx sdk.Context) (res []types.Instrument) {
+	store := ctx.KVStore(k.key)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetInstrumentPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var instrument types.Instrument
+		k.cdc.MustUnmarshal(it.Value(), &instrument)
+		res = append(res, instrument)
+	}
+
+	return
+}
+
+// Orders in instruments that are not listed are matched without restrictions, unless halted by a circuit breaker.
+func (k Keeper) isTradable(ctx sdk.Context, src, dst string) bool {
+	if md := k.GetInstrument(ctx, src, dst); md != nil && md.IsHalted(ctx.BlockTime()) {
+		return false
+	}
+
+	listing := k.GetListing(ctx, src, dst)
+	return listing == nil || listing.IsActive()
+}
+
+func (k *Keeper) cancelInstrumentOrders(ctx sdk.Context, src, dst string) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	var orders []*types.Order
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetPriorityKeyByInstrument(src, dst))
+	for ; it.Valid(); it.Next() {
+		order := new(types.Order)
+		k.cdc.MustUnmarshal(it.Value(), order)
+		orders = append(orders, order)
+	}
+	it.Close()
+
+	for _, order := range orders {
+		k.emitCloseEvent(ctx, *order, types.OrderState_Cancelled)
+		k.deleteOrder(ctx, order)
+		k.recordTerminalOrder(ctx, *order, types.OrderState_Cancelled)
+		k.refundDeposit(ctx, order)
+	}
+
+	var stopOrders []*types.StopOrder
+	it = sdk.KVStorePrefixIterator(idxStore, types.GetStopTriggerKeyByInstrument(src, dst))
+	for ; it.Valid(); it.Next() {
+		order := new(types.StopOrder)
+		k.cdc.MustUnmarshal(store.Get(it.Value()), order)
+		stopOrders = append(stopOrders, order)
+	}
+	it.Close()
+
+	for _, order := range stopOrders {
+		k.emitStopExpireEvent(ctx, *order)
+		k.deleteStopOrder(ctx, order)
+		k.refundStopDeposit(ctx, order)
+	}
+}
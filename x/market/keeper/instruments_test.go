@@ -0,0 +1,165 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetInstrumentListing(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.NewInt(10), sdk.MustNewDecFromStr("0.01"), sdk.NewInt(5))
+
+	err := k.SetInstrument(ctx, randomAddress(), listing)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Nil(t, k.GetListing(ctx, "eur", "usd"))
+
+	invalid := listing
+	invalid.Status = types.InstrumentStatus_Unspecified
+	require.ErrorIs(t, k.SetInstrument(ctx, testAuthority, invalid), types.ErrInvalidListing)
+
+	invalid = listing
+	invalid.Destination = "xyz"
+	require.ErrorIs(t, k.SetInstrument(ctx, testAuthority, invalid), types.ErrUnknownAsset)
+
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+	require.True(t, findEventAttr(ctx, "set_instrument"))
+	require.Equal(t, &listing, k.GetListing(ctx, "eur", "usd"))
+	require.Nil(t, k.GetListing(ctx, "usd", "eur"))
+	require.Len(t, k.GetListings(ctx), 1)
+
+	res, err := k.Instruments(sdk.WrapSDKContext(ctx), &types.QueryInstrumentsRequest{})
+	require.NoError(t, err)
+	for _, instr := range res.Instruments {
+		if instr.Source == "eur" && instr.Destination == "usd" {
+			require.Equal(t, types.InstrumentStatus_Active, instr.Status)
+			require.Equal(t, sdk.NewInt(10), *instr.MinOrderSize)
+			require.Equal(t, sdk.MustNewDecFromStr("0.01"), *instr.TickSize)
+			require.Equal(t, sdk.NewInt(5), *instr.LotSize)
+		} else {
+			require.Equal(t, types.InstrumentStatus_Unspecified, instr.Status)
+			require.Nil(t, instr.TickSize)
+		}
+	}
+}
+
+func TestListedInstrumentOrderSizes(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05"), sdk.NewInt(10))
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	specs := map[string]struct {
+		src, dst string
+		expErr   error
+	}{
+		"valid":                 {src: "100eur", dst: "120usd"},
+		"below minimum":         {src: "90eur", dst: "90usd", expErr: types.ErrInvalidOrderSize},
+		"not a multiple of lot": {src: "100eur", dst: "115usd", expErr: types.ErrInvalidOrderSize},
+		"off tick":              {src: "101eur", dst: "120usd", expErr: types.ErrInvalidTickSize},
+		"source rounded":        {src: "109eur", dst: "120usd"},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			err := k.NewOrderSingle(cacheCtx, order(ctx.BlockTime(), acc1, spec.src, spec.dst))
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Unlisted instruments are not restricted
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1usd", "1eur")))
+}
+
+func TestMarketOrderInListedInstrument(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a last price of 0.97 usd per eur
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "97usd", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "97usd")))
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Active, sdk.ZeroInt(), sdk.MustNewDecFromStr("0.1"), sdk.ZeroInt())
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// The derived source amount is adjusted to a price at the next tick
+	src, err := k.GetSrcFromSlippage(ctx, "eur", coin("500usd"), sdk.MustNewDecFromStr("0.05"))
+	require.NoError(t, err)
+	require.Equal(t, coin("500eur"), src)
+
+	o, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, src, coin("500usd"), acc1.GetAddress(), "market")
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+}
+
+func TestHaltedInstrument(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	resting := order(ctx.BlockTime(), acc1, "100eur", "100usd")
+	require.NoError(t, k.NewOrderSingle(ctx, resting))
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Halted, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt())
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// New orders are rejected
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd"))
+	require.ErrorIs(t, err, types.ErrInstrumentNotActive)
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90usd", "0.9", "stop"))
+	require.ErrorIs(t, err, types.ErrInstrumentNotActive)
+
+	// Resting orders are kept, but not matched
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+	require.Nil(t, k.GetInstrument(ctx, "eur", "usd").LastPrice)
+
+	// Trading resumes once the instrument is active
+	listing.Status = types.InstrumentStatus_Active
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestDelistedInstrument(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90usd", "0.9", "stop")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "110eur")))
+
+	listing := types.NewInstrument("eur", "usd", types.InstrumentStatus_Delisted, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt())
+	require.NoError(t, k.SetInstrument(ctx, testAuthority, listing))
+
+	// Orders of the delisted instrument are canceled, but not those of the reverse instrument
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+	require.True(t, findEventAttr(ctx, "expire"))
+	require.True(t, findEventAttr(ctx, "expire_stop"))
+
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd"))
+	require.ErrorIs(t, err, types.ErrInstrumentNotActive)
+}
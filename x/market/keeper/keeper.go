@@ -16,7 +16,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/e-money/em-ledger/x/market/types"
+	dbm "github.com/tendermint/tm-db"
 )
 
 const (
@@ -31,22 +33,41 @@ var _ marketKeeper = &Keeper{}
 type Keeper struct {
 	key        sdk.StoreKey
 	keyIndices sdk.StoreKey
+	tkey       sdk.StoreKey
+	paramSpace paramtypes.Subspace
 	cdc        codec.BinaryCodec
 	// instruments types.Instruments
-	ak types.AccountKeeper
-	bk types.BankKeeper
+	ak         types.AccountKeeper
+	bk         types.BankKeeper
+	authorityk types.AuthorityKeeper
+
+	// Order history retained outside of the consensus state
+	database         dbm.DB
+	historyRetention uint64
 
 	// accountOrders types.Orders
 	appstateInit *sync.Once
 }
 
-func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
+// NewKeeper creates a market keeper. The terminal states of up to historyRetention orders per account are retained in the
+// application database. A historyRetention of zero disables the order history.
+func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, tkey sdk.StoreKey, paramSpace paramtypes.Subspace, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, authorityKeeper types.AuthorityKeeper, db dbm.DB, historyRetention uint64) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	k := &Keeper{
 		cdc:        cdc,
 		key:        key,
 		keyIndices: keyIndices,
+		tkey:       tkey,
+		paramSpace: paramSpace,
 		ak:         authKeeper,
 		bk:         bankKeeper,
+		authorityk: authorityKeeper,
+
+		database:         db,
+		historyRetention: historyRetention,
 
 		appstateInit: new(sync.Once),
 	}
@@ -69,6 +90,12 @@ func (k *Keeper) createExecutionPlan(ctx sdk.Context, SourceDenom, DestinationDe
 			continue
 		}
 
+		// Orders in halted or delisted instruments are not matched, nor are those cleared by batch auctions
+		if !k.isTradable(ctx, SourceDenom, firstInstrument.Destination) ||
+			k.batchInterval(ctx, SourceDenom, firstInstrument.Destination) > 0 {
+			continue
+		}
+
 		// firstPassiveOrder := firstInstrument.Orders.LeftKey().(*types.Order)
 		firstPassiveOrder := k.getBestOrder(ctx, SourceDenom, firstInstrument.Destination)
 		if firstPassiveOrder == nil {
@@ -91,6 +118,11 @@ func (k *Keeper) createExecutionPlan(ctx sdk.Context, SourceDenom, DestinationDe
 
 		// Check synthetic price by going through two orders:
 		// (SourceDenom, X) -> (X, DestinationDenom)
+		if !k.isTradable(ctx, firstInstrument.Destination, DestinationDenom) ||
+			k.batchInterval(ctx, firstInstrument.Destination, DestinationDenom) > 0 {
+			continue
+		}
+
 		secondPassiveOrder := k.getBestOrder(ctx, firstInstrument.Destination, DestinationDenom)
 		if secondPassiveOrder == nil {
 			continue
@@ -137,6 +169,13 @@ func (k *Keeper) GetSrcFromSlippage(
 	source := dst.Amount.ToDec().Quo(*md.LastPrice)
 	source = source.Mul(sdk.NewDec(1).Add(maxSlippage))
 
+	// Listed instruments require the price to be a multiple of the tick size. Rounding the price up keeps the source
+	// amount within the slippage.
+	if listing := k.GetListing(ctx, srcDenom, dst.Denom); listing != nil && listing.TickSize.IsPositive() && source.IsPositive() {
+		price := listing.RoundPriceToTick(dst.Amount.ToDec().Quo(source))
+		source = dst.Amount.ToDec().Quo(price)
+	}
+
 	slippageSource := sdk.NewCoin(srcDenom, source.RoundInt())
 	return slippageSource, nil
 }
@@ -151,6 +190,7 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 
 	// Set this to true to roll back any state changes made by the aggressive order. Used for FillOrKill orders.
 	KillOrder := false
+	killCtx := ctx
 	ctx, commitTrade := ctx.CacheContext()
 
 	defer func() {
@@ -172,6 +212,23 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		)
 	}
 
+	// Verify that the order meets the requirements of a listed instrument
+	if listing := k.GetListing(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom); listing != nil {
+		if err := aggressiveOrder.IsValidForInstrument(*listing); err != nil {
+			return err
+		}
+	}
+
+	// Orders in batch auction instruments are not matched before the next auction, so they cannot be filled or killed
+	// immediately
+	batch := k.batchInterval(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom) > 0
+	if batch && aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill {
+		return sdkerrors.Wrapf(
+			types.ErrBatchAuctionFillOrKill, "%v/%v",
+			aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom,
+		)
+	}
+
 	owner, err := sdk.AccAddressFromBech32(aggressiveOrder.Owner)
 	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
@@ -199,11 +256,33 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		return sdkerrors.Wrapf(types.ErrAccountBalanceInsufficientForInstrument, "")
 	}
 
-	// Verify uniqueness of client order id among active orders
-	if containsClientId(accountOrders, aggressiveOrder.ClientOrderID) {
+	// Verify uniqueness of client order id among active and stop orders
+	if containsClientId(accountOrders, aggressiveOrder.ClientOrderID) ||
+		k.GetStopOrderByOwnerAndClientOrderId(ctx, aggressiveOrder.Owner, aggressiveOrder.ClientOrderID) != nil {
 		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, aggressiveOrder.ClientOrderID)
 	}
 
+	// Orders that can rest in the book are subject to the open order limits and deposit of the account
+	params := k.GetParams(ctx)
+	if aggressiveOrder.TimeInForce == types.TimeInForce_GoodTillCancel || batch {
+		stopOrders := k.GetStopOrdersByOwner(ctx, owner)
+		if err := checkOpenOrderLimits(params, accountOrders, stopOrders, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom); err != nil {
+			return err
+		}
+
+		// The deposit must be covered in addition to the source demand of all of the account's orders in the instrument
+		required := params.OrderDeposit.Add(totalSourceDemand)
+		if _, anyNegative := spendableCoins.SafeSub(required); anyNegative {
+			return sdkerrors.Wrapf(
+				types.ErrAccountBalanceInsufficient,
+				"Account %v has insufficient balance for the order and its deposit: %v < %v",
+				owner,
+				spendableCoins,
+				required,
+			)
+		}
+	}
+
 	// Verify that the destination asset actually exists on chain before creating an instrument
 	if !k.assetExists(ctx, aggressiveOrder.Destination) {
 		return sdkerrors.Wrap(types.ErrUnknownAsset, aggressiveOrder.Destination.Denom)
@@ -213,8 +292,72 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 
 	// Accept order
 	aggressiveOrder.ID = k.getNextOrderNumber(ctx)
-	types.EmitAcceptEvent(ctx, aggressiveOrder)
+	k.emitAcceptEvent(ctx, aggressiveOrder)
+
+	unmatchedOrder := aggressiveOrder
+	var (
+		canceled bool
+		tripped  *types.ExecutionPlan
+	)
+	if !batch {
+		_, canceled, tripped = k.matchOrder(ctx, &aggressiveOrder, true)
+	}
+
+	if aggressiveOrder.IsFilled() {
+		k.emitCloseEvent(ctx, aggressiveOrder, types.OrderState_Filled)
+		k.recordTerminalOrder(ctx, aggressiveOrder, types.OrderState_Filled)
+	} else {
+		addToBook := true
+
+		switch {
+		case batch:
+			// Immediate-or-cancel orders expire after the next auction
+		case aggressiveOrder.TimeInForce == types.TimeInForce_ImmediateOrCancel:
+			addToBook = false
+			k.emitCloseEvent(ctx, aggressiveOrder, types.OrderState_Expired)
+			k.recordTerminalOrder(ctx, aggressiveOrder, types.OrderState_Expired)
+		case aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill:
+			KillOrder = true
+			addToBook = false
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			k.emitCloseEvent(ctx, aggressiveOrder, types.OrderState_Expired)
+
+			// The fills of a killed order are rolled back, but not the halt of an instrument it would have traded outside
+			// the price band of
+			k.recordTerminalOrder(killCtx, unmatchedOrder, types.OrderState_Expired)
+			if tripped != nil {
+				k.tripCircuitBreaker(killCtx, &unmatchedOrder, *tripped)
+			}
+		default:
+			if canceled {
+				addToBook = false
+				k.emitCloseEvent(ctx, aggressiveOrder, types.OrderState_Cancelled)
+				k.recordTerminalOrder(ctx, aggressiveOrder, types.OrderState_Cancelled)
+			}
+		}
+
+		if addToBook {
+			op := &aggressiveOrder
+			op.Deposit = params.OrderDeposit
+			k.setOrder(ctx, op)
+			if err := k.chargeDeposit(ctx, op); err != nil {
+				return err
+			}
+
+			// NOTE This should be the only place that an order is added to the book!
+			// NOTE If this ceases to be true, move logic to func that cleans up all datastructures.
+		}
+	}
+
+	retEvManager.EmitEvents(ctx.EventManager().Events())
 
+	return nil
+}
+
+// matchOrder executes the aggressive order against the order book until it is filled or the spread is no longer crossed.
+// The traded amounts are only transferred between the owners if settle is set. Returns canceled if the aggressive order
+// was cancelled by its self-trade prevention, and the plan that tripped a circuit breaker if any.
+func (k *Keeper) matchOrder(ctx sdk.Context, aggressiveOrder *types.Order, settle bool) (fills []types.QuoteFill, canceled bool, tripped *types.ExecutionPlan) {
 	for {
 		plan := k.createExecutionPlan(ctx, aggressiveOrder.Destination.Denom, aggressiveOrder.Source.Denom)
 		if plan.FirstOrder == nil {
@@ -241,6 +384,24 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			break
 		}
 
+		// Prevented self-trades do not execute and are not registered in market data
+		if len(selfTradeOrders(plan, aggressiveOrder.Owner)) > 0 {
+			if mode := k.effectiveSelfTradePrevention(ctx, *aggressiveOrder); mode != types.SelfTradePrevention_None {
+				if k.preventSelfTrade(ctx, mode, aggressiveOrder, plan, stepDestinationFilled) {
+					canceled = true
+					break
+				}
+
+				continue
+			}
+		}
+
+		// Trades outside the price band of a listed instrument halt it instead of executing
+		if k.tripCircuitBreaker(ctx, aggressiveOrder, plan) {
+			tripped = &plan
+			break
+		}
+
 		// Track aggressive fill for event
 		aggressiveSourceFilled := sdk.ZeroInt()
 		aggressiveDestinationFilled := sdk.ZeroInt()
@@ -296,15 +457,26 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			// Settle traded tokens
 			nextDestinationFilledCoin := sdk.NewCoin(passiveOrder.Destination.Denom, stepDestinationFilled.RoundInt())
 			nextSourceFilledCoin := sdk.NewCoin(passiveOrder.Source.Denom, stepSourceFilled.RoundInt())
-			if err := k.transferTradedAmounts(ctx, nextDestinationFilledCoin, nextSourceFilledCoin, passiveOrder.Owner, aggressiveOrder.Owner); err != nil {
-				panic(err)
+			if settle {
+				if err := k.transferTradedAmounts(ctx, nextDestinationFilledCoin, nextSourceFilledCoin, passiveOrder.Owner, aggressiveOrder.Owner); err != nil {
+					panic(err)
+				}
 			}
+			fills = append(fills, types.QuoteFill{
+				OrderID:     passiveOrder.ID,
+				Source:      nextDestinationFilledCoin,
+				Destination: nextSourceFilledCoin,
+			})
 
-			types.EmitFillEvent(ctx, *passiveOrder, false, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt())
+			k.emitFillEvent(ctx, *passiveOrder, false, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt())
 
 			if passiveOrder.IsFilled() {
 				k.deleteOrder(ctx, passiveOrder)
-				types.EmitExpireEvent(ctx, *passiveOrder)
+				k.emitCloseEvent(ctx, *passiveOrder, types.OrderState_Filled)
+				k.recordTerminalOrder(ctx, *passiveOrder, types.OrderState_Filled)
+				if settle {
+					k.refundDeposit(ctx, passiveOrder)
+				}
 			} else {
 				k.setOrder(ctx, passiveOrder)
 			}
@@ -316,7 +488,7 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			stepDestinationFilled = stepSourceFilled
 		}
 
-		types.EmitFillEvent(ctx, aggressiveOrder, true, aggressiveSourceFilled, aggressiveDestinationFilled)
+		k.emitFillEvent(ctx, *aggressiveOrder, true, aggressiveSourceFilled, aggressiveDestinationFilled)
 
 		// Register trades in market data
 		k.setMarketData(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom, plan.Price)
@@ -327,34 +499,7 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		}
 	}
 
-	if aggressiveOrder.IsFilled() {
-		types.EmitExpireEvent(ctx, aggressiveOrder)
-	} else {
-		addToBook := true
-
-		switch aggressiveOrder.TimeInForce {
-		case types.TimeInForce_ImmediateOrCancel:
-			addToBook = false
-			types.EmitExpireEvent(ctx, aggressiveOrder)
-		case types.TimeInForce_FillOrKill:
-			KillOrder = true
-			addToBook = false
-			ctx = ctx.WithEventManager(sdk.NewEventManager())
-			types.EmitExpireEvent(ctx, aggressiveOrder)
-		}
-
-		if addToBook {
-			op := &aggressiveOrder
-			k.setOrder(ctx, op)
-
-			// NOTE This should be the only place that an order is added to the book!
-			// NOTE If this ceases to be true, move logic to func that cleans up all datastructures.
-		}
-	}
-
-	retEvManager.EmitEvents(ctx.EventManager().Events())
-
-	return nil
+	return fills, canceled, tripped
 }
 
 // Check whether an asset even exists on the chain at the moment.
@@ -398,7 +543,9 @@ func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order,
 	}
 
 	k.deleteOrder(ctx, origOrder)
-	types.EmitExpireEvent(ctx, *origOrder)
+	k.emitCloseEvent(ctx, *origOrder, types.OrderState_Cancelled)
+	k.recordTerminalOrder(ctx, *origOrder, types.OrderState_Cancelled)
+	k.refundDeposit(ctx, origOrder)
 
 	// Adjust remaining according to how much of the replaced order was filled:
 	newOrder.SourceFilled = origOrder.SourceFilled
@@ -406,6 +553,7 @@ func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order,
 	newOrder.DestinationFilled = origOrder.DestinationFilled
 
 	newOrder.TimeInForce = origOrder.TimeInForce
+	newOrder.SelfTradePrevention = origOrder.SelfTradePrevention
 
 	return k.NewOrderSingle(ctx, newOrder)
 }
@@ -429,6 +577,23 @@ func (k *Keeper) GetOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientO
 	return o
 }
 
+// GetOrderByID returns the order in the order book with the given orderID, or nil if there is none.
+func (k *Keeper) GetOrderByID(ctx sdk.Context, orderId uint64) *types.Order {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	ownerKey := idxStore.Get(types.GetOrderIDKey(orderId))
+	if ownerKey == nil {
+		return nil
+	}
+
+	o := &types.Order{}
+	k.cdc.MustUnmarshal(store.Get(ownerKey), o)
+	return o
+}
+
 func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error {
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceCancelOrder, "CancelOrder")
@@ -438,11 +603,17 @@ func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderI
 	order := k.GetOrderByOwnerAndClientOrderId(ctx, owner.String(), clientOrderId)
 
 	if order == nil {
+		if k.cancelStopOrder(ctx, owner, clientOrderId) {
+			return nil
+		}
+
 		return sdkerrors.Wrap(types.ErrClientOrderIdNotFound, clientOrderId)
 	}
 
-	types.EmitExpireEvent(ctx, *order)
+	k.emitCloseEvent(ctx, *order, types.OrderState_Cancelled)
 	k.deleteOrder(ctx, order)
+	k.recordTerminalOrder(ctx, *order, types.OrderState_Cancelled)
+	k.refundDeposit(ctx, order)
 
 	return nil
 }
@@ -460,10 +631,12 @@ func (k *Keeper) accountChanged(ctx sdk.Context, accounts []sdk.AccAddress) {
 			order.SourceRemaining = sdk.MinInt(order.SourceRemaining, denomBalance)
 
 			if order.SourceRemaining.IsZero() {
-				types.EmitExpireEvent(ctx, *order)
+				k.emitCloseEvent(ctx, *order, types.OrderState_Expired)
 				k.deleteOrder(ctx, order)
+				k.recordTerminalOrder(ctx, *order, types.OrderState_Expired)
+				k.refundDeposit(ctx, order)
 			} else if !origSourceRemaining.Equal(order.SourceRemaining) {
-				types.EmitUpdateEvent(ctx, *order)
+				k.emitUpdateEvent(ctx, *order)
 				k.setOrder(ctx, order)
 			}
 		}
@@ -483,6 +656,8 @@ func (k Keeper) setOrder(ctx sdk.Context, order *types.Order) {
 
 	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.ID)
 	idxStore.Set(priorityKey, orderbz)
+
+	idxStore.Set(types.GetOrderIDKey(order.ID), ownerKey)
 }
 
 func (k Keeper) GetInstrument(ctx sdk.Context, src, dst string) *types.MarketData {
@@ -554,6 +729,10 @@ func (k Keeper) GetAllInstruments(ctx sdk.Context) ([]*types.MarketData, error)
 			if md != nil && md.LastPrice != nil {
 				instrLst[idx].LastPrice = md.LastPrice
 				instrLst[idx].Timestamp = md.Timestamp
+				instrLst[idx].ReferencePrice = md.ReferencePrice
+			}
+			if md != nil && md.IsHalted(ctx.BlockTime()) {
+				instrLst[idx].HaltedUntil = md.HaltedUntil
 			}
 
 			idx++
@@ -574,6 +753,8 @@ func (k *Keeper) deleteOrder(ctx sdk.Context, order *types.Order) {
 
 	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.ID)
 	idxStore.Delete(priorityKey)
+
+	idxStore.Delete(types.GetOrderIDKey(order.ID))
 }
 
 func (k Keeper) getBestOrder(ctx sdk.Context, src, dst string) *types.Order {
@@ -606,6 +787,22 @@ func (k Keeper) GetBestPrice(ctx sdk.Context, source, destination string) *sdk.D
 	return bestPrice
 }
 
+// GetInstrumentOrders returns the resting orders of the instrument in price/time priority.
+func (k Keeper) GetInstrumentOrders(ctx sdk.Context, src, dst string) (orders []*types.Order) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetPriorityKeyByInstrument(src, dst))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		order := new(types.Order)
+		k.cdc.MustUnmarshal(it.Value(), order)
+		orders = append(orders, order)
+	}
+
+	return orders
+}
+
 func (k Keeper) GetOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) (res []*types.Order) {
 	store := ctx.KVStore(k.key)
 
@@ -704,6 +901,16 @@ func (k Keeper) setMarketData(ctx sdk.Context, src, dst string, price sdk.Dec) {
 	md := types.MarketData{Source: src, Destination: dst, LastPrice: &price, Timestamp: &timestamp}
 	key := types.GetMarketDataKey(src, dst)
 
+	// Keep the rolling reference price and any halt of the circuit breaker
+	prev := k.GetInstrument(ctx, src, dst)
+	reference := nextReferencePrice(prev, price, timestamp)
+	md.ReferencePrice = &reference
+	if prev != nil && prev.IsHalted(timestamp) {
+		md.HaltedUntil = prev.HaltedUntil
+	}
+
 	bz := k.cdc.MustMarshal(&md)
 	idxStore.Set(key, bz)
+
+	k.recordStopTrigger(ctx, src, dst, price)
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -1448,6 +1449,7 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 		keyParams  = sdk.NewKVStoreKey("params")
 		keyBank    = sdk.NewKVStoreKey(banktypes.ModuleName)
 		tkeyParams = sdk.NewTransientStoreKey("transient_params")
+		tkeyMarket = sdk.NewTransientStoreKey(types.TStoreKey)
 
 		blockedAddr = make(map[string]bool)
 		maccPerms   = map[string][]string{
@@ -1463,6 +1465,8 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 	ms.MountStoreWithDB(keyAuthCap, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyMarket, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -1484,10 +1488,23 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 	err = mintBalance(ctx, bk, coins("1eur,1usd,1chf,1jpy,1gbp,1ngm"))
 	require.NoError(t, err)
 
-	marketKeeper := NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, ak, wrappedBank)
+	marketKeeper := NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, tkeyMarket, pk.Subspace(types.ModuleName), ak, wrappedBank, mockAuthorityKeeper{}, dbm.NewMemDB(), testHistoryRetention)
 	return ctx, marketKeeper, ak, wrappedBank
 }
 
+var testAuthority = randomAddress()
+
+const testHistoryRetention = 3
+
+type mockAuthorityKeeper struct{}
+
+func (mockAuthorityKeeper) ValidateAuthority(_ sdk.Context, address sdk.AccAddress) error {
+	if !testAuthority.Equals(address) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, address.String())
+	}
+	return nil
+}
+
 func MakeTestEncodingConfig() simappparams.EncodingConfig {
 	cdc := codec.NewLegacyAmino()
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
@@ -0,0 +1,39 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by indexing the orders in the order book by orderID.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var (
+		store    = ctx.KVStore(m.keeper.key)
+		idxStore = ctx.KVStore(m.keeper.keyIndices)
+	)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetOwnersPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		order := new(types.Order)
+		m.keeper.cdc.MustUnmarshal(it.Value(), order)
+		idxStore.Set(types.GetOrderIDKey(order.ID), it.Key())
+	}
+
+	return nil
+}
@@ -15,6 +15,9 @@ type marketKeeper interface {
 	CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
 	CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
 	GetSrcFromSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
+	NewStopOrder(ctx sdk.Context, order types.StopOrder) error
+	SetSelfTradePrevention(ctx sdk.Context, owner sdk.AccAddress, mode types.SelfTradePrevention) error
+	SetInstrument(ctx sdk.Context, authority sdk.AccAddress, instrument types.Instrument) error
 }
 type msgServer struct {
 	k marketKeeper
@@ -35,6 +38,7 @@ func (m msgServer) AddLimitOrder(c context.Context, msg *types.MsgAddLimitOrder)
 	if err != nil {
 		return nil, err
 	}
+	order.SelfTradePrevention = msg.SelfTradePrevention
 
 	err = m.k.NewOrderSingle(ctx, order)
 	if err != nil {
@@ -55,11 +59,12 @@ func (m msgServer) AddMarketOrder(c context.Context, msg *types.MsgAddMarketOrde
 	}
 
 	limitMsg := &types.MsgAddLimitOrder{
-		Owner:         msg.Owner,
-		ClientOrderId: msg.ClientOrderId,
-		TimeInForce:   msg.TimeInForce,
-		Source:        slippageSource,
-		Destination:   msg.Destination,
+		Owner:               msg.Owner,
+		ClientOrderId:       msg.ClientOrderId,
+		TimeInForce:         msg.TimeInForce,
+		Source:              slippageSource,
+		Destination:         msg.Destination,
+		SelfTradePrevention: msg.SelfTradePrevention,
 	}
 
 	_, err = m.AddLimitOrder(c, limitMsg)
@@ -127,3 +132,71 @@ func (m msgServer) CancelReplaceMarketOrder(c context.Context, msg *types.MsgCan
 	_, err = m.CancelReplaceLimitOrder(c, limitMsg)
 	return &types.MsgCancelReplaceMarketOrderResponse{}, err
 }
+
+func (m msgServer) AddStopLimitOrder(c context.Context, msg *types.MsgAddStopLimitOrder) (*types.MsgAddStopLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	order, err := types.NewStopLimitOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, msg.TriggerPrice, owner, msg.ClientOrderId)
+	if err != nil {
+		return nil, err
+	}
+	order.SelfTradePrevention = msg.SelfTradePrevention
+
+	if err := m.k.NewStopOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddStopLimitOrderResponse{}, nil
+}
+
+func (m msgServer) AddStopMarketOrder(c context.Context, msg *types.MsgAddStopMarketOrder) (*types.MsgAddStopMarketOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	order, err := types.NewStopMarketOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, msg.MaxSlippage, msg.TriggerPrice, owner, msg.ClientOrderId)
+	if err != nil {
+		return nil, err
+	}
+	order.SelfTradePrevention = msg.SelfTradePrevention
+
+	if err := m.k.NewStopOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddStopMarketOrderResponse{}, nil
+}
+
+func (m msgServer) SetSelfTradePrevention(c context.Context, msg *types.MsgSetSelfTradePrevention) (*types.MsgSetSelfTradePreventionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	if err := m.k.SetSelfTradePrevention(ctx, owner, msg.SelfTradePrevention); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSelfTradePreventionResponse{}, nil
+}
+
+func (m msgServer) SetInstrument(c context.Context, msg *types.MsgSetInstrument) (*types.MsgSetInstrumentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	if err := m.k.SetInstrument(ctx, authority, msg.Instrument); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetInstrumentResponse{}, nil
+}
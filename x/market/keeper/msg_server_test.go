@@ -601,12 +601,147 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 	}
 }
 
+func TestAddStopOrders(t *testing.T) {
+	var (
+		ownerAddr = randomAccAddress()
+		gotOrder  types.StopOrder
+	)
+
+	keeper := marketKeeperMock{
+		NewStopOrderFn: func(ctx sdk.Context, order types.StopOrder) error {
+			gotOrder = order
+			return nil
+		},
+	}
+	svr := NewMsgServerImpl(&keeper)
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+
+	_, err := svr.AddStopLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgAddStopLimitOrder{
+		Owner:         ownerAddr.String(),
+		ClientOrderId: "stopLimit",
+		TimeInForce:   types.TimeInForce_GoodTillCancel,
+		Source:        sdk.NewCoin("eeur", sdk.NewInt(100)),
+		Destination:   sdk.NewCoin("alx", sdk.NewInt(50)),
+		TriggerPrice:  sdk.MustNewDecFromStr("0.4"),
+	})
+	require.NoError(t, err)
+	assert.False(t, gotOrder.IsStopMarket())
+	assert.Equal(t, sdk.NewCoin("eeur", sdk.NewInt(100)), gotOrder.Source)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.4"), gotOrder.TriggerPrice)
+
+	_, err = svr.AddStopMarketOrder(sdk.WrapSDKContext(ctx), &types.MsgAddStopMarketOrder{
+		Owner:         ownerAddr.String(),
+		ClientOrderId: "stopMarket",
+		TimeInForce:   types.TimeInForce_ImmediateOrCancel,
+		Source:        "eeur",
+		Destination:   sdk.NewCoin("alx", sdk.NewInt(50)),
+		MaxSlippage:   sdk.MustNewDecFromStr("0.05"),
+		TriggerPrice:  sdk.MustNewDecFromStr("0.4"),
+	})
+	require.NoError(t, err)
+	assert.True(t, gotOrder.IsStopMarket())
+	assert.Equal(t, "eeur", gotOrder.Source.Denom)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.05"), gotOrder.MaxSlippage)
+
+	_, err = svr.AddStopLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgAddStopLimitOrder{
+		Owner:         ownerAddr.String(),
+		ClientOrderId: "invalidTrigger",
+		TimeInForce:   types.TimeInForce_GoodTillCancel,
+		Source:        sdk.NewCoin("eeur", sdk.NewInt(100)),
+		Destination:   sdk.NewCoin("alx", sdk.NewInt(50)),
+		TriggerPrice:  sdk.ZeroDec(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTriggerPrice)
+}
+
+func TestSetSelfTradePrevention(t *testing.T) {
+	var (
+		ownerAddr = randomAccAddress()
+		gotOrder  types.Order
+		gotOwner  sdk.AccAddress
+		gotMode   types.SelfTradePrevention
+	)
+
+	keeper := marketKeeperMock{
+		NewOrderSingleFn: func(ctx sdk.Context, aggressiveOrder types.Order) error {
+			gotOrder = aggressiveOrder
+			return nil
+		},
+		SetSelfTradePreventionFn: func(ctx sdk.Context, owner sdk.AccAddress, mode types.SelfTradePrevention) error {
+			gotOwner, gotMode = owner, mode
+			return nil
+		},
+	}
+	svr := NewMsgServerImpl(&keeper)
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+
+	_, err := svr.AddLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgAddLimitOrder{
+		Owner:               ownerAddr.String(),
+		ClientOrderId:       "limit",
+		TimeInForce:         types.TimeInForce_GoodTillCancel,
+		Source:              sdk.NewCoin("eeur", sdk.NewInt(100)),
+		Destination:         sdk.NewCoin("alx", sdk.NewInt(50)),
+		SelfTradePrevention: types.SelfTradePrevention_CancelOldest,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.SelfTradePrevention_CancelOldest, gotOrder.SelfTradePrevention)
+
+	_, err = svr.SetSelfTradePrevention(sdk.WrapSDKContext(ctx), &types.MsgSetSelfTradePrevention{
+		Owner:               ownerAddr.String(),
+		SelfTradePrevention: types.SelfTradePrevention_DecrementAndCancel,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ownerAddr, gotOwner)
+	assert.Equal(t, types.SelfTradePrevention_DecrementAndCancel, gotMode)
+
+	_, err = svr.SetSelfTradePrevention(sdk.WrapSDKContext(ctx), &types.MsgSetSelfTradePrevention{
+		Owner:               "invalid",
+		SelfTradePrevention: types.SelfTradePrevention_CancelBoth,
+	})
+	require.Error(t, err)
+}
+
+func TestSetInstrument(t *testing.T) {
+	var (
+		authorityAddr = randomAccAddress()
+		gotAuthority  sdk.AccAddress
+		gotInstrument types.Instrument
+	)
+
+	keeper := marketKeeperMock{
+		SetInstrumentFn: func(ctx sdk.Context, authority sdk.AccAddress, instrument types.Instrument) error {
+			gotAuthority, gotInstrument = authority, instrument
+			return nil
+		},
+	}
+	svr := NewMsgServerImpl(&keeper)
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+
+	instrument := types.NewInstrument("eeur", "alx", types.InstrumentStatus_Halted, sdk.NewInt(10), sdk.MustNewDecFromStr("0.01"), sdk.NewInt(5))
+	_, err := svr.SetInstrument(sdk.WrapSDKContext(ctx), &types.MsgSetInstrument{
+		Authority:  authorityAddr.String(),
+		Instrument: instrument,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, authorityAddr, gotAuthority)
+	assert.Equal(t, instrument, gotInstrument)
+
+	_, err = svr.SetInstrument(sdk.WrapSDKContext(ctx), &types.MsgSetInstrument{
+		Authority:  "invalid",
+		Instrument: instrument,
+	})
+	require.Error(t, err)
+}
+
 type marketKeeperMock struct {
 	NewMarketOrderWithSlippageFn func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec, owner sdk.AccAddress, timeInForce types.TimeInForce, clientOrderId string) error
 	NewOrderSingleFn             func(ctx sdk.Context, aggressiveOrder types.Order) error
 	CancelOrderFn                func(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
 	CancelReplaceLimitOrderFn    func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
 	GetSrcFromSlippageFn         func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
+	NewStopOrderFn               func(ctx sdk.Context, order types.StopOrder) error
+	SetSelfTradePreventionFn     func(ctx sdk.Context, owner sdk.AccAddress, mode types.SelfTradePrevention) error
+	SetInstrumentFn              func(ctx sdk.Context, authority sdk.AccAddress, instrument types.Instrument) error
 }
 
 func (m marketKeeperMock) NewMarketOrderWithSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec, owner sdk.AccAddress, timeInForce types.TimeInForce, clientOrderId string) error {
@@ -644,6 +779,27 @@ func (m marketKeeperMock) GetSrcFromSlippage(ctx sdk.Context, srcDenom string, d
 	return m.GetSrcFromSlippageFn(ctx, srcDenom, dst, maxSlippage)
 }
 
+func (m marketKeeperMock) NewStopOrder(ctx sdk.Context, order types.StopOrder) error {
+	if m.NewStopOrderFn == nil {
+		panic("not expected to be called")
+	}
+	return m.NewStopOrderFn(ctx, order)
+}
+
+func (m marketKeeperMock) SetSelfTradePrevention(ctx sdk.Context, owner sdk.AccAddress, mode types.SelfTradePrevention) error {
+	if m.SetSelfTradePreventionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetSelfTradePreventionFn(ctx, owner, mode)
+}
+
+func (m marketKeeperMock) SetInstrument(ctx sdk.Context, authority sdk.AccAddress, instrument types.Instrument) error {
+	if m.SetInstrumentFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetInstrumentFn(ctx, authority, instrument)
+}
+
 func randomAccAddress() sdk.AccAddress {
 	const legacyAddrLen = 20
 	return rand.Bytes(legacyAddrLen)
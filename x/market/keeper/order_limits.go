@@ -0,0 +1,89 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// checkOpenOrderLimits verifies that adding an order to the book does not exceed the maximum number of open orders of
+// the account. Pending stop orders count as open orders, as each of them can be placed in the book.
+func checkOpenOrderLimits(params types.Params, accountOrders []*types.Order, stopOrders []*types.StopOrder, src, dst string) error {
+	openOrders := len(accountOrders) + len(stopOrders)
+	if params.MaxOpenOrders > 0 && openOrders >= int(params.MaxOpenOrders) {
+		return sdkerrors.Wrapf(types.ErrTooManyOpenOrders, "account has %d open orders", openOrders)
+	}
+
+	if params.MaxOpenOrdersPerInstrument == 0 {
+		return nil
+	}
+
+	instrumentOrders := 0
+	for _, o := range accountOrders {
+		if o.Source.Denom == src && o.Destination.Denom == dst {
+			instrumentOrders++
+		}
+	}
+	for _, o := range stopOrders {
+		if o.Source.Denom == src && o.Destination.Denom == dst {
+			instrumentOrders++
+		}
+	}
+
+	if instrumentOrders >= int(params.MaxOpenOrdersPerInstrument) {
+		return sdkerrors.Wrapf(
+			types.ErrTooManyOpenOrders, "account has %d open orders in %v -> %v",
+			instrumentOrders, src, dst,
+		)
+	}
+
+	return nil
+}
+
+// chargeDeposit transfers the deposit of an order that is added to the book to the market module account.
+func (k *Keeper) chargeDeposit(ctx sdk.Context, order *types.Order) error {
+	return k.chargeOwnerDeposit(ctx, order.Owner, order.Deposit)
+}
+
+// refundDeposit returns the deposit of an order that left the book to its owner.
+func (k *Keeper) refundDeposit(ctx sdk.Context, order *types.Order) {
+	k.refundOwnerDeposit(ctx, order.Owner, order.Deposit)
+}
+
+// chargeStopDeposit transfers the deposit of an accepted stop order to the market module account.
+func (k *Keeper) chargeStopDeposit(ctx sdk.Context, order *types.StopOrder) error {
+	return k.chargeOwnerDeposit(ctx, order.Owner, order.Deposit)
+}
+
+// refundStopDeposit returns the deposit of a stop order that is no longer pending to its owner.
+func (k *Keeper) refundStopDeposit(ctx sdk.Context, order *types.StopOrder) {
+	k.refundOwnerDeposit(ctx, order.Owner, order.Deposit)
+}
+
+func (k *Keeper) chargeOwnerDeposit(ctx sdk.Context, owner string, deposit sdk.Coins) error {
+	if deposit.Empty() {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	return k.bk.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, deposit)
+}
+
+func (k *Keeper) refundOwnerDeposit(ctx sdk.Context, owner string, deposit sdk.Coins) {
+	if deposit.Empty() {
+		return
+	}
+
+	addr := sdk.MustAccAddressFromBech32(owner)
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, deposit); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,241 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenOrderLimits(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	params := types.DefaultParams()
+	params.MaxOpenOrders = 3
+	params.MaxOpenOrdersPerInstrument = 2
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "130usd")))
+
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "140usd"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	// Other instruments count towards the account limit only
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120chf")))
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120gbp"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	// Orders that cannot rest in the book are not limited
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("120gbp"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	// Canceling an order makes room for another
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 3)
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), orders[0].ClientOrderID))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120gbp")))
+
+	res, err := k.ByAccount(sdk.WrapSDKContext(ctx), &types.QueryByAccountRequest{Address: acc1.GetAddress().String()})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.MaxOpenOrders)
+	require.Equal(t, uint32(2), res.MaxOpenOrdersPerInstrument)
+}
+
+func TestOrderDeposit(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,15chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd,10chf")
+
+	// The module account also holds the balance minted by the test setup
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	deposits := func() sdk.Int {
+		return bk.GetAllBalances(ctx, moduleAddr).AmountOf("chf").Sub(sdk.OneInt())
+	}
+
+	params := types.DefaultParams()
+	params.OrderDeposit = coins("10chf")
+	k.SetParams(ctx, params)
+
+	// Resting orders are charged the deposit
+	o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Equal(t, sdk.NewInt(10), deposits())
+	require.Equal(t, coins("10000eur,5chf"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+	require.Equal(t, coins("10chf"), k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].Deposit)
+
+	// The account cannot cover the deposit of another order
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "130usd"))
+	require.ErrorIs(t, err, types.ErrAccountBalanceInsufficient)
+
+	// Orders that do not rest are not charged
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("130usd"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	// The deposit is refunded when the order is filled
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.True(t, deposits().IsZero())
+	require.Equal(t, coins("9900eur,15chf,120usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	// ... and when it is canceled
+	o = order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Equal(t, sdk.NewInt(10), deposits())
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+	require.True(t, deposits().IsZero())
+	require.Equal(t, coins("9900eur,15chf,120usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	// Changing the deposit does not affect the refund of resting orders
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	params.OrderDeposit = coins("5chf")
+	k.SetParams(ctx, params)
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+	require.Equal(t, coins("9900eur,15chf,120usd"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	res, err := k.ByAccount(sdk.WrapSDKContext(ctx), &types.QueryByAccountRequest{Address: acc1.GetAddress().String()})
+	require.NoError(t, err)
+	require.Equal(t, coins("5chf"), res.OrderDeposit)
+}
+
+func TestOrderDepositRefundedOnExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "100eur,10chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10usd")
+
+	params := types.DefaultParams()
+	params.OrderDeposit = coins("10chf")
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.Equal(t, coins("100eur"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	// Spending the source balance expires the order and refunds the deposit
+	err := bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("100eur"))
+	require.NoError(t, err)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Equal(t, coins("10chf"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+}
+
+func TestOrderDepositCoversInstrumentDemand(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "200eur")
+	createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	params := types.DefaultParams()
+	params.OrderDeposit = coins("10eur")
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "90eur", "100usd")))
+	require.Equal(t, coins("190eur"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	// The balance covers both orders, but not the deposit on top of them
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "95eur", "100usd"))
+	require.ErrorIs(t, err, types.ErrAccountBalanceInsufficient)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "90eur", "100usd")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+}
+
+func TestStopOrderLimits(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd,10000chf")
+
+	// Establish a last price of 1 usd per eur
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000usd", "1000eur")))
+
+	params := types.DefaultParams()
+	params.MaxOpenOrders = 3
+	params.MaxOpenOrdersPerInstrument = 2
+	k.SetParams(ctx, params)
+
+	// Pending stop orders count towards the limits of both orders and stop orders
+	require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90usd", "0.95", "stop-1")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "130usd"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90usd", "0.9", "stop-2"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120chf")))
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90chf", "0.9", "stop-3"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	// Canceling a stop order makes room for another
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), "stop-1"))
+	require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90chf", "0.9", "stop-3")))
+}
+
+func TestStopOrderDeposit(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur,20chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10chf")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd,10chf")
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	deposits := func() sdk.Int {
+		return bk.GetAllBalances(ctx, moduleAddr).AmountOf("chf").Sub(sdk.OneInt())
+	}
+
+	// Establish a last price of 1 usd per eur
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000usd", "1000eur")))
+
+	params := types.DefaultParams()
+	params.OrderDeposit = coins("10chf")
+	k.SetParams(ctx, params)
+
+	// Stop orders are charged the deposit when accepted
+	require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "0.95", "stop-1")))
+	require.Equal(t, sdk.NewInt(10), deposits())
+	require.Equal(t, coins("1000eur,10chf"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+	require.Equal(t, coins("10chf"), k.GetStopOrdersByOwner(ctx, acc1.GetAddress())[0].Deposit)
+
+	stopMarket, err := types.NewStopMarketOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, "eur", coin("500usd"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.92"), acc1.GetAddress(), "stop-market")
+	require.NoError(t, err)
+	require.NoError(t, k.NewStopOrder(ctx, stopMarket))
+	require.Equal(t, sdk.NewInt(20), deposits())
+
+	// The account cannot cover the deposit of another stop order
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "90usd", "0.9", "stop-2"))
+	require.ErrorIs(t, err, types.ErrAccountBalanceInsufficient)
+
+	// The deposit is refunded when the stop order is canceled
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), "stop-market"))
+	require.Equal(t, sdk.NewInt(10), deposits())
+	require.Equal(t, coins("1000eur,10chf"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+
+	// ... and when it is triggered, after which the order placed in the book is charged its own
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "900usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "900usd", "1000eur")))
+	k.ActivateTriggeredStopOrders(ctx)
+
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, coins("10chf"), orders[0].Deposit)
+	require.Equal(t, sdk.NewInt(10), deposits())
+
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), "stop-1"))
+	require.True(t, deposits().IsZero())
+	require.Equal(t, coins("1000eur,20chf"), bk.GetAllBalances(ctx, acc1.GetAddress()))
+}
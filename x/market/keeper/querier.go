@@ -74,7 +74,18 @@ func queryByAccount(ctx sdk.Context, k *Keeper, path []string, req abci.RequestQ
 			return orders[i].ID < orders[j].ID
 		})
 
-	resp := types.QueryByAccountResponse{Orders: orders}
+	stopOrders := k.GetStopOrdersByOwner(ctx, account)
+
+	params := k.GetParams(ctx)
+
+	resp := types.QueryByAccountResponse{
+		Orders:                     orders,
+		StopOrders:                 stopOrders,
+		SelfTradePrevention:        k.GetSelfTradePrevention(ctx, account),
+		MaxOpenOrders:              params.MaxOpenOrders,
+		MaxOpenOrdersPerInstrument: params.MaxOpenOrdersPerInstrument,
+		OrderDeposit:               params.OrderDeposit,
+	}
 	return json.Marshal(resp)
 }
 
@@ -117,6 +128,7 @@ func queryInstrument(ctx sdk.Context, k *Keeper, path []string, req abci.Request
 		Source:      source,
 		Destination: destination,
 		Orders:      orders,
+		HaltedUntil: k.getHaltedUntil(ctx, source, destination),
 	}
 
 	return json.Marshal(resp)
@@ -0,0 +1,141 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetSelfTradePrevention sets the self-trade prevention used by the account's orders that do not specify one.
+func (k Keeper) SetSelfTradePrevention(ctx sdk.Context, owner sdk.AccAddress, mode types.SelfTradePrevention) error {
+	if err := types.ValidateSelfTradePrevention(mode); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	key := types.GetSelfTradePreventionKey(owner.String())
+
+	if mode == types.SelfTradePrevention_Unspecified {
+		store.Delete(key)
+		return nil
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(mode)))
+	return nil
+}
+
+// GetSelfTradePrevention returns the account's default self-trade prevention.
+func (k Keeper) GetSelfTradePrevention(ctx sdk.Context, owner sdk.AccAddress) types.SelfTradePrevention {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.GetSelfTradePreventionKey(owner.String()))
+	if bz == nil {
+		return types.SelfTradePrevention_Unspecified
+	}
+
+	return types.SelfTradePrevention(sdk.BigEndianToUint64(bz))
+}
+
+// Orders without a self-trade prevention use the account default. Self-trades are allowed if neither is set.
+func (k Keeper) effectiveSelfTradePrevention(ctx sdk.Context, order types.Order) types.SelfTradePrevention {
+	mode := order.SelfTradePrevention
+	if mode == types.SelfTradePrevention_Unspecified {
+		if owner, err := sdk.AccAddressFromBech32(order.Owner); err == nil {
+			mode = k.GetSelfTradePrevention(ctx, owner)
+		}
+	}
+
+	if mode == types.SelfTradePrevention_Unspecified {
+		return types.SelfTradePrevention_None
+	}
+
+	return mode
+}
+
+// preventSelfTrade applies the self-trade prevention of the aggressive order to a step of the execution plan that would
+// trade with resting orders of the same owner. No trade takes place. Returns true if the aggressive order is cancelled.
+func (k *Keeper) preventSelfTrade(ctx sdk.Context, mode types.SelfTradePrevention, aggressiveOrder *types.Order, plan types.ExecutionPlan, stepDestinationFilled sdk.Dec) bool {
+	selfOrders := selfTradeOrders(plan, aggressiveOrder.Owner)
+
+	switch mode {
+	case types.SelfTradePrevention_CancelNewest:
+		k.emitSelfTradeEvent(ctx, mode, *aggressiveOrder, *selfOrders[0])
+		return true
+
+	case types.SelfTradePrevention_CancelOldest, types.SelfTradePrevention_CancelBoth:
+		for _, o := range selfOrders {
+			k.emitSelfTradeEvent(ctx, mode, *aggressiveOrder, *o)
+			k.cancelRestingOrder(ctx, o)
+		}
+		return mode == types.SelfTradePrevention_CancelBoth
+
+	case types.SelfTradePrevention_DecrementAndCancel:
+		if plan.SecondOrder != nil {
+			// Quantities of a synthetic plan cannot be matched between the orders, so the resting orders are cancelled.
+			for _, o := range selfOrders {
+				k.emitSelfTradeEvent(ctx, mode, *aggressiveOrder, *o)
+				k.cancelRestingOrder(ctx, o)
+			}
+			return false
+		}
+
+		// Same quantities as the trade that is prevented. All variables are named from the perspective of the passive order.
+		passiveOrder := plan.FirstOrder
+		stepSourceFilled := stepDestinationFilled.Quo(passiveOrder.Price())
+		if stepSourceFilled.LT(sdk.OneDec()) {
+			stepSourceFilled = sdk.OneDec()
+		}
+		stepSourceFilled = sdk.MinDec(stepSourceFilled, aggressiveOrder.Destination.Amount.Sub(aggressiveOrder.DestinationFilled).ToDec())
+
+		k.emitSelfTradeEvent(ctx, mode, *aggressiveOrder, *passiveOrder)
+
+		// The priority key depends on the price of the order, so remove it before the order is changed.
+		k.deleteOrder(ctx, passiveOrder)
+		if decrementOrder(passiveOrder, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt()) {
+			k.emitCloseEvent(ctx, *passiveOrder, types.OrderState_Cancelled)
+			k.recordTerminalOrder(ctx, *passiveOrder, types.OrderState_Cancelled)
+			k.refundDeposit(ctx, passiveOrder)
+		} else {
+			k.emitUpdateEvent(ctx, *passiveOrder)
+			k.setOrder(ctx, passiveOrder)
+		}
+
+		return decrementOrder(aggressiveOrder, stepDestinationFilled.RoundInt(), stepSourceFilled.RoundInt())
+	}
+
+	return false
+}
+
+func (k *Keeper) cancelRestingOrder(ctx sdk.Context, order *types.Order) {
+	k.emitCloseEvent(ctx, *order, types.OrderState_Cancelled)
+	k.deleteOrder(ctx, order)
+	k.recordTerminalOrder(ctx, *order, types.OrderState_Cancelled)
+	k.refundDeposit(ctx, order)
+}
+
+// decrementOrder reduces the remaining quantity of the order without trading it. Returns true if nothing remains of the
+// order, in which case it is left unchanged.
+func decrementOrder(order *types.Order, source, destination sdk.Int) bool {
+	if source.GTE(order.SourceRemaining) || destination.GTE(order.Destination.Amount.Sub(order.DestinationFilled)) {
+		return true
+	}
+
+	order.Source.Amount = order.Source.Amount.Sub(source)
+	order.Destination.Amount = order.Destination.Amount.Sub(destination)
+	order.SourceRemaining = order.SourceRemaining.Sub(source)
+
+	return order.IsFilled()
+}
+
+func selfTradeOrders(plan types.ExecutionPlan, owner string) (res []*types.Order) {
+	for _, o := range []*types.Order{plan.SecondOrder, plan.FirstOrder} {
+		if o != nil && o.Owner == owner {
+			res = append(res, o)
+		}
+	}
+
+	return
+}
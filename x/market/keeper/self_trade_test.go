@@ -0,0 +1,149 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfTradeAllowedByDefault(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100usd", "100eur")))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.NotNil(t, k.GetInstrument(ctx, "eur", "usd").LastPrice)
+}
+
+func TestSelfTradePrevention(t *testing.T) {
+	specs := map[string]struct {
+		mode            types.SelfTradePrevention
+		event           string
+		expResting      bool
+		expAggressive   bool
+		expOtherMatched bool
+	}{
+		"cancel newest": {
+			mode:       types.SelfTradePrevention_CancelNewest,
+			event:      "self_trade_cancel_newest",
+			expResting: true,
+		},
+		"cancel oldest": {
+			mode:            types.SelfTradePrevention_CancelOldest,
+			event:           "self_trade_cancel_oldest",
+			expOtherMatched: true,
+		},
+		"cancel both": {
+			mode:  types.SelfTradePrevention_CancelBoth,
+			event: "self_trade_cancel_both",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, ak, bk := createTestComponents(t)
+
+			acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+			acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+			resting := order(ctx.BlockTime(), acc1, "100eur", "100usd")
+			require.NoError(t, k.NewOrderSingle(ctx, resting))
+			require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "110usd")))
+
+			aggressive := selfTradeOrder(ctx, acc1, "110usd", "100eur", spec.mode)
+			require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+
+			require.True(t, findEventAttr(ctx, spec.event))
+			require.Equal(t, spec.expResting, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), resting.ClientOrderID) != nil)
+			require.Equal(t, spec.expAggressive, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), aggressive.ClientOrderID) != nil)
+			require.Equal(t, spec.expOtherMatched, k.GetOrdersByOwner(ctx, acc2.GetAddress()) == nil)
+
+			// Only the trade with the other account is registered
+			if spec.expOtherMatched {
+				require.True(t, epsEqual(sdk.MustNewDecFromStr("1.1"), *k.GetInstrument(ctx, "eur", "usd").LastPrice))
+			} else {
+				require.Nil(t, k.GetInstrument(ctx, "eur", "usd").LastPrice)
+			}
+
+			// No tokens were exchanged by acc1
+			balances := bk.GetAllBalances(ctx, acc1.GetAddress())
+			if spec.expOtherMatched {
+				require.Equal(t, "10100eur,9890usd", balances.String())
+			} else {
+				require.Equal(t, "10000eur,10000usd", balances.String())
+			}
+		})
+	}
+}
+
+func TestSelfTradeDecrementAndCancel(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	resting := order(ctx.BlockTime(), acc1, "100eur", "100usd")
+	require.NoError(t, k.NewOrderSingle(ctx, resting))
+
+	aggressive := selfTradeOrder(ctx, acc1, "60usd", "60eur", types.SelfTradePrevention_DecrementAndCancel)
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+	require.True(t, findEventAttr(ctx, "self_trade_decrement"))
+
+	// The smaller order is cancelled and the larger reduced by its quantity
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), aggressive.ClientOrderID))
+
+	remaining := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), resting.ClientOrderID)
+	require.NotNil(t, remaining)
+	require.Equal(t, coin("40eur"), remaining.Source)
+	require.Equal(t, coin("40usd"), remaining.Destination)
+	require.Equal(t, sdk.NewInt(40), remaining.SourceRemaining)
+	require.True(t, remaining.SourceFilled.IsZero())
+
+	require.Nil(t, k.GetInstrument(ctx, "eur", "usd").LastPrice)
+	require.Equal(t, "10000eur,10000usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+
+	// The reduced order remains in the book at its original price
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "40usd", "40eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestSelfTradePreventionAccountDefault(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	require.Equal(t, types.SelfTradePrevention_Unspecified, k.GetSelfTradePrevention(ctx, acc1.GetAddress()))
+	require.NoError(t, k.SetSelfTradePrevention(ctx, acc1.GetAddress(), types.SelfTradePrevention_CancelNewest))
+	require.Equal(t, types.SelfTradePrevention_CancelNewest, k.GetSelfTradePrevention(ctx, acc1.GetAddress()))
+	require.ErrorIs(t, k.SetSelfTradePrevention(ctx, acc1.GetAddress(), types.SelfTradePrevention(100)), types.ErrUnknownSelfTradePrevention)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+
+	// Orders without a self-trade prevention use the account default
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100usd", "100eur")))
+	require.True(t, findEventAttr(ctx, "self_trade_cancel_newest"))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	// ... unless the order overrides it
+	require.NoError(t, k.NewOrderSingle(ctx, selfTradeOrder(ctx, acc1, "100usd", "100eur", types.SelfTradePrevention_None)))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.NotNil(t, k.GetInstrument(ctx, "eur", "usd").LastPrice)
+
+	require.NoError(t, k.SetSelfTradePrevention(ctx, acc1.GetAddress(), types.SelfTradePrevention_Unspecified))
+	require.Equal(t, types.SelfTradePrevention_Unspecified, k.GetSelfTradePrevention(ctx, acc1.GetAddress()))
+}
+
+func selfTradeOrder(ctx sdk.Context, account authtypes.AccountI, src, dst string, mode types.SelfTradePrevention) types.Order {
+	o := order(ctx.BlockTime(), account, src, dst)
+	o.SelfTradePrevention = mode
+	return o
+}
@@ -0,0 +1,328 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Limits the number of triggered stop orders placed in the order book per block. Remaining triggered orders are placed
+// in the following blocks in the order they were accepted.
+const maxTriggeredStopOrdersPerBlock = 50
+
+// NewStopOrder accepts a stop order, which is placed in the order book once the last traded price of its instrument
+// falls to the trigger price.
+func (k *Keeper) NewStopOrder(ctx sdk.Context, order types.StopOrder) error {
+	// Use a fixed gas amount
+	ctx.GasMeter().ConsumeGas(gasPriceNewOrder, "NewStopOrder")
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	if err := order.IsValid(); err != nil {
+		return err
+	}
+
+	if listing := k.GetListing(ctx, order.Source.Denom, order.Destination.Denom); listing != nil {
+		if err := order.IsValidForInstrument(*listing); err != nil {
+			return err
+		}
+	}
+
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	// Pending stop orders are subject to the open order limits and deposit of the account like orders in the book
+	params := k.GetParams(ctx)
+	required := params.OrderDeposit
+	if !order.IsStopMarket() {
+		required = required.Add(order.Source)
+	}
+
+	spendableCoins := k.bk.SpendableCoins(ctx, owner)
+	if _, anyNegative := spendableCoins.SafeSub(required); anyNegative {
+		return sdkerrors.Wrapf(
+			types.ErrAccountBalanceInsufficient,
+			"Account %v has insufficient balance to place stop order: %v < %v",
+			owner,
+			spendableCoins,
+			required,
+		)
+	}
+
+	if k.clientOrderIdInUse(ctx, order.Owner, order.ClientOrderID) {
+		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, order.ClientOrderID)
+	}
+
+	if err := checkOpenOrderLimits(params, k.GetOrdersByOwner(ctx, owner), k.GetStopOrdersByOwner(ctx, owner), order.Source.Denom, order.Destination.Denom); err != nil {
+		return err
+	}
+
+	if !k.assetExists(ctx, order.Destination) {
+		return sdkerrors.Wrap(types.ErrUnknownAsset, order.Destination.Denom)
+	}
+
+	// A stop order is triggered by a trade crossing the trigger price, so it cannot already be crossed.
+	md := k.GetInstrument(ctx, order.Source.Denom, order.Destination.Denom)
+	if md != nil && md.LastPrice != nil && md.LastPrice.LTE(order.TriggerPrice) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidTriggerPrice,
+			"last price %v of %v/%v is already at or below the trigger price %v",
+			md.LastPrice, order.Source.Denom, order.Destination.Denom, order.TriggerPrice,
+		)
+	}
+
+	if crossed, found := k.getStopCrossedPrice(ctx, order.Source.Denom, order.Destination.Denom); found && crossed.LTE(order.TriggerPrice) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidTriggerPrice,
+			"%v/%v has traded at %v in this block, at or below the trigger price %v",
+			order.Source.Denom, order.Destination.Denom, crossed, order.TriggerPrice,
+		)
+	}
+
+	order.ID = k.getNextOrderNumber(ctx)
+	order.Triggered = false
+	order.Deposit = params.OrderDeposit
+	k.emitStopAcceptEvent(ctx, order)
+
+	k.setStopOrder(ctx, &order)
+	if err := k.chargeStopDeposit(ctx, &order); err != nil {
+		return err
+	}
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Set(
+		types.GetStopTriggerKey(order.Source.Denom, order.Destination.Denom, order.TriggerPrice, order.ID),
+		types.GetStopOwnerKey(order.Owner, order.ClientOrderID),
+	)
+
+	return nil
+}
+
+// recordStopTrigger keeps the lowest price the instrument traded at until the stop orders it crosses are triggered
+// in EndBlock. Matching only updates this record, so that its cost does not depend on the number of stop orders.
+func (k Keeper) recordStopTrigger(ctx sdk.Context, src, dst string, price sdk.Dec) {
+	if crossed, found := k.getStopCrossedPrice(ctx, src, dst); found && crossed.LTE(price) {
+		return
+	}
+
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.keyIndices).Set(types.GetStopCrossedKey(src, dst), bz)
+}
+
+func (k Keeper) getStopCrossedPrice(ctx sdk.Context, src, dst string) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.keyIndices).Get(types.GetStopCrossedKey(src, dst))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	var price sdk.Dec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price, true
+}
+
+// triggerStopOrders queues at most limit stop orders with a trigger price at or above the lowest price their
+// instrument traded at. Instruments with more crossed stop orders are continued in the following blocks.
+func (k Keeper) triggerStopOrders(ctx sdk.Context, limit int) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	var crossedKeys, crossedPrices [][]byte
+	crossedIt := sdk.KVStorePrefixIterator(idxStore, types.GetStopCrossedPrefix())
+	for ; crossedIt.Valid(); crossedIt.Next() {
+		crossedKeys = append(crossedKeys, crossedIt.Key())
+		crossedPrices = append(crossedPrices, crossedIt.Value())
+	}
+	crossedIt.Close()
+
+	for i, crossedKey := range crossedKeys {
+		src, dst, err := types.ParseStopCrossedKey(crossedKey)
+		if err != nil {
+			panic(err)
+		}
+
+		var price sdk.Dec
+		if err := price.Unmarshal(crossedPrices[i]); err != nil {
+			panic(err)
+		}
+
+		start := types.GetStopTriggerKey(src, dst, price, 0)
+		end := sdk.PrefixEndBytes(types.GetStopTriggerKeyByInstrument(src, dst))
+
+		var triggerKeys, ownerKeys [][]byte
+		it := idxStore.Iterator(start, end)
+		for ; it.Valid() && len(triggerKeys) < limit; it.Next() {
+			triggerKeys = append(triggerKeys, it.Key())
+			ownerKeys = append(ownerKeys, it.Value())
+		}
+		exhausted := !it.Valid()
+		it.Close()
+
+		for j, ownerKey := range ownerKeys {
+			idxStore.Delete(triggerKeys[j])
+
+			order := new(types.StopOrder)
+			k.cdc.MustUnmarshal(store.Get(ownerKey), order)
+
+			order.Triggered = true
+			k.setStopOrder(ctx, order)
+			idxStore.Set(types.GetStopTriggeredKey(order.ID), ownerKey)
+
+			k.emitStopTriggerEvent(ctx, *order, price)
+		}
+
+		if exhausted {
+			idxStore.Delete(crossedKey)
+		}
+
+		limit -= len(triggerKeys)
+		if limit <= 0 {
+			return
+		}
+	}
+}
+
+// ActivateTriggeredStopOrders triggers the stop orders crossed by the trades of the block and places triggered stop
+// orders in the order book, both limited per block. Orders that can no longer be placed, e.g. due to insufficient
+// balance, expire.
+func (k *Keeper) ActivateTriggeredStopOrders(ctx sdk.Context) {
+	k.triggerStopOrders(ctx, maxTriggeredStopOrdersPerBlock)
+
+	// Trades of activated orders may cross further stop orders, which are triggered in the following block.
+	for i := 0; i < maxTriggeredStopOrdersPerBlock; i++ {
+		order := k.nextTriggeredStopOrder(ctx)
+		if order == nil {
+			return
+		}
+
+		// The deposit of the stop order is returned before the order it places in the book is charged its own
+		k.deleteStopOrder(ctx, order)
+		k.refundStopDeposit(ctx, order)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.activateStopOrder(cacheCtx, *order); err != nil {
+			ctx.Logger().Info("Triggered stop order expired", "order_id", order.ID, "owner", order.Owner, "err", err)
+			k.emitStopExpireEvent(ctx, *order)
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+func (k *Keeper) activateStopOrder(ctx sdk.Context, stopOrder types.StopOrder) error {
+	owner, err := sdk.AccAddressFromBech32(stopOrder.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	source := stopOrder.Source
+	if stopOrder.IsStopMarket() {
+		source, err = k.GetSrcFromSlippage(ctx, stopOrder.Source.Denom, stopOrder.Destination, stopOrder.MaxSlippage)
+		if err != nil {
+			return err
+		}
+	}
+
+	order, err := types.NewOrder(ctx.BlockTime(), stopOrder.TimeInForce, source, stopOrder.Destination, owner, stopOrder.ClientOrderID)
+	if err != nil {
+		return err
+	}
+	order.SelfTradePrevention = stopOrder.SelfTradePrevention
+
+	return k.NewOrderSingle(ctx, order)
+}
+
+func (k Keeper) nextTriggeredStopOrder(ctx sdk.Context) *types.StopOrder {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetStopTriggeredPrefix())
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	order := new(types.StopOrder)
+	k.cdc.MustUnmarshal(ctx.KVStore(k.key).Get(it.Value()), order)
+	return order
+}
+
+func (k *Keeper) cancelStopOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) bool {
+	order := k.GetStopOrderByOwnerAndClientOrderId(ctx, owner.String(), clientOrderId)
+	if order == nil {
+		return false
+	}
+
+	k.emitStopExpireEvent(ctx, *order)
+	k.deleteStopOrder(ctx, order)
+	k.refundStopDeposit(ctx, order)
+
+	return true
+}
+
+func (k Keeper) GetStopOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientOrderId string) *types.StopOrder {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.GetStopOwnerKey(owner, clientOrderId))
+	if bz == nil {
+		return nil
+	}
+
+	o := new(types.StopOrder)
+	k.cdc.MustUnmarshal(bz, o)
+	return o
+}
+
+func (k Keeper) GetStopOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) (res []*types.StopOrder) {
+	store := ctx.KVStore(k.key)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetStopOwnerKey(owner.String(), ""))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		o := new(types.StopOrder)
+		k.cdc.MustUnmarshal(it.Value(), o)
+		res = append(res, o)
+	}
+
+	return
+}
+
+// Client order ids are unique among an account's orders in the book and its stop orders.
+func (k Keeper) clientOrderIdInUse(ctx sdk.Context, owner, clientOrderId string) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(types.GetOwnerKey(owner, clientOrderId)) || store.Has(types.GetStopOwnerKey(owner, clientOrderId))
+}
+
+func (k Keeper) setStopOrder(ctx sdk.Context, order *types.StopOrder) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.GetStopOwnerKey(order.Owner, order.ClientOrderID), k.cdc.MustMarshal(order))
+}
+
+func (k Keeper) deleteStopOrder(ctx sdk.Context, order *types.StopOrder) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	store.Delete(types.GetStopOwnerKey(order.Owner, order.ClientOrderID))
+
+	if order.Triggered {
+		idxStore.Delete(types.GetStopTriggeredKey(order.ID))
+	} else {
+		idxStore.Delete(types.GetStopTriggerKey(order.Source.Denom, order.Destination.Denom, order.TriggerPrice, order.ID))
+	}
+}
@@ -0,0 +1,206 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a last price of 1 usd per eur
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000usd", "1000eur")))
+
+	stopLimit := stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "0.95", "stop-limit")
+	require.NoError(t, k.NewStopOrder(ctx, stopLimit))
+
+	stopMarket, err := types.NewStopMarketOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, "eur", coin("500usd"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.92"), acc1.GetAddress(), "stop-market")
+	require.NoError(t, err)
+	require.NoError(t, k.NewStopOrder(ctx, stopMarket))
+
+	// Stop orders cannot be triggered by the current price
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "1", "at-last-price"))
+	require.ErrorIs(t, err, types.ErrInvalidTriggerPrice)
+
+	// Client order ids are shared with the order book
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "0.9", "stop-limit"))
+	require.ErrorIs(t, err, types.ErrNonUniqueClientOrderId)
+	o, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("100eur"), coin("200usd"), acc1.GetAddress(), "stop-market")
+	require.NoError(t, err)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, o), types.ErrNonUniqueClientOrderId)
+
+	stopOrders := k.GetStopOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, stopOrders, 2)
+	for _, so := range stopOrders {
+		require.False(t, so.Triggered)
+	}
+
+	// A trade at 0.96 does not cross either trigger price
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "960usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "960usd", "1000eur")))
+	k.ActivateTriggeredStopOrders(ctx)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 0)
+
+	// A trade at 0.9 crosses both, which are triggered in EndBlock
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "900usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "900usd", "1000eur")))
+
+	stopOrders = k.GetStopOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, stopOrders, 2)
+	for _, so := range stopOrders {
+		require.False(t, so.Triggered)
+	}
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 0)
+
+	// The price has been crossed, even if the last price is back above the trigger price
+	err = k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "0.91", "crossed"))
+	require.ErrorIs(t, err, types.ErrInvalidTriggerPrice)
+
+	k.ActivateTriggeredStopOrders(ctx)
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 2)
+
+	activated := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), "stop-limit")
+	require.NotNil(t, activated)
+	require.Equal(t, coin("1000eur"), activated.Source)
+	require.Equal(t, coin("900usd"), activated.Destination)
+
+	// Source amount of the stop-market order is derived from the last price of 0.9 and the slippage
+	activated = k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), "stop-market")
+	require.NotNil(t, activated)
+	require.Equal(t, coin("611eur"), activated.Source)
+}
+
+func TestCancelStopOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "0.95", "stop")))
+	require.Len(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), "stop"))
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+	require.True(t, findEventAttr(ctx, "expire_stop"))
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetStopTriggerKeyByInstrument("eur", "usd"))
+	require.False(t, it.Valid())
+	it.Close()
+
+	require.ErrorIs(t, k.CancelOrder(ctx, acc1.GetAddress(), "stop"), types.ErrClientOrderIdNotFound)
+}
+
+func TestTriggeredStopOrdersPerBlock(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "100000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	total := maxTriggeredStopOrdersPerBlock + 10
+	for i := 0; i < total; i++ {
+		require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "200usd", "0.95", fmt.Sprintf("stop-%d", i))))
+	}
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "900usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "900usd", "1000eur")))
+
+	k.ActivateTriggeredStopOrders(ctx)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), maxTriggeredStopOrdersPerBlock)
+	require.Len(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()), total-maxTriggeredStopOrdersPerBlock)
+
+	k.ActivateTriggeredStopOrders(ctx)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), total)
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestStopOrdersTriggeredPerBlock(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "100000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	total := 2*maxTriggeredStopOrdersPerBlock + 10
+	for i := 0; i < total; i++ {
+		require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "100eur", "200usd", "0.95", fmt.Sprintf("stop-%d", i))))
+	}
+
+	// Matching does not touch the stop orders crossed by the trade
+	gasMeter := sdk.NewGasMeter(1_000_000)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "900usd")))
+	require.NoError(t, k.NewOrderSingle(ctx.WithGasMeter(gasMeter), order(ctx.BlockTime(), acc3, "900usd", "1000eur")))
+	require.Equal(t, sdk.Gas(gasPriceNewOrder), gasMeter.GasConsumed())
+	for _, so := range k.GetStopOrdersByOwner(ctx, acc1.GetAddress()) {
+		require.False(t, so.Triggered)
+	}
+
+	for block := 1; block <= 3; block++ {
+		k.ActivateTriggeredStopOrders(ctx)
+
+		var triggered int
+		for _, so := range k.GetStopOrdersByOwner(ctx, acc1.GetAddress()) {
+			if so.Triggered {
+				triggered++
+			}
+		}
+		require.Zero(t, triggered)
+
+		expActivated := block * maxTriggeredStopOrdersPerBlock
+		if expActivated > total {
+			expActivated = total
+		}
+		require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), expActivated)
+	}
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	require.Nil(t, idxStore.Get(types.GetStopCrossedKey("eur", "usd")))
+}
+
+func TestTriggeredStopOrderExpires(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewStopOrder(ctx, stopLimitOrder(t, ctx, acc1, "1000eur", "900usd", "0.95", "stop")))
+
+	// Balance is spent before the order is triggered
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("1000eur")))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "900usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "900usd", "1000eur")))
+
+	k.ActivateTriggeredStopOrders(ctx)
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.True(t, findEventAttr(ctx, "expire_stop"))
+}
+
+func stopLimitOrder(t *testing.T, ctx sdk.Context, account authtypes.AccountI, src, dst, trigger, clientOrderId string) types.StopOrder {
+	o, err := types.NewStopLimitOrder(
+		ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin(src), coin(dst),
+		sdk.MustNewDecFromStr(trigger), account.GetAddress(), clientOrderId,
+	)
+	require.NoError(t, err)
+	return o
+}
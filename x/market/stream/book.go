@@ -0,0 +1,98 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package stream
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// book is the aggregated order book of an instrument, keyed by price.
+type book struct {
+	source      string
+	destination string
+	sequence    uint64
+	levels      map[string]types.PriceLevel
+}
+
+func newBook(src, dst string) *book {
+	return &book{
+		source:      src,
+		destination: dst,
+		levels:      make(map[string]types.PriceLevel),
+	}
+}
+
+// aggregate sums the remaining source amount of the orders at each price.
+func aggregate(orders []*types.Order) map[string]types.PriceLevel {
+	levels := make(map[string]types.PriceLevel)
+
+	for _, order := range orders {
+		price := order.Price()
+
+		level, found := levels[price.String()]
+		if !found {
+			level = types.PriceLevel{Price: price, Quantity: sdk.ZeroInt()}
+		}
+
+		level.Quantity = level.Quantity.Add(order.SourceRemaining)
+		level.Orders++
+		levels[price.String()] = level
+	}
+
+	return levels
+}
+
+// update replaces the levels of the book and returns the levels that changed, with a zero quantity for those removed.
+// The sequence number is incremented if anything changed.
+func (b *book) update(levels map[string]types.PriceLevel) []types.PriceLevel {
+	var changed []types.PriceLevel
+
+	for key, level := range levels {
+		prev, found := b.levels[key]
+		if !found || !prev.Quantity.Equal(level.Quantity) || prev.Orders != level.Orders {
+			changed = append(changed, level)
+		}
+	}
+
+	for key, prev := range b.levels {
+		if _, found := levels[key]; !found {
+			changed = append(changed, types.PriceLevel{Price: prev.Price, Quantity: sdk.ZeroInt()})
+		}
+	}
+
+	b.levels = levels
+	if len(changed) > 0 {
+		b.sequence++
+	}
+
+	sortLevels(changed)
+	return changed
+}
+
+func (b book) snapshot(height int64) types.BookSnapshot {
+	levels := make([]types.PriceLevel, 0, len(b.levels))
+	for _, level := range b.levels {
+		levels = append(levels, level)
+	}
+	sortLevels(levels)
+
+	return types.BookSnapshot{
+		Source:      b.source,
+		Destination: b.destination,
+		Sequence:    b.sequence,
+		Height:      height,
+		Levels:      levels,
+	}
+}
+
+// Levels are ordered by price like the orders of the instrument, i.e. best price first.
+func sortLevels(levels []types.PriceLevel) {
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Price.LT(levels[j].Price)
+	})
+}
@@ -0,0 +1,382 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// FlagEnable is the app.toml setting that enables the market data streaming service.
+	FlagEnable = "market-stream.enable"
+	// FlagSubscriberBuffer is the app.toml setting for the number of messages buffered for each subscriber.
+	FlagSubscriberBuffer = "market-stream.subscriber-buffer"
+
+	DefaultSubscriberBuffer = 1000
+)
+
+// Typed market events are named after their proto message.
+const typedEventPrefix = "em.market.v1."
+
+var (
+	errSlowSubscriber = status.Error(codes.ResourceExhausted, "subscriber did not keep up with the updates")
+	errServiceClosed  = status.Error(codes.Unavailable, "market data streaming service closed")
+)
+
+// OrderBookReader reads the order books of the market from committed state.
+type OrderBookReader interface {
+	GetInstruments(ctx sdk.Context) []types.MarketData
+	GetInstrumentOrders(ctx sdk.Context, src, dst string) []*types.Order
+}
+
+// Service maintains the aggregated order book of each instrument from the market events of committed blocks, and
+// streams their updates to subscribers. The service is node-local and does not affect consensus.
+//
+// Events only identify the denominations of the orders that changed in a block. Once the block is committed, the books
+// of those denominations are read from state and compared to the previous books to produce the updates.
+type Service struct {
+	reader           OrderBookReader
+	logger           log.Logger
+	subscriberBuffer int
+
+	// Denominations of the market events of the current block. Only accessed while executing blocks.
+	touched map[string]bool
+
+	mu          sync.RWMutex
+	initialized bool
+	height      int64
+	books       map[bookKey]*book
+	subscribers map[*subscriber]bool
+}
+
+type subscriber struct {
+	// Subscribed instruments, or nil for all instruments
+	instruments map[bookKey]bool
+	messages    chan *types.StreamMessage
+	err         error
+}
+
+var (
+	_ baseapp.StreamingService = (*Service)(nil)
+	_ types.StreamServer       = (*Service)(nil)
+)
+
+func NewService(reader OrderBookReader, logger log.Logger, subscriberBuffer int) *Service {
+	if subscriberBuffer <= 0 {
+		subscriberBuffer = DefaultSubscriberBuffer
+	}
+
+	return &Service{
+		reader:           reader,
+		logger:           logger.With("module", "market-stream"),
+		subscriberBuffer: subscriberBuffer,
+		touched:          make(map[string]bool),
+		books:            make(map[bookKey]*book),
+		subscribers:      make(map[*subscriber]bool),
+	}
+}
+
+func (s *Service) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.collect(res.Events)
+	return nil
+}
+
+func (s *Service) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.collect(res.Events)
+	return nil
+}
+
+func (s *Service) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.collect(res.Events)
+	return nil
+}
+
+// Listeners returns no store listeners, as the service only relies on events.
+func (s *Service) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Stream is a no-op, as updates are published when blocks are committed.
+func (s *Service) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close disconnects all subscribers.
+func (s *Service) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subscribers {
+		s.disconnect(sub, errServiceClosed)
+	}
+
+	return nil
+}
+
+// collect registers the denominations of the market events, whose books are refreshed when the block is committed.
+func (s *Service) collect(events []abci.Event) {
+	for _, event := range events {
+		if event.Type != types.EventTypeMarket && !strings.HasPrefix(event.Type, typedEventPrefix) {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeySource, types.AttributeKeyDestination, types.AttributeKeySourceRemaining,
+				types.AttributeKeySourceFilled, types.AttributeKeyDestinationFilled:
+			default:
+				continue
+			}
+
+			if denom := parseDenom(string(attr.Value)); denom != "" {
+				s.touched[denom] = true
+			}
+		}
+	}
+}
+
+// Attributes hold a denomination, a coin, or a coin as JSON in typed events.
+func parseDenom(value string) string {
+	var coin sdk.Coin
+	if err := json.Unmarshal([]byte(value), &coin); err == nil {
+		return coin.Denom
+	}
+
+	if coin, err := sdk.ParseCoinNormalized(value); err == nil {
+		return coin.Denom
+	}
+
+	if sdk.ValidateDenom(value) == nil {
+		return value
+	}
+
+	return ""
+}
+
+// Commit publishes the changes of the committed block to the books of the denominations touched by its events. The
+// orders are read from the committed state of ctx. The books of all instruments are loaded with the first block.
+func (s *Service) Commit(ctx sdk.Context) {
+	touched := s.touched
+	s.touched = make(map[string]bool)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.height = ctx.BlockHeight()
+
+	for _, md := range s.reader.GetInstruments(ctx) {
+		if s.initialized && !touched[md.Source] {
+			continue
+		}
+
+		key := instrumentKey(md.Source, md.Destination)
+		b, found := s.books[key]
+		if !found {
+			b = newBook(md.Source, md.Destination)
+			s.books[key] = b
+		}
+
+		levels := b.update(aggregate(s.reader.GetInstrumentOrders(ctx, md.Source, md.Destination)))
+		if len(levels) == 0 {
+			continue
+		}
+
+		s.publish(key, &types.StreamMessage{
+			Message: &types.StreamMessage_Update{
+				Update: &types.BookUpdate{
+					Source:      b.source,
+					Destination: b.destination,
+					Sequence:    b.sequence,
+					Height:      s.height,
+					Levels:      levels,
+				},
+			},
+		})
+	}
+
+	s.initialized = true
+}
+
+// publish sends the message to the subscribers of the instrument. Subscribers whose buffer is full are disconnected, so
+// that the consensus process never waits for them.
+func (s *Service) publish(key bookKey, msg *types.StreamMessage) {
+	for sub := range s.subscribers {
+		if sub.instruments != nil && !sub.instruments[key] {
+			continue
+		}
+
+		select {
+		case sub.messages <- msg:
+		default:
+			s.logger.Debug("disconnecting slow subscriber", "instrument", key)
+			s.disconnect(sub, errSlowSubscriber)
+		}
+	}
+}
+
+func (s *Service) disconnect(sub *subscriber, err error) {
+	sub.err = err
+	close(sub.messages)
+	delete(s.subscribers, sub)
+}
+
+// Snapshot implements the Stream gRPC service.
+func (s *Service) Snapshot(_ context.Context, req *types.StreamSnapshotRequest) (*types.BookSnapshot, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateInstrument(req.Source, req.Destination); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	snapshot := s.snapshot(req.Source, req.Destination)
+	return &snapshot, nil
+}
+
+// Subscribe implements the Stream gRPC service.
+func (s *Service) Subscribe(req *types.StreamSubscribeRequest, stream types.Stream_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sub, snapshots, err := s.subscribe(req.Instruments)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.serve(stream.Context(), sub, snapshots, stream.Send)
+}
+
+// subscribe registers a subscriber of the instruments and returns their current books, from which the subscriber's
+// updates follow.
+func (s *Service) subscribe(instruments []types.StreamInstrument) (*subscriber, []types.BookSnapshot, error) {
+	sub := &subscriber{messages: make(chan *types.StreamMessage, s.subscriberBuffer)}
+
+	if len(instruments) > 0 {
+		sub.instruments = make(map[bookKey]bool)
+	}
+	for _, instrument := range instruments {
+		if err := validateInstrument(instrument.Source, instrument.Destination); err != nil {
+			return nil, nil, err
+		}
+
+		sub.instruments[instrumentKey(instrument.Source, instrument.Destination)] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var snapshots []types.BookSnapshot
+	if sub.instruments == nil {
+		for _, b := range s.books {
+			snapshots = append(snapshots, b.snapshot(s.height))
+		}
+	} else {
+		for _, instrument := range instruments {
+			snapshots = append(snapshots, s.snapshot(instrument.Source, instrument.Destination))
+		}
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].Source != snapshots[j].Source {
+			return snapshots[i].Source < snapshots[j].Source
+		}
+
+		return snapshots[i].Destination < snapshots[j].Destination
+	})
+
+	s.subscribers[sub] = true
+	return sub, snapshots, nil
+}
+
+func (s *Service) unsubscribe(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.subscribers[sub] {
+		s.disconnect(sub, nil)
+	}
+}
+
+// serve sends the snapshots followed by the updates of the subscriber until it is disconnected or ctx is done.
+func (s *Service) serve(ctx context.Context, sub *subscriber, snapshots []types.BookSnapshot, send func(*types.StreamMessage) error) error {
+	defer s.unsubscribe(sub)
+
+	for i := range snapshots {
+		if err := send(&types.StreamMessage{Message: &types.StreamMessage_Snapshot{Snapshot: &snapshots[i]}}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-sub.messages:
+			if !ok {
+				// The error is set before the channel is closed
+				return sub.err
+			}
+
+			if err := send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// snapshot returns the book of the instrument. Instruments without a book have no orders.
+func (s *Service) snapshot(src, dst string) types.BookSnapshot {
+	b, found := s.books[instrumentKey(src, dst)]
+	if !found {
+		b = newBook(src, dst)
+	}
+
+	return b.snapshot(s.height)
+}
+
+func validateInstrument(src, dst string) error {
+	if err := sdk.ValidateDenom(src); err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(dst); err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	if src == dst {
+		return errors.New("source and destination are the same")
+	}
+
+	return nil
+}
+
+// bookKey identifies the book of an instrument. Denominations are kept apart, as IBC denominations contain "/".
+type bookKey struct {
+	src, dst string
+}
+
+func instrumentKey(src, dst string) bookKey {
+	return bookKey{src: src, dst: dst}
+}
@@ -0,0 +1,305 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package stream
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBookUpdate(t *testing.T) {
+	b := newBook("eur", "usd")
+
+	levels := b.update(aggregate([]*types.Order{
+		order("100eur", "120usd"),
+		order("50eur", "60usd"),
+		order("100eur", "110usd"),
+	}))
+	require.Equal(t, uint64(1), b.sequence)
+	require.Len(t, levels, 2)
+	requireLevel(t, levels[0], "1.1", 100, 1)
+	requireLevel(t, levels[1], "1.2", 150, 2)
+
+	// Unchanged levels are not reported, removed levels have no quantity
+	levels = b.update(aggregate([]*types.Order{
+		order("100eur", "120usd"),
+		order("50eur", "60usd"),
+		order("10eur", "13usd"),
+	}))
+	require.Equal(t, uint64(2), b.sequence)
+	require.Len(t, levels, 2)
+	requireLevel(t, levels[0], "1.1", 0, 0)
+	requireLevel(t, levels[1], "1.3", 10, 1)
+
+	// No changes, no new sequence number
+	require.Empty(t, b.update(aggregate([]*types.Order{
+		order("50eur", "60usd"),
+		order("100eur", "120usd"),
+		order("10eur", "13usd"),
+	})))
+	require.Equal(t, uint64(2), b.sequence)
+
+	snapshot := b.snapshot(7)
+	require.Equal(t, uint64(2), snapshot.Sequence)
+	require.Equal(t, int64(7), snapshot.Height)
+	require.Len(t, snapshot.Levels, 2)
+	requireLevel(t, snapshot.Levels[0], "1.2", 150, 2)
+	requireLevel(t, snapshot.Levels[1], "1.3", 10, 1)
+}
+
+func TestCommit(t *testing.T) {
+	reader := newFakeReader()
+	reader.set("eur", "usd", order("100eur", "120usd"))
+	reader.set("usd", "eur")
+	reader.set("chf", "usd", order("100chf", "100usd"))
+	s := NewService(reader, log.NewNopLogger(), 10)
+
+	// The books of all instruments are loaded with the first block
+	s.Commit(sdk.Context{}.WithBlockHeight(1))
+	sub, snapshots, err := s.subscribe(nil)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	require.Equal(t, "chf", snapshots[0].Source)
+	require.Equal(t, "eur", snapshots[1].Source)
+	require.Equal(t, uint64(1), snapshots[1].Sequence)
+	require.Equal(t, int64(1), snapshots[1].Height)
+	require.Len(t, snapshots[1].Levels, 1)
+	require.Equal(t, "usd", snapshots[2].Source)
+	require.Empty(t, snapshots[2].Levels)
+
+	// Books are not refreshed without market events
+	reader.set("eur", "usd", order("100eur", "120usd"), order("100eur", "130usd"))
+	s.Commit(sdk.Context{}.WithBlockHeight(2))
+	require.Empty(t, sub.messages)
+
+	// ... and only the books of the touched denominations are
+	reader.set("chf", "usd", order("50chf", "100usd"))
+	require.NoError(t, s.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{
+		Events: []abci.Event{
+			marketEvent(types.EventTypeMarket, types.AttributeKeySourceRemaining, "100eur"),
+			marketEvent("transfer", types.AttributeKeySource, "chf"),
+		},
+	}))
+	s.Commit(sdk.Context{}.WithBlockHeight(3))
+
+	require.Len(t, sub.messages, 1)
+	update := (<-sub.messages).GetUpdate()
+	require.Equal(t, "eur", update.Source)
+	require.Equal(t, uint64(2), update.Sequence)
+	require.Equal(t, int64(3), update.Height)
+	require.Len(t, update.Levels, 1)
+	requireLevel(t, update.Levels[0], "1.3", 100, 1)
+
+	// Typed events hold coins as JSON
+	require.NoError(t, s.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{}, abci.ResponseEndBlock{
+		Events: []abci.Event{
+			marketEvent("em.market.v1.EventOrderFilled", types.AttributeKeySourceFilled, `{"denom":"chf","amount":"50"}`),
+		},
+	}))
+	s.Commit(sdk.Context{}.WithBlockHeight(4))
+
+	require.Len(t, sub.messages, 1)
+	update = (<-sub.messages).GetUpdate()
+	require.Equal(t, "chf", update.Source)
+	require.Len(t, update.Levels, 2)
+	requireLevel(t, update.Levels[0], "1", 0, 0)
+	requireLevel(t, update.Levels[1], "2", 50, 1)
+
+	snapshot, err := s.Snapshot(context.Background(), &types.StreamSnapshotRequest{Source: "chf", Destination: "usd"})
+	require.NoError(t, err)
+	require.Equal(t, update.Sequence, snapshot.Sequence)
+	require.Equal(t, int64(4), snapshot.Height)
+	require.Equal(t, update.Levels[1:], snapshot.Levels)
+}
+
+func TestSubscribe(t *testing.T) {
+	reader := newFakeReader()
+	reader.set("eur", "usd", order("100eur", "120usd"))
+	reader.set("chf", "usd")
+	s := NewService(reader, log.NewNopLogger(), 1)
+	s.Commit(sdk.Context{}.WithBlockHeight(1))
+
+	_, _, err := s.subscribe([]types.StreamInstrument{{Source: "eur", Destination: "eur"}})
+	require.Error(t, err)
+	_, err = s.Snapshot(context.Background(), &types.StreamSnapshotRequest{Source: "eur"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Instruments without orders have an empty book
+	sub, snapshots, err := s.subscribe([]types.StreamInstrument{
+		{Source: "eur", Destination: "usd"},
+		{Source: "usd", Destination: "jpy"},
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Len(t, snapshots[0].Levels, 1)
+	require.Equal(t, "usd", snapshots[1].Source)
+	require.Empty(t, snapshots[1].Levels)
+
+	// Updates of other instruments are not sent
+	touch(t, s, "chf")
+	reader.set("chf", "usd", order("100chf", "100usd"))
+	s.Commit(sdk.Context{}.WithBlockHeight(2))
+	require.Empty(t, sub.messages)
+
+	// Subscribers that do not keep up are disconnected
+	for i := 3; i < 5; i++ {
+		touch(t, s, "eur")
+		reader.set("eur", "usd", order("100eur", "120usd"), order(sdk.NewInt64Coin("eur", int64(i)).String(), "120usd"))
+		s.Commit(sdk.Context{}.WithBlockHeight(int64(i)))
+	}
+
+	var updates []*types.StreamMessage
+	err = s.serve(context.Background(), sub, nil, func(msg *types.StreamMessage) error {
+		updates = append(updates, msg)
+		return nil
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Len(t, updates, 1)
+	require.Equal(t, uint64(2), updates[0].GetUpdate().Sequence)
+	require.Empty(t, s.subscribers)
+}
+
+func TestWebsocket(t *testing.T) {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	reader := newFakeReader()
+	reader.set("eur", "usd", order("100eur", "120usd"))
+	reader.set(ibcDenom, "usd", order("100"+ibcDenom, "150usd"))
+	s := NewService(reader, log.NewNopLogger(), 10)
+	s.Commit(sdk.Context{}.WithBlockHeight(1))
+
+	r := mux.NewRouter()
+	s.RegisterRoutes(r, false)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + WebsocketPath
+
+	_, res, err := websocket.DefaultDialer.Dial(url+"?source=eur", nil)
+	require.Error(t, err)
+	require.Equal(t, 400, res.StatusCode)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	read := func(conn *websocket.Conn) *types.StreamMessage {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		_, bz, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		msg := new(types.StreamMessage)
+		require.NoError(t, cdc.UnmarshalJSON(bz, msg))
+		return msg
+	}
+
+	// IBC denominations contain a slash
+	ibcConn, _, err := websocket.DefaultDialer.Dial(url+"?source="+ibcDenom+"&destination=usd", nil)
+	require.NoError(t, err)
+	defer ibcConn.Close()
+
+	snapshot := read(ibcConn).GetSnapshot()
+	require.NotNil(t, snapshot)
+	require.Equal(t, ibcDenom, snapshot.Source)
+	require.Equal(t, "usd", snapshot.Destination)
+	requireLevel(t, snapshot.Levels[0], "1.5", 100, 1)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?source=eur&destination=usd", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	snapshot = read(conn).GetSnapshot()
+	require.NotNil(t, snapshot)
+	require.Equal(t, uint64(1), snapshot.Sequence)
+	requireLevel(t, snapshot.Levels[0], "1.2", 100, 1)
+
+	touch(t, s, "eur")
+	reader.set("eur", "usd")
+	s.Commit(sdk.Context{}.WithBlockHeight(2))
+
+	update := read(conn).GetUpdate()
+	require.NotNil(t, update)
+	require.Equal(t, uint64(2), update.Sequence)
+	requireLevel(t, update.Levels[0], "1.2", 0, 0)
+
+	// Subscribers are disconnected when the node shuts down
+	require.NoError(t, s.Close())
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater))
+}
+
+type fakeReader struct {
+	instruments []types.MarketData
+	orders      map[bookKey][]*types.Order
+}
+
+func newFakeReader() *fakeReader {
+	return &fakeReader{orders: make(map[bookKey][]*types.Order)}
+}
+
+func (r *fakeReader) set(src, dst string, orders ...*types.Order) {
+	key := instrumentKey(src, dst)
+	if _, found := r.orders[key]; !found {
+		r.instruments = append(r.instruments, types.MarketData{Source: src, Destination: dst})
+	}
+
+	r.orders[key] = orders
+}
+
+func (r *fakeReader) GetInstruments(sdk.Context) []types.MarketData {
+	return r.instruments
+}
+
+func (r *fakeReader) GetInstrumentOrders(_ sdk.Context, src, dst string) []*types.Order {
+	return r.orders[instrumentKey(src, dst)]
+}
+
+func touch(t *testing.T, s *Service, denom string) {
+	require.NoError(t, s.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{
+		Events: []abci.Event{marketEvent(types.EventTypeMarket, types.AttributeKeySource, denom)},
+	}))
+}
+
+func marketEvent(eventType, key, value string) abci.Event {
+	return abci.Event{
+		Type:       eventType,
+		Attributes: []abci.EventAttribute{{Key: []byte(key), Value: []byte(value)}},
+	}
+}
+
+func order(src, dst string) *types.Order {
+	o, err := types.NewOrder(time.Now(), types.TimeInForce_GoodTillCancel, coin(src), coin(dst), sdk.AccAddress("owner"), "")
+	if err != nil {
+		panic(err)
+	}
+	return &o
+}
+
+func requireLevel(t *testing.T, level types.PriceLevel, price string, quantity int64, orders uint32) {
+	t.Helper()
+	require.True(t, sdk.MustNewDecFromStr(price).Equal(level.Price), "price %v", level.Price)
+	require.Equal(t, sdk.NewInt(quantity), level.Quantity)
+	require.Equal(t, orders, level.Orders)
+}
+
+func coin(s string) sdk.Coin {
+	coin, err := sdk.ParseCoinNormalized(s)
+	if err != nil {
+		panic(err)
+	}
+	return coin
+}
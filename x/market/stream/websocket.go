@@ -0,0 +1,98 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package stream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+// WebsocketPath is the API server route of the websocket stream.
+const WebsocketPath = "/e-money/market/v1/stream"
+
+// RegisterRoutes registers the websocket stream with the API server. Subscribed instruments are given as repeated
+// source and destination query parameters, paired in order, where none subscribes to all instruments. Messages are the
+// JSON encoding of StreamMessage. Cross-origin connections are accepted if allowAllOrigins is set.
+func (s *Service) RegisterRoutes(r *mux.Router, allowAllOrigins bool) {
+	upgrader := websocket.Upgrader{}
+	if allowAllOrigins {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+
+	r.HandleFunc(WebsocketPath, func(w http.ResponseWriter, r *http.Request) {
+		s.serveWebsocket(upgrader, w, r)
+	}).Methods(http.MethodGet)
+}
+
+func (s *Service) serveWebsocket(upgrader websocket.Upgrader, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	instruments, err := parseInstruments(query["source"], query["destination"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sub, snapshots, err := s.subscribe(instruments)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has replied to the client
+		s.unsubscribe(sub)
+		return
+	}
+	defer conn.Close()
+
+	// Reading processes the control messages of the client and detects when it goes away
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = s.serve(ctx, sub, snapshots, func(msg *types.StreamMessage) error {
+		bz, err := codec.ProtoMarshalJSON(msg, nil)
+		if err != nil {
+			return err
+		}
+
+		return conn.WriteMessage(websocket.TextMessage, bz)
+	})
+
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil {
+		closeMsg = websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error())
+	}
+	_ = conn.WriteMessage(websocket.CloseMessage, closeMsg)
+}
+
+// parseInstruments pairs the source and destination denominations in order. Denominations are not split on "/", which
+// is part of IBC denominations.
+func parseInstruments(sources, destinations []string) ([]types.StreamInstrument, error) {
+	if len(sources) != len(destinations) {
+		return nil, fmt.Errorf("%d sources do not pair with %d destinations", len(sources), len(destinations))
+	}
+
+	instruments := make([]types.StreamInstrument, len(sources))
+	for i := range sources {
+		instruments[i] = types.StreamInstrument{Source: sources[i], Destination: destinations[i]}
+	}
+
+	return instruments, nil
+}
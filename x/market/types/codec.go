@@ -28,6 +28,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMarketOrder{}, "e-money/MsgAddMarketOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelReplaceLimitOrder{}, "e-money/MsgCancelReplaceLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "e-money/MsgCancelOrder", nil)
+	cdc.RegisterConcrete(&MsgAddStopLimitOrder{}, "e-money/MsgAddStopLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgAddStopMarketOrder{}, "e-money/MsgAddStopMarketOrder", nil)
+	cdc.RegisterConcrete(&MsgSetSelfTradePrevention{}, "e-money/MsgSetSelfTradePrevention", nil)
+	cdc.RegisterConcrete(&MsgSetInstrument{}, "e-money/MsgSetInstrument", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -36,6 +40,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddMarketOrder{},
 		&MsgCancelReplaceLimitOrder{},
 		&MsgCancelOrder{},
+		&MsgAddStopLimitOrder{},
+		&MsgAddStopMarketOrder{},
+		&MsgSetSelfTradePrevention{},
+		&MsgSetInstrument{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
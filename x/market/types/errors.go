@@ -22,4 +22,12 @@ var (
 	ErrUnknownTimeInForce                      = sdkerrors.Register(ModuleName, 12, "unknown time in force value. Valid values are TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel")
 	ErrNoMarketDataAvailable                   = sdkerrors.Register(ModuleName, 13, "no market data available for instrument")
 	ErrInvalidSlippage                         = sdkerrors.Register(ModuleName, 14, "invalid slippage")
+	ErrInvalidTriggerPrice                     = sdkerrors.Register(ModuleName, 15, "invalid trigger price")
+	ErrUnknownSelfTradePrevention              = sdkerrors.Register(ModuleName, 16, "unknown self-trade prevention value")
+	ErrInvalidListing                          = sdkerrors.Register(ModuleName, 17, "invalid instrument listing")
+	ErrInstrumentNotActive                     = sdkerrors.Register(ModuleName, 18, "instrument is not active")
+	ErrInvalidOrderSize                        = sdkerrors.Register(ModuleName, 19, "invalid order size")
+	ErrInvalidTickSize                         = sdkerrors.Register(ModuleName, 20, "price does not match the tick size")
+	ErrTooManyOpenOrders                       = sdkerrors.Register(ModuleName, 21, "too many open orders")
+	ErrBatchAuctionFillOrKill                  = sdkerrors.Register(ModuleName, 22, "fill-or-kill orders cannot be placed in batch auction instruments")
 )
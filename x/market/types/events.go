@@ -10,6 +10,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 // market module event types
@@ -28,6 +29,22 @@ const (
 	AttributeKeyDestinationFilled = "destination_filled"
 	AttributeKeyAggressive        = "aggressive"
 	AttributeKeyCreated           = "created"
+	AttributeKeyTriggerPrice      = "trigger_price"
+
+	AttributeKeyRestingOrderID       = "resting_order_id"
+	AttributeKeyRestingClientOrderID = "resting_client_order_id"
+
+	AttributeKeyStatus       = "status"
+	AttributeKeyMinOrderSize = "min_order_size"
+	AttributeKeyTickSize     = "tick_size"
+	AttributeKeyLotSize      = "lot_size"
+
+	AttributeKeyPriceBand      = "price_band"
+	AttributeKeyCoolingPeriod  = "cooling_period"
+	AttributeKeyReferencePrice = "reference_price"
+	AttributeKeyHaltedUntil    = "halted_until"
+
+	AttributeKeyBatchInterval = "batch_interval"
 )
 
 func EmitAcceptEvent(ctx sdk.Context, order Order) {
@@ -85,3 +102,248 @@ func EmitUpdateEvent(ctx sdk.Context, order Order) {
 		),
 	)
 }
+
+func EmitStopAcceptEvent(ctx sdk.Context, order StopOrder) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "accept_stop"),
+			sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, order.ClientOrderID),
+			sdk.NewAttribute(AttributeKeySource, order.Source.String()),
+			sdk.NewAttribute(AttributeKeyDestination, order.Destination.String()),
+			sdk.NewAttribute(AttributeKeyTriggerPrice, order.TriggerPrice.String()),
+			sdk.NewAttribute(AttributeKeyCreated, order.Created.Format(time.RFC3339)),
+		),
+	)
+}
+
+func EmitStopTriggerEvent(ctx sdk.Context, order StopOrder, price sdk.Dec) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "trigger_stop"),
+			sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, order.ClientOrderID),
+			sdk.NewAttribute(AttributeKeyTriggerPrice, order.TriggerPrice.String()),
+			sdk.NewAttribute(AttributeKeyPrice, price.String()),
+		),
+	)
+}
+
+func EmitStopExpireEvent(ctx sdk.Context, order StopOrder) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "expire_stop"),
+			sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, order.ClientOrderID),
+			sdk.NewAttribute(AttributeKeySource, order.Source.String()),
+			sdk.NewAttribute(AttributeKeyDestination, order.Destination.String()),
+		),
+	)
+}
+
+// EmitSelfTradeEvent reports an action taken to prevent the aggressive order from trading with a resting order of
+// the same owner.
+func EmitSelfTradeEvent(ctx sdk.Context, mode SelfTradePrevention, aggressive, resting Order) {
+	var action string
+	switch mode {
+	case SelfTradePrevention_CancelNewest:
+		action = "self_trade_cancel_newest"
+	case SelfTradePrevention_CancelOldest:
+		action = "self_trade_cancel_oldest"
+	case SelfTradePrevention_CancelBoth:
+		action = "self_trade_cancel_both"
+	case SelfTradePrevention_DecrementAndCancel:
+		action = "self_trade_decrement"
+	default:
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, action),
+			sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprintf("%d", aggressive.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, aggressive.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, aggressive.ClientOrderID),
+			sdk.NewAttribute(AttributeKeyRestingOrderID, fmt.Sprintf("%d", resting.ID)),
+			sdk.NewAttribute(AttributeKeyRestingClientOrderID, resting.ClientOrderID),
+		),
+	)
+}
+
+func EmitInstrumentEvent(ctx sdk.Context, instrument Instrument) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "set_instrument"),
+			sdk.NewAttribute(AttributeKeySource, instrument.Source),
+			sdk.NewAttribute(AttributeKeyDestination, instrument.Destination),
+			sdk.NewAttribute(AttributeKeyStatus, instrument.Status.String()),
+			sdk.NewAttribute(AttributeKeyMinOrderSize, instrument.MinOrderSize.String()),
+			sdk.NewAttribute(AttributeKeyTickSize, instrument.TickSize.String()),
+			sdk.NewAttribute(AttributeKeyLotSize, instrument.LotSize.String()),
+			sdk.NewAttribute(AttributeKeyPriceBand, instrument.PriceBand.String()),
+			sdk.NewAttribute(AttributeKeyCoolingPeriod, instrument.CoolingPeriod.String()),
+			sdk.NewAttribute(AttributeKeyBatchInterval, fmt.Sprintf("%d", instrument.BatchInterval)),
+		),
+	)
+}
+
+func EmitCircuitBreakerEvent(ctx sdk.Context, src, dst string, price, reference sdk.Dec, haltedUntil time.Time) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "circuit_breaker"),
+			sdk.NewAttribute(AttributeKeySource, src),
+			sdk.NewAttribute(AttributeKeyDestination, dst),
+			sdk.NewAttribute(AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(AttributeKeyReferencePrice, reference.String()),
+			sdk.NewAttribute(AttributeKeyHaltedUntil, haltedUntil.Format(time.RFC3339)),
+		),
+	)
+}
+
+// EmitBatchAuctionEvent reports the uniform clearing price of a batch auction and the amounts traded at it.
+func EmitBatchAuctionEvent(ctx sdk.Context, price sdk.Dec, sourceTraded, destinationTraded sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "batch_auction"),
+			sdk.NewAttribute(AttributeKeySource, sourceTraded.Denom),
+			sdk.NewAttribute(AttributeKeyDestination, destinationTraded.Denom),
+			sdk.NewAttribute(AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(AttributeKeySourceFilled, sourceTraded.String()),
+			sdk.NewAttribute(AttributeKeyDestinationFilled, destinationTraded.String()),
+		),
+	)
+}
+
+// EmitOrderAcceptedEvent emits the typed counterpart of the accept event.
+func EmitOrderAcceptedEvent(ctx sdk.Context, order Order) {
+	emitTypedEvent(ctx, &EventOrderAccepted{
+		ID:            order.ID,
+		Owner:         order.Owner,
+		ClientOrderID: order.ClientOrderID,
+		Source:        order.Source,
+		Destination:   order.Destination,
+		Created:       order.Created,
+	})
+}
+
+// EmitOrderFilledEvent emits the typed counterpart of the fill event.
+func EmitOrderFilledEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled sdk.Int, destinationFilled sdk.Int) {
+	emitTypedEvent(ctx, &EventOrderFilled{
+		ID:                order.ID,
+		Owner:             order.Owner,
+		ClientOrderID:     order.ClientOrderID,
+		Aggressive:        aggressive,
+		SourceFilled:      sdk.NewCoin(order.Source.Denom, sourceFilled),
+		DestinationFilled: sdk.NewCoin(order.Destination.Denom, destinationFilled),
+		Filled:            order.IsFilled(),
+	})
+}
+
+// EmitOrderClosedEvent emits the typed counterpart of the expire event of an order that left the order book in the
+// given state. Filled orders are reported by their last fill event.
+func EmitOrderClosedEvent(ctx sdk.Context, order Order, state OrderState) {
+	var (
+		sourceFilled      = sdk.NewCoin(order.Source.Denom, order.SourceFilled)
+		destinationFilled = sdk.NewCoin(order.Destination.Denom, order.DestinationFilled)
+	)
+
+	switch state {
+	case OrderState_Cancelled:
+		emitTypedEvent(ctx, &EventOrderCancelled{
+			ID:                order.ID,
+			Owner:             order.Owner,
+			ClientOrderID:     order.ClientOrderID,
+			Source:            order.Source,
+			SourceFilled:      sourceFilled,
+			Destination:       order.Destination,
+			DestinationFilled: destinationFilled,
+		})
+	case OrderState_Expired:
+		emitTypedEvent(ctx, &EventOrderExpired{
+			ID:                order.ID,
+			Owner:             order.Owner,
+			ClientOrderID:     order.ClientOrderID,
+			Source:            order.Source,
+			SourceFilled:      sourceFilled,
+			Destination:       order.Destination,
+			DestinationFilled: destinationFilled,
+		})
+	}
+}
+
+// EmitOrderUpdatedEvent emits the typed counterpart of the update event.
+func EmitOrderUpdatedEvent(ctx sdk.Context, order Order) {
+	emitTypedEvent(ctx, &EventOrderUpdated{
+		ID:              order.ID,
+		Owner:           order.Owner,
+		ClientOrderID:   order.ClientOrderID,
+		SourceRemaining: sdk.NewCoin(order.Source.Denom, order.SourceRemaining),
+	})
+}
+
+// EmitStopOrderAcceptedEvent emits the typed counterpart of the accept_stop event.
+func EmitStopOrderAcceptedEvent(ctx sdk.Context, order StopOrder) {
+	emitTypedEvent(ctx, &EventStopOrderAccepted{
+		ID:            order.ID,
+		Owner:         order.Owner,
+		ClientOrderID: order.ClientOrderID,
+		Source:        order.Source,
+		Destination:   order.Destination,
+		TriggerPrice:  order.TriggerPrice,
+		Created:       order.Created,
+	})
+}
+
+// EmitStopOrderTriggeredEvent emits the typed counterpart of the trigger_stop event.
+func EmitStopOrderTriggeredEvent(ctx sdk.Context, order StopOrder, price sdk.Dec) {
+	emitTypedEvent(ctx, &EventStopOrderTriggered{
+		ID:            order.ID,
+		Owner:         order.Owner,
+		ClientOrderID: order.ClientOrderID,
+		TriggerPrice:  order.TriggerPrice,
+		Price:         price,
+	})
+}
+
+// EmitStopOrderExpiredEvent emits the typed counterpart of the expire_stop event.
+func EmitStopOrderExpiredEvent(ctx sdk.Context, order StopOrder) {
+	emitTypedEvent(ctx, &EventStopOrderExpired{
+		ID:            order.ID,
+		Owner:         order.Owner,
+		ClientOrderID: order.ClientOrderID,
+		Source:        order.Source,
+		Destination:   order.Destination,
+	})
+}
+
+// EmitSelfTradePreventedEvent emits the typed counterpart of the self-trade events.
+func EmitSelfTradePreventedEvent(ctx sdk.Context, mode SelfTradePrevention, aggressive, resting Order) {
+	emitTypedEvent(ctx, &EventSelfTradePrevented{
+		Mode:                 mode,
+		ID:                   aggressive.ID,
+		Owner:                aggressive.Owner,
+		ClientOrderID:        aggressive.ClientOrderID,
+		RestingOrderID:       resting.ID,
+		RestingClientOrderID: resting.ClientOrderID,
+	})
+}
+
+// EmitCircuitBreakerTrippedEvent emits the typed counterpart of the circuit_breaker event.
+func EmitCircuitBreakerTrippedEvent(ctx sdk.Context, src, dst string, price, reference sdk.Dec, haltedUntil time.Time) {
+	emitTypedEvent(ctx, &EventCircuitBreakerTripped{
+		Source:         src,
+		Destination:    dst,
+		Price:          price,
+		ReferencePrice: reference,
+		HaltedUntil:    haltedUntil,
+	})
+}
+
+func emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
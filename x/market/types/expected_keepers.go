@@ -22,6 +22,12 @@ type (
 		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 		GetSupply(ctx sdk.Context, denom string) sdk.Coin
 		GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 		AddBalanceListener(l func(sdk.Context, []sdk.AccAddress))
 	}
+
+	AuthorityKeeper interface {
+		ValidateAuthority(ctx sdk.Context, address sdk.AccAddress) error
+	}
 )
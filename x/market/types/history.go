@@ -0,0 +1,23 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+func NewTerminalOrder(order Order, state OrderState, height int64, closed time.Time) TerminalOrder {
+	return TerminalOrder{
+		Order:  order,
+		State:  state,
+		Height: height,
+		Closed: closed,
+	}
+}
+
+func (t TerminalOrder) String() string {
+	return fmt.Sprintf("%v\n%v at height %v (%v)\n", t.Order, t.State, t.Height, t.Closed.Format(time.RFC3339))
+}
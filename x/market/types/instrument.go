@@ -0,0 +1,210 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewInstrument(src, dst string, status InstrumentStatus, minOrderSize sdk.Int, tickSize sdk.Dec, lotSize sdk.Int) Instrument {
+	return Instrument{
+		Source:       src,
+		Destination:  dst,
+		Status:       status,
+		MinOrderSize: minOrderSize,
+		TickSize:     tickSize,
+		LotSize:      lotSize,
+		PriceBand:    sdk.ZeroDec(),
+	}
+}
+
+// WithCircuitBreaker returns a copy of the listing that is halted for the cooling period when a trade deviates more than
+// the price band from the reference price.
+func (i Instrument) WithCircuitBreaker(priceBand sdk.Dec, coolingPeriod time.Duration) Instrument {
+	i.PriceBand = priceBand
+	i.CoolingPeriod = coolingPeriod
+	return i
+}
+
+// WithBatchAuction returns a copy of the listing whose orders are cleared by a batch auction every interval blocks.
+func (i Instrument) WithBatchAuction(interval uint32) Instrument {
+	i.BatchInterval = interval
+	return i
+}
+
+func (i Instrument) Validate() error {
+	if err := sdk.ValidateDenom(i.Source); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidListing, "source: %v", err)
+	}
+
+	if err := sdk.ValidateDenom(i.Destination); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidListing, "destination: %v", err)
+	}
+
+	if i.Source == i.Destination {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", i.Source, i.Destination)
+	}
+
+	switch i.Status {
+	case InstrumentStatus_Active, InstrumentStatus_Halted, InstrumentStatus_Delisted:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidListing, "unknown status: %v", i.Status)
+	}
+
+	if i.MinOrderSize.IsNil() || i.MinOrderSize.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidListing, "minimum order size: %v", i.MinOrderSize)
+	}
+
+	if i.TickSize.IsNil() || i.TickSize.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidListing, "tick size: %v", i.TickSize)
+	}
+
+	if i.LotSize.IsNil() || i.LotSize.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidListing, "lot size: %v", i.LotSize)
+	}
+
+	if i.PriceBand.IsNil() || i.PriceBand.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidListing, "price band: %v", i.PriceBand)
+	}
+
+	if i.CoolingPeriod < 0 || (i.PriceBand.IsPositive() && i.CoolingPeriod == 0) {
+		return sdkerrors.Wrapf(ErrInvalidListing, "cooling period: %v", i.CoolingPeriod)
+	}
+
+	return nil
+}
+
+func (i Instrument) IsActive() bool {
+	return i.Status == InstrumentStatus_Active
+}
+
+// RoundPriceToTick rounds the price up to the next multiple of the tick size.
+func (i Instrument) RoundPriceToTick(price sdk.Dec) sdk.Dec {
+	if !i.TickSize.IsPositive() {
+		return price
+	}
+
+	ticks := price.Quo(i.TickSize).Ceil()
+	return ticks.Mul(i.TickSize)
+}
+
+// BreachesPriceBand returns true if the price deviates more than the price band from the reference price.
+func (i Instrument) BreachesPriceBand(price, reference sdk.Dec) bool {
+	if !i.PriceBand.IsPositive() || !reference.IsPositive() {
+		return false
+	}
+
+	deviation := price.Sub(reference).Abs().Quo(reference)
+	return deviation.GT(i.PriceBand)
+}
+
+func (i Instrument) String() string {
+	return fmt.Sprintf("%v => %v %v min: %v tick: %v lot: %v band: %v cooling: %v batch: %v", i.Source, i.Destination, i.Status, i.MinOrderSize, i.TickSize, i.LotSize, i.PriceBand, i.CoolingPeriod, i.BatchInterval)
+}
+
+// IsHalted returns true if the instrument is halted by its circuit breaker at the given time.
+func (md MarketData) IsHalted(t time.Time) bool {
+	return md.HaltedUntil != nil && t.Before(*md.HaltedUntil)
+}
+
+// IsValidForInstrument verifies that the order can be placed in the listed instrument.
+func (o Order) IsValidForInstrument(instrument Instrument) error {
+	if err := o.IsValid(); err != nil {
+		return err
+	}
+
+	if err := instrument.validateStatus(); err != nil {
+		return err
+	}
+
+	if err := instrument.validateQuantity(o.Destination); err != nil {
+		return err
+	}
+
+	return instrument.validatePrice(o.Source, o.Destination)
+}
+
+// IsValidForInstrument verifies that the stop order can be placed in the listed instrument once triggered. The price
+// of stop-market orders is only known when they are triggered.
+func (o StopOrder) IsValidForInstrument(instrument Instrument) error {
+	if err := o.IsValid(); err != nil {
+		return err
+	}
+
+	if err := instrument.validateStatus(); err != nil {
+		return err
+	}
+
+	if err := instrument.validateQuantity(o.Destination); err != nil {
+		return err
+	}
+
+	if o.IsStopMarket() {
+		return nil
+	}
+
+	return instrument.validatePrice(o.Source, o.Destination)
+}
+
+func (i Instrument) validateStatus() error {
+	if !i.IsActive() {
+		return sdkerrors.Wrapf(ErrInstrumentNotActive, "%v/%v is %v", i.Source, i.Destination, i.Status)
+	}
+
+	return nil
+}
+
+// The quantity of an order is its destination amount.
+func (i Instrument) validateQuantity(destination sdk.Coin) error {
+	if destination.Amount.LT(i.MinOrderSize) {
+		return sdkerrors.Wrapf(ErrInvalidOrderSize, "%v is below the minimum order size %v", destination, i.MinOrderSize)
+	}
+
+	if i.LotSize.IsPositive() && !destination.Amount.Mod(i.LotSize).IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidOrderSize, "%v is not a multiple of the lot size %v", destination, i.LotSize)
+	}
+
+	return nil
+}
+
+func (i Instrument) validatePrice(source, destination sdk.Coin) error {
+	if !i.TickSize.IsPositive() {
+		return nil
+	}
+
+	// Integer amounts cannot express every price exactly. The source amount must be the nearest integer to the amount
+	// that buys the destination at the nearest tick.
+	price := destination.Amount.ToDec().Quo(source.Amount.ToDec())
+	ticks := price.Quo(i.TickSize).RoundInt()
+	if !ticks.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidTickSize, "price %v is below the tick size %v", price, i.TickSize)
+	}
+
+	tickSource := destination.Amount.ToDec().Quo(i.TickSize.MulInt(ticks))
+	if source.Amount.ToDec().Sub(tickSource).Abs().GT(sdk.NewDecWithPrec(5, 1)) {
+		return sdkerrors.Wrapf(ErrInvalidTickSize, "price %v is not a multiple of the tick size %v", price, i.TickSize)
+	}
+
+	return nil
+}
+
+// Convert from InstrumentStatus string representation to the internal enum type. Case insensitive.
+func InstrumentStatusFromString(s string) (InstrumentStatus, error) {
+	switch strings.ToLower(s) {
+	case "active":
+		return InstrumentStatus_Active, nil
+	case "halted":
+		return InstrumentStatus_Halted, nil
+	case "delisted":
+		return InstrumentStatus_Delisted, nil
+	}
+
+	return 0, fmt.Errorf("unknown instrument status: %v", s)
+}
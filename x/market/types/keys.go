@@ -21,6 +21,7 @@ const (
 	// StoreKeyIdx 0.44 SDK forced rename: market_indices -> indices_market. A
 	// store key cannot use a shared prefix i.e. market.
 	StoreKeyIdx  = "indices_market"
+	TStoreKey    = "transient_market"
 	RouterKey    = ModuleName
 	QuerierRoute = ModuleName
 
@@ -40,12 +41,38 @@ var (
 	marketDataPrefix = []byte{0x02}
 	priorityPrefix   = []byte{0x03}
 	ownerPrefix      = []byte{0x04}
+
+	stopOwnerPrefix     = []byte{0x05}
+	stopTriggerPrefix   = []byte{0x06}
+	stopTriggeredPrefix = []byte{0x07}
+
+	selfTradePreventionPrefix = []byte{0x08}
+
+	instrumentPrefix = []byte{0x09}
+
+	orderIDPrefix = []byte{0x0A}
+
+	// Transient store prefix of orders that left the order book during the block
+	terminalOrderPrefix = []byte{0x0B}
+
+	stopCrossedPrefix = []byte{0x0C}
+
+	// Application database prefixes of the order history, which is not part of the consensus state
+	orderHistoryPrefix   = []byte("market/history/")
+	orderHistoryIDPrefix = []byte("market/history-id/")
 )
 
 /*
  - Priority-prefix: Orders sorted by SRC/DST/Price/orderID
  - Owner-prefix : Order sorted by owner-account/ClientOrderId
  - marketData-Prefix : Last traded price sorted by SRC/DST
+ - stopOwner-Prefix : Stop orders sorted by owner-account/ClientOrderId
+ - stopTrigger-Prefix : Pending stop orders sorted by SRC/DST/TriggerPrice/orderID
+ - stopTriggered-Prefix : Triggered stop orders awaiting activation sorted by orderID
+ - stopCrossed-Prefix : Lowest traded price not yet applied to the pending stop orders by SRC/DST
+ - selfTradePrevention-Prefix : Default self-trade prevention by owner-account
+ - instrument-Prefix : Listed instruments by source and destination denomination
+ - orderID-Prefix : Owner keys of orders sorted by orderID
 */
 
 func GetMarketDataPrefix() []byte {
@@ -118,3 +145,93 @@ func GetOwnerKey(acc, clientOrderId string) []byte {
 	res = append(res, []byte(clientOrderId)...)
 	return res
 }
+
+func GetStopOwnerKey(acc, clientOrderId string) []byte {
+	res := append(stopOwnerPrefix, []byte(acc)...)
+	res = append(res, []byte(clientOrderId)...)
+	return res
+}
+
+func GetStopTriggerKeyByInstrument(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v/", src, dst)
+	return append(stopTriggerPrefix, []byte(instr)...)
+}
+
+func GetStopTriggerKey(src, dst string, triggerPrice sdk.Dec, orderId uint64) []byte {
+	res := GetStopTriggerKeyByInstrument(src, dst)
+	res = append(res, sdk.SortableDecBytes(triggerPrice)...)
+	res = append(res, util.Uint64ToBytes(orderId)...)
+	return res
+}
+
+func GetStopTriggeredPrefix() []byte {
+	return stopTriggeredPrefix
+}
+
+func GetStopTriggeredKey(orderId uint64) []byte {
+	return append(stopTriggeredPrefix, util.Uint64ToBytes(orderId)...)
+}
+
+func GetStopCrossedPrefix() []byte {
+	return stopCrossedPrefix
+}
+
+func GetStopCrossedKey(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v", src, dst)
+	return append(stopCrossedPrefix, []byte(instr)...)
+}
+
+func ParseStopCrossedKey(key []byte) (source, destination string, err error) {
+	if !bytes.HasPrefix(key, stopCrossedPrefix) {
+		return "", "", fmt.Errorf("invalid prefix: %v", hex.EncodeToString(key))
+	}
+
+	a := strings.Split(string(key[len(stopCrossedPrefix):]), "/")
+	if len(a) != 2 {
+		return "", "", fmt.Errorf("invalid key: %v", hex.EncodeToString(key))
+	}
+	return a[0], a[1], nil
+}
+
+func GetSelfTradePreventionKey(acc string) []byte {
+	return append(selfTradePreventionPrefix, []byte(acc)...)
+}
+
+func GetInstrumentKey(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v", src, dst)
+	return append(instrumentPrefix, []byte(instr)...)
+}
+
+func GetInstrumentPrefix() []byte {
+	return instrumentPrefix
+}
+
+func GetOrderIDKey(orderId uint64) []byte {
+	return append(orderIDPrefix, util.Uint64ToBytes(orderId)...)
+}
+
+func GetTerminalOrderPrefix() []byte {
+	return terminalOrderPrefix
+}
+
+func GetTerminalOrderKey(orderId uint64) []byte {
+	return append(terminalOrderPrefix, util.Uint64ToBytes(orderId)...)
+}
+
+// GetOrderHistoryPrefix returns the prefix of the account's terminal orders, which are sorted by height and orderID.
+func GetOrderHistoryPrefix(owner string) []byte {
+	res := append([]byte{}, orderHistoryPrefix...)
+	res = append(res, []byte(owner)...)
+	return append(res, '/')
+}
+
+func GetOrderHistoryKey(owner string, height int64, orderId uint64) []byte {
+	res := GetOrderHistoryPrefix(owner)
+	res = append(res, util.Uint64ToBytes(uint64(height))...)
+	return append(res, util.Uint64ToBytes(orderId)...)
+}
+
+func GetOrderHistoryIDKey(orderId uint64) []byte {
+	res := append([]byte{}, orderHistoryIDPrefix...)
+	return append(res, util.Uint64ToBytes(orderId)...)
+}
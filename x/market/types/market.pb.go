@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -60,14 +61,152 @@ func (TimeInForce) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_888ec7fc0f7580e2, []int{0}
 }
 
+// SelfTradePrevention determines what happens when an order would trade with
+// an order of the same owner.
+type SelfTradePrevention int32
+
+const (
+	// Use the default of the owner account, which allows self trades if unset.
+	SelfTradePrevention_Unspecified SelfTradePrevention = 0
+	// Allow self trades.
+	SelfTradePrevention_None SelfTradePrevention = 1
+	// Cancel the remainder of the aggressive order.
+	SelfTradePrevention_CancelNewest SelfTradePrevention = 2
+	// Cancel the resting order and continue matching.
+	SelfTradePrevention_CancelOldest SelfTradePrevention = 3
+	// Cancel both orders.
+	SelfTradePrevention_CancelBoth SelfTradePrevention = 4
+	// Reduce both orders by the quantity that would have traded, which cancels
+	// the smaller order.
+	SelfTradePrevention_DecrementAndCancel SelfTradePrevention = 5
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_NONE",
+	2: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	3: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	4: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	5: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_NONE":                 1,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":        2,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":        3,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          4,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 5,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{1}
+}
+
+// OrderState is the reason an order left the order book.
+type OrderState int32
+
+const (
+	OrderState_Unspecified OrderState = 0
+	// The destination amount of the order was bought.
+	OrderState_Filled OrderState = 1
+	// Canceled or replaced by the owner, by self-trade prevention or by the
+	// delisting of the instrument.
+	OrderState_Cancelled OrderState = 2
+	// Expired by its time in force or for lack of balance.
+	OrderState_Expired OrderState = 3
+)
+
+var OrderState_name = map[int32]string{
+	0: "ORDER_STATE_UNSPECIFIED",
+	1: "ORDER_STATE_FILLED",
+	2: "ORDER_STATE_CANCELLED",
+	3: "ORDER_STATE_EXPIRED",
+}
+
+var OrderState_value = map[string]int32{
+	"ORDER_STATE_UNSPECIFIED": 0,
+	"ORDER_STATE_FILLED":      1,
+	"ORDER_STATE_CANCELLED":   2,
+	"ORDER_STATE_EXPIRED":     3,
+}
+
+func (x OrderState) String() string {
+	return proto.EnumName(OrderState_name, int32(x))
+}
+
+func (OrderState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+}
+
+// InstrumentStatus determines whether orders are accepted and matched in a
+// listed instrument.
+type InstrumentStatus int32
+
+const (
+	// The instrument is not listed, which places no restrictions on its orders.
+	InstrumentStatus_Unspecified InstrumentStatus = 0
+	// Orders are accepted and matched.
+	InstrumentStatus_Active InstrumentStatus = 1
+	// Orders are neither accepted nor matched. Resting orders remain in the
+	// order book.
+	InstrumentStatus_Halted InstrumentStatus = 2
+	// Orders are not accepted. Resting orders are canceled when the instrument
+	// is delisted.
+	InstrumentStatus_Delisted InstrumentStatus = 3
+)
+
+var InstrumentStatus_name = map[int32]string{
+	0: "INSTRUMENT_STATUS_UNSPECIFIED",
+	1: "INSTRUMENT_STATUS_ACTIVE",
+	2: "INSTRUMENT_STATUS_HALTED",
+	3: "INSTRUMENT_STATUS_DELISTED",
+}
+
+var InstrumentStatus_value = map[string]int32{
+	"INSTRUMENT_STATUS_UNSPECIFIED": 0,
+	"INSTRUMENT_STATUS_ACTIVE":      1,
+	"INSTRUMENT_STATUS_HALTED":      2,
+	"INSTRUMENT_STATUS_DELISTED":    3,
+}
+
+func (x InstrumentStatus) String() string {
+	return proto.EnumName(InstrumentStatus_name, int32(x))
+}
+
+func (InstrumentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{3}
+}
+
+// Instrument is the listing of orders selling source for destination. Sizes
+// that are zero are not enforced.
 type Instrument struct {
-	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Source      string           `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	Status      InstrumentStatus `protobuf:"varint,3,opt,name=status,proto3,enum=em.market.v1.InstrumentStatus" json:"status,omitempty" yaml:"status"`
+	// Minimum destination amount of an order.
+	MinOrderSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_order_size,json=minOrderSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_size" yaml:"min_order_size"`
+	// The price of an order (destination / source) must be a multiple of the
+	// tick size.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	// The destination amount of an order must be a multiple of the lot size.
+	LotSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size" yaml:"lot_size"`
+	// Trades deviating more than the price band (a fraction) from the reference
+	// price halt the instrument for the cooling period. Zero disables the
+	// circuit breaker.
+	PriceBand     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price_band,json=priceBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_band" yaml:"price_band"`
+	CoolingPeriod time.Duration                          `protobuf:"bytes,8,opt,name=cooling_period,json=coolingPeriod,proto3,stdduration" json:"cooling_period" yaml:"cooling_period"`
+	// Number of blocks between the batch auctions that clear the orders of the
+	// instrument at a uniform price. Zero matches orders continuously.
+	BatchInterval uint32 `protobuf:"varint,9,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty" yaml:"batch_interval"`
 }
 
-func (m *Instrument) Reset()         { *m = Instrument{} }
-func (m *Instrument) String() string { return proto.CompactTextString(m) }
-func (*Instrument) ProtoMessage()    {}
+func (m *Instrument) Reset()      { *m = Instrument{} }
+func (*Instrument) ProtoMessage() {}
 func (*Instrument) Descriptor() ([]byte, []int) {
 	return fileDescriptor_888ec7fc0f7580e2, []int{0}
 }
@@ -112,17 +251,42 @@ func (m *Instrument) GetDestination() string {
 	return ""
 }
 
+func (m *Instrument) GetStatus() InstrumentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return InstrumentStatus_Unspecified
+}
+
+func (m *Instrument) GetCoolingPeriod() time.Duration {
+	if m != nil {
+		return m.CoolingPeriod
+	}
+	return 0
+}
+
+func (m *Instrument) GetBatchInterval() uint32 {
+	if m != nil {
+		return m.BatchInterval
+	}
+	return 0
+}
+
 type Order struct {
-	ID                uint64                                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
-	TimeInForce       TimeInForce                            `protobuf:"varint,2,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Owner             string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	ClientOrderID     string                                 `protobuf:"bytes,4,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
-	Source            types.Coin                             `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
-	SourceRemaining   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=source_remaining,json=sourceRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_remaining" yaml:"source_remaining"`
-	SourceFilled      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=source_filled,json=sourceFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_filled" yaml:"source_filled"`
-	Destination       types.Coin                             `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination" yaml:"destination"`
-	DestinationFilled github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=destination_filled,json=destinationFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"destination_filled" yaml:"destination_filled"`
-	Created           time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	ID                  uint64                                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,2,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Owner               string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderID       string                                 `protobuf:"bytes,4,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	Source              types.Coin                             `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
+	SourceRemaining     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=source_remaining,json=sourceRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_remaining" yaml:"source_remaining"`
+	SourceFilled        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=source_filled,json=sourceFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_filled" yaml:"source_filled"`
+	Destination         types.Coin                             `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	DestinationFilled   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=destination_filled,json=destinationFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"destination_filled" yaml:"destination_filled"`
+	Created             time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,11,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// deposit is held by the market while the order rests in the order book and
+	// is returned to the owner when it leaves the order book.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit" yaml:"deposit"`
 }
 
 func (m *Order) Reset()      { *m = Order{} }
@@ -206,6 +370,20 @@ func (m *Order) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *Order) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
+}
+
+func (m *Order) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
 type ExecutionPlan struct {
 	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 	FirstOrder  *Order                                 `protobuf:"bytes,2,opt,name=first_order,json=firstOrder,proto3" json:"first_order,omitempty"`
@@ -263,6 +441,10 @@ type MarketData struct {
 	Destination string                                  `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
 	LastPrice   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
 	Timestamp   *time.Time                              `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// Rolling average of the traded prices, which bounds the price band.
+	ReferencePrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price,omitempty"`
+	// Set while the instrument is halted by its circuit breaker.
+	HaltedUntil *time.Time `protobuf:"bytes,6,opt,name=halted_until,json=haltedUntil,proto3,stdtime" json:"halted_until,omitempty"`
 }
 
 func (m *MarketData) Reset()         { *m = MarketData{} }
@@ -319,74 +501,420 @@ func (m *MarketData) GetTimestamp() *time.Time {
 	return nil
 }
 
+func (m *MarketData) GetHaltedUntil() *time.Time {
+	if m != nil {
+		return m.HaltedUntil
+	}
+	return nil
+}
+
+// StopOrder is an order that is placed in the order book once the last traded
+// price of its instrument falls to the trigger price. A stop-market order has
+// no source amount, which is derived from the market price and the maximum
+// slippage when the order is triggered.
+type StopOrder struct {
+	ID                  uint64                                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,2,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Owner               string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderID       string                                 `protobuf:"bytes,4,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	Source              types.Coin                             `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination         types.Coin                             `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	TriggerPrice        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
+	Triggered           bool                                   `protobuf:"varint,9,opt,name=triggered,proto3" json:"triggered,omitempty" yaml:"triggered"`
+	Created             time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,11,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// deposit is held by the market while the stop order is pending and is
+	// returned to the owner when it is triggered or cancelled.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit" yaml:"deposit"`
+}
+
+func (m *StopOrder) Reset()      { *m = StopOrder{} }
+func (*StopOrder) ProtoMessage() {}
+func (*StopOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{4}
+}
+func (m *StopOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopOrder.Merge(m, src)
+}
+func (m *StopOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopOrder proto.InternalMessageInfo
+
+func (m *StopOrder) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *StopOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_Unspecified
+}
+
+func (m *StopOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StopOrder) GetClientOrderID() string {
+	if m != nil {
+		return m.ClientOrderID
+	}
+	return ""
+}
+
+func (m *StopOrder) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
+	}
+	return types.Coin{}
+}
+
+func (m *StopOrder) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
+	}
+	return types.Coin{}
+}
+
+func (m *StopOrder) GetTriggered() bool {
+	if m != nil {
+		return m.Triggered
+	}
+	return false
+}
+
+func (m *StopOrder) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *StopOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
+}
+
+func (m *StopOrder) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// TerminalOrder is the final state of an order that left the order book. It is
+// retained by nodes that keep an order history, outside of the consensus state.
+type TerminalOrder struct {
+	Order  Order      `protobuf:"bytes,1,opt,name=order,proto3" json:"order" yaml:"order"`
+	State  OrderState `protobuf:"varint,2,opt,name=state,proto3,enum=em.market.v1.OrderState" json:"state,omitempty" yaml:"state"`
+	Height int64      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Closed time.Time  `protobuf:"bytes,4,opt,name=closed,proto3,stdtime" json:"closed" yaml:"closed"`
+}
+
+func (m *TerminalOrder) Reset()      { *m = TerminalOrder{} }
+func (*TerminalOrder) ProtoMessage() {}
+func (*TerminalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{5}
+}
+func (m *TerminalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerminalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminalOrder.Merge(m, src)
+}
+func (m *TerminalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminalOrder proto.InternalMessageInfo
+
+func (m *TerminalOrder) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
+}
+
+func (m *TerminalOrder) GetState() OrderState {
+	if m != nil {
+		return m.State
+	}
+	return OrderState_Unspecified
+}
+
+func (m *TerminalOrder) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TerminalOrder) GetClosed() time.Time {
+	if m != nil {
+		return m.Closed
+	}
+	return time.Time{}
+}
+
+// Params defines the parameters of the market module.
+type Params struct {
+	// legacy_events enables the untyped accept, fill and expire events alongside
+	// the typed order events during the transition to the latter.
+	LegacyEvents bool `protobuf:"varint,1,opt,name=legacy_events,json=legacyEvents,proto3" json:"legacy_events,omitempty" yaml:"legacy_events"`
+	// max_open_orders is the maximum number of orders an account can have in the
+	// order book. Zero is unlimited.
+	MaxOpenOrders uint32 `protobuf:"varint,2,opt,name=max_open_orders,json=maxOpenOrders,proto3" json:"max_open_orders,omitempty" yaml:"max_open_orders"`
+	// max_open_orders_per_instrument is the maximum number of orders an account
+	// can have in the order book of an instrument. Zero is unlimited.
+	MaxOpenOrdersPerInstrument uint32 `protobuf:"varint,3,opt,name=max_open_orders_per_instrument,json=maxOpenOrdersPerInstrument,proto3" json:"max_open_orders_per_instrument,omitempty" yaml:"max_open_orders_per_instrument"`
+	// order_deposit is charged for each order that rests in the order book and
+	// refunded when the order leaves it. Empty disables deposits.
+	OrderDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=order_deposit,json=orderDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"order_deposit" yaml:"order_deposit"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{6}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetLegacyEvents() bool {
+	if m != nil {
+		return m.LegacyEvents
+	}
+	return false
+}
+
+func (m *Params) GetMaxOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxOpenOrders
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOpenOrdersPerInstrument() uint32 {
+	if m != nil {
+		return m.MaxOpenOrdersPerInstrument
+	}
+	return 0
+}
+
+func (m *Params) GetOrderDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OrderDeposit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("em.market.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("em.market.v1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("em.market.v1.OrderState", OrderState_name, OrderState_value)
+	proto.RegisterEnum("em.market.v1.InstrumentStatus", InstrumentStatus_name, InstrumentStatus_value)
 	proto.RegisterType((*Instrument)(nil), "em.market.v1.Instrument")
 	proto.RegisterType((*Order)(nil), "em.market.v1.Order")
 	proto.RegisterType((*ExecutionPlan)(nil), "em.market.v1.ExecutionPlan")
 	proto.RegisterType((*MarketData)(nil), "em.market.v1.MarketData")
+	proto.RegisterType((*StopOrder)(nil), "em.market.v1.StopOrder")
+	proto.RegisterType((*TerminalOrder)(nil), "em.market.v1.TerminalOrder")
+	proto.RegisterType((*Params)(nil), "em.market.v1.Params")
 }
 
 func init() { proto.RegisterFile("em/market/v1/market.proto", fileDescriptor_888ec7fc0f7580e2) }
 
 var fileDescriptor_888ec7fc0f7580e2 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0x6d, 0xd9, 0x8e, 0x4f, 0x96, 0xad, 0x5c, 0x9d, 0x54, 0xe2, 0x40, 0xaa, 0x1c, 0x82,
-	0x20, 0x81, 0x49, 0xd8, 0x0d, 0x32, 0x04, 0x45, 0x81, 0x48, 0xa2, 0x52, 0xc2, 0xb2, 0x65, 0x30,
-	0x0a, 0x02, 0x74, 0x21, 0x28, 0xf2, 0xa4, 0x1e, 0x4c, 0xde, 0x09, 0xe4, 0xc9, 0x8d, 0xfb, 0x11,
-	0x3c, 0x65, 0xec, 0x62, 0xa0, 0x43, 0x87, 0x7e, 0x94, 0x74, 0x73, 0xb7, 0xa2, 0x03, 0x5b, 0xc8,
-	0x53, 0x87, 0x2e, 0xfa, 0x04, 0x05, 0xef, 0x28, 0x99, 0x6c, 0xd1, 0x16, 0xea, 0xa4, 0x7b, 0x7f,
-	0x7e, 0xef, 0xbd, 0xdf, 0xfb, 0x43, 0x81, 0x06, 0x0a, 0x8d, 0xd0, 0x8d, 0xce, 0x11, 0x33, 0x2e,
-	0x0e, 0xb3, 0x97, 0x3e, 0x89, 0x28, 0xa3, 0x70, 0x07, 0x85, 0x7a, 0xa6, 0xb8, 0x38, 0x94, 0xf7,
-	0xc7, 0x74, 0x4c, 0xb9, 0xc1, 0x48, 0x5f, 0xc2, 0x47, 0x56, 0xc7, 0x94, 0x8e, 0x03, 0x64, 0x70,
-	0x69, 0x38, 0x1d, 0x19, 0x0c, 0x87, 0x28, 0x66, 0x6e, 0x38, 0xc9, 0x1c, 0x14, 0x8f, 0xc6, 0x21,
-	0x8d, 0x8d, 0xa1, 0x1b, 0x23, 0xe3, 0xe2, 0x70, 0x88, 0x98, 0x7b, 0x68, 0x78, 0x14, 0x13, 0x61,
-	0xd7, 0xba, 0x00, 0x58, 0x24, 0x66, 0xd1, 0x34, 0x44, 0x84, 0xc1, 0x87, 0x60, 0x33, 0xa6, 0xd3,
-	0xc8, 0x43, 0x75, 0xa9, 0x29, 0x3d, 0xde, 0xb6, 0x33, 0x09, 0x36, 0x41, 0xc5, 0x47, 0x31, 0xc3,
-	0xc4, 0x65, 0x98, 0x92, 0xfa, 0x1a, 0x37, 0xe6, 0x55, 0xda, 0x1f, 0x9b, 0x60, 0xa3, 0x1f, 0xf9,
-	0x28, 0x82, 0xcf, 0xc0, 0x3d, 0x9a, 0x3e, 0x1c, 0xec, 0xf3, 0x28, 0xe5, 0x56, 0x63, 0x96, 0xa8,
-	0x6b, 0x56, 0x67, 0x9e, 0xa8, 0x7b, 0x97, 0x6e, 0x18, 0xbc, 0xd0, 0x16, 0x76, 0xcd, 0xde, 0xe2,
-	0x4f, 0xcb, 0x87, 0x6f, 0x41, 0x35, 0x2d, 0xdd, 0xc1, 0xc4, 0x19, 0xd1, 0xb4, 0x80, 0x34, 0xc7,
-	0xee, 0x51, 0x43, 0xcf, 0x37, 0x41, 0x1f, 0xe0, 0x10, 0x59, 0xa4, 0x9b, 0x3a, 0xb4, 0xea, 0xf3,
-	0x44, 0xdd, 0x17, 0xf1, 0x0a, 0x48, 0xcd, 0xae, 0xb0, 0x3b, 0x37, 0xf8, 0x08, 0x6c, 0xd0, 0xaf,
-	0x09, 0x8a, 0xea, 0xeb, 0x69, 0xd1, 0xad, 0xda, 0x3c, 0x51, 0x77, 0xb2, 0x2a, 0x52, 0xb5, 0x66,
-	0x0b, 0x33, 0x7c, 0x0d, 0xf6, 0xbc, 0x00, 0x23, 0xc2, 0x9c, 0x65, 0xf5, 0x65, 0x8e, 0x78, 0x3a,
-	0x4b, 0xd4, 0x6a, 0x9b, 0x9b, 0x38, 0x41, 0x4e, 0xe4, 0xa1, 0x08, 0xf1, 0x17, 0x84, 0x66, 0x57,
-	0xbd, 0x9c, 0xa3, 0x0f, 0xbf, 0x58, 0xf6, 0x73, 0xa3, 0x29, 0x3d, 0xae, 0x1c, 0x35, 0x74, 0x31,
-	0x0e, 0x3d, 0x1d, 0x87, 0x9e, 0x8d, 0x43, 0x6f, 0x53, 0x4c, 0x5a, 0x0f, 0x3e, 0x24, 0x6a, 0x69,
-	0x9e, 0xa8, 0x55, 0x11, 0x59, 0xc0, 0xb4, 0xe5, 0x04, 0x18, 0xa8, 0x89, 0x97, 0x13, 0xa1, 0xd0,
-	0xc5, 0x04, 0x93, 0x71, 0x7d, 0x93, 0xd7, 0x67, 0xa5, 0xc0, 0x5f, 0x12, 0xf5, 0xd1, 0x18, 0xb3,
-	0xaf, 0xa6, 0x43, 0xdd, 0xa3, 0xa1, 0x91, 0x0d, 0x5d, 0xfc, 0x1c, 0xc4, 0xfe, 0xb9, 0xc1, 0x2e,
-	0x27, 0x28, 0xd6, 0x2d, 0xc2, 0xe6, 0x89, 0xfa, 0x71, 0x3e, 0xc5, 0x5d, 0x3c, 0xcd, 0xde, 0x13,
-	0x2a, 0x7b, 0xa1, 0x81, 0xe7, 0xa0, 0x9a, 0x79, 0x8d, 0x70, 0x10, 0x20, 0xbf, 0xbe, 0xc5, 0x53,
-	0x76, 0x57, 0x4e, 0xb9, 0x5f, 0x48, 0x29, 0x82, 0x69, 0xf6, 0x8e, 0x90, 0xbb, 0x5c, 0x84, 0x6f,
-	0x8b, 0x4b, 0x76, 0xef, 0xbf, 0x3a, 0x26, 0x67, 0x1d, 0x83, 0x22, 0x76, 0x7e, 0x1b, 0x0b, 0xbb,
-	0x09, 0xbf, 0x01, 0x30, 0x27, 0x2e, 0xa8, 0x6c, 0x73, 0x2a, 0xc7, 0x2b, 0x53, 0x69, 0xfc, 0x2d,
-	0xdd, 0x92, 0xcf, 0xfd, 0x9c, 0x32, 0x23, 0x75, 0x06, 0xb6, 0xbc, 0x08, 0xb9, 0x0c, 0xf9, 0x75,
-	0xc0, 0x09, 0xc9, 0xba, 0x38, 0x59, 0x7d, 0x71, 0xb2, 0x7c, 0xa9, 0xf9, 0xc9, 0x2e, 0x19, 0xed,
-	0x66, 0xdb, 0x25, 0x80, 0xda, 0xfb, 0x5f, 0x55, 0xc9, 0x5e, 0x84, 0x79, 0x51, 0xfe, 0xf6, 0x3b,
-	0xb5, 0xa4, 0xfd, 0x28, 0x81, 0xaa, 0xf9, 0x0e, 0x79, 0xd3, 0x34, 0xd7, 0x59, 0xe0, 0x12, 0xd8,
-	0x01, 0x1b, 0x93, 0x08, 0x2f, 0x4e, 0xb7, 0xa5, 0xaf, 0x40, 0xac, 0x83, 0x3c, 0x5b, 0x80, 0xe1,
-	0x33, 0x50, 0x19, 0xe1, 0x28, 0xce, 0x76, 0x9a, 0x5f, 0x61, 0xe5, 0xe8, 0xa3, 0xe2, 0x15, 0xf2,
-	0xed, 0xb6, 0x01, 0xf7, 0x13, 0x37, 0xff, 0x1c, 0xec, 0xc4, 0xc8, 0xa3, 0xc4, 0xcf, 0x60, 0xeb,
-	0xff, 0x0c, 0xab, 0x08, 0x47, 0x2e, 0x64, 0x5c, 0x7e, 0x92, 0x00, 0x38, 0xe1, 0x6e, 0x1d, 0x97,
-	0xb9, 0xff, 0xff, 0x23, 0x04, 0x2d, 0x00, 0x02, 0x37, 0x66, 0x8e, 0xe8, 0x83, 0x38, 0xf8, 0x27,
-	0x2b, 0xf4, 0x60, 0x3b, 0x45, 0x9f, 0xf1, 0x3e, 0x7c, 0x0e, 0xb6, 0x97, 0x9f, 0x52, 0xfe, 0x21,
-	0xf8, 0xf7, 0xc9, 0x95, 0xf9, 0x8c, 0xee, 0x20, 0x4f, 0x7e, 0x97, 0x40, 0x25, 0xf7, 0xb5, 0x82,
-	0x3a, 0x68, 0x0c, 0xac, 0x13, 0xd3, 0xb1, 0x4e, 0x9d, 0x6e, 0xdf, 0x6e, 0x9b, 0xce, 0x9b, 0xd3,
-	0xd7, 0x67, 0x66, 0xdb, 0xea, 0x5a, 0x66, 0xa7, 0x56, 0x92, 0xf7, 0xae, 0xae, 0x9b, 0x95, 0x37,
-	0x24, 0x9e, 0x20, 0x0f, 0x8f, 0x30, 0xf2, 0xe1, 0x73, 0xa0, 0x14, 0xfd, 0x5f, 0xf5, 0xfb, 0x1d,
-	0x67, 0x60, 0xf5, 0x7a, 0x4e, 0xfb, 0xe5, 0x69, 0xdb, 0xec, 0xd5, 0x24, 0x19, 0x5e, 0x5d, 0x37,
-	0x77, 0x5f, 0x51, 0xea, 0x0f, 0x70, 0x10, 0xb4, 0x5d, 0xe2, 0xa1, 0x00, 0x7e, 0x06, 0x3e, 0x29,
-	0xe2, 0xac, 0x93, 0x13, 0xb3, 0x63, 0xbd, 0x1c, 0x98, 0x4e, 0xdf, 0x5e, 0x40, 0xd7, 0xe4, 0x07,
-	0x57, 0xd7, 0xcd, 0xfb, 0x56, 0x18, 0x22, 0x1f, 0xbb, 0x0c, 0xf5, 0xa3, 0x0c, 0xad, 0x03, 0xb9,
-	0x88, 0xee, 0xa6, 0x09, 0xfb, 0xb6, 0x73, 0x6c, 0xf5, 0x7a, 0xb5, 0x75, 0x79, 0xf7, 0xea, 0xba,
-	0x09, 0xd2, 0xcd, 0xee, 0x47, 0xc7, 0x38, 0x08, 0xe4, 0xf2, 0x0f, 0xdf, 0x2b, 0x52, 0xcb, 0xfc,
-	0x30, 0x53, 0xa4, 0x9b, 0x99, 0x22, 0xfd, 0x36, 0x53, 0xa4, 0xf7, 0xb7, 0x4a, 0xe9, 0xe6, 0x56,
-	0x29, 0xfd, 0x7c, 0xab, 0x94, 0xbe, 0x7c, 0x9a, 0x6b, 0x3c, 0x3a, 0x08, 0x29, 0x41, 0x97, 0x06,
-	0x0a, 0x0f, 0x02, 0xe4, 0x8f, 0x51, 0x64, 0xbc, 0x5b, 0xfc, 0xf3, 0xf1, 0x09, 0x0c, 0x37, 0x79,
-	0x5f, 0x3f, 0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x22, 0xa6, 0x88, 0x13, 0x07, 0x00, 0x00,
+	// 1907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x4a, 0xa2, 0x44, 0x0e, 0x49, 0x99, 0x1e, 0x4b, 0x0e, 0xb5, 0x68, 0x49, 0x6a, 0xdb,
+	0x06, 0x8a, 0x93, 0x90, 0xb0, 0x9a, 0x1a, 0x6d, 0xd0, 0x8f, 0x68, 0xb9, 0x2b, 0x6b, 0x61, 0x89,
+	0x24, 0x86, 0x54, 0x1c, 0x14, 0x05, 0x16, 0xab, 0xdd, 0x11, 0x35, 0xf0, 0x7e, 0x10, 0xbb, 0x23,
+	0x59, 0xf6, 0xb5, 0x28, 0x50, 0xf0, 0x14, 0xf4, 0x94, 0x0b, 0x81, 0x1e, 0x7a, 0x28, 0xda, 0x6b,
+	0xff, 0x88, 0xf4, 0x52, 0xe4, 0x58, 0xf4, 0x40, 0x17, 0xf2, 0xa9, 0xe8, 0x4d, 0xd7, 0x5e, 0x8a,
+	0x9d, 0x99, 0x15, 0x97, 0x92, 0x1c, 0x99, 0x45, 0x7a, 0xcb, 0x89, 0x3b, 0x33, 0xbf, 0xdf, 0x9b,
+	0xf7, 0xde, 0xbc, 0x8f, 0x19, 0x82, 0x75, 0xec, 0x35, 0x3c, 0x2b, 0x7c, 0x86, 0x69, 0xe3, 0xf4,
+	0xa1, 0xf8, 0xaa, 0x0f, 0xc2, 0x80, 0x06, 0xb0, 0x80, 0xbd, 0xba, 0x98, 0x38, 0x7d, 0x28, 0xaf,
+	0xf6, 0x83, 0x7e, 0xc0, 0x16, 0x1a, 0xf1, 0x17, 0xc7, 0xc8, 0xd5, 0x7e, 0x10, 0xf4, 0x5d, 0xdc,
+	0x60, 0xa3, 0xc3, 0x93, 0xa3, 0x06, 0x25, 0x1e, 0x8e, 0xa8, 0xe5, 0x0d, 0x04, 0xa0, 0x72, 0x15,
+	0xe0, 0x9c, 0x84, 0x16, 0x25, 0x81, 0x9f, 0xac, 0xdb, 0x41, 0xe4, 0x05, 0x51, 0xe3, 0xd0, 0x8a,
+	0x70, 0xe3, 0xf4, 0xe1, 0x21, 0xa6, 0xd6, 0xc3, 0x86, 0x1d, 0x10, 0xb1, 0xae, 0xfc, 0x27, 0x03,
+	0x80, 0xe1, 0x47, 0x34, 0x3c, 0xf1, 0xb0, 0x4f, 0xe1, 0x7b, 0x60, 0x29, 0x0a, 0x4e, 0x42, 0x1b,
+	0x97, 0xa5, 0x9a, 0xb4, 0x99, 0x53, 0xef, 0x5e, 0x8c, 0xab, 0xc5, 0x17, 0x96, 0xe7, 0x7e, 0xac,
+	0xf0, 0x79, 0x05, 0x09, 0x00, 0xfc, 0x31, 0xc8, 0x3b, 0x38, 0xa2, 0xc4, 0x67, 0xdb, 0x95, 0xe7,
+	0x19, 0xfe, 0xfe, 0xc5, 0xb8, 0x0a, 0x39, 0x3e, 0xb5, 0xa8, 0xa0, 0x34, 0x14, 0x1a, 0x60, 0x29,
+	0xa2, 0x16, 0x3d, 0x89, 0xca, 0x0b, 0x35, 0x69, 0x73, 0x65, 0xab, 0x52, 0x4f, 0x7b, 0xa2, 0x3e,
+	0x51, 0xa7, 0xcb, 0x50, 0x53, 0x4a, 0xb0, 0x99, 0x58, 0x09, 0xf6, 0x01, 0x3d, 0xb0, 0xe2, 0x11,
+	0xdf, 0x0c, 0x42, 0x07, 0x87, 0x66, 0x44, 0x5e, 0xe2, 0xf2, 0x22, 0xd3, 0xe3, 0xf1, 0x97, 0xe3,
+	0xea, 0xdc, 0x3f, 0xc6, 0xd5, 0x77, 0xfb, 0x84, 0x1e, 0x9f, 0x1c, 0xd6, 0xed, 0xc0, 0x6b, 0x08,
+	0x4f, 0xf0, 0x9f, 0x0f, 0x23, 0xe7, 0x59, 0x83, 0xbe, 0x18, 0xe0, 0xa8, 0x6e, 0xf8, 0xf4, 0x62,
+	0x5c, 0x5d, 0xe3, 0x1b, 0x4c, 0x4b, 0x53, 0x50, 0xc1, 0x23, 0x7e, 0x3b, 0x1e, 0x77, 0xc9, 0x4b,
+	0x0c, 0x4d, 0x90, 0xa3, 0xc4, 0x7e, 0xc6, 0x77, 0xca, 0xb0, 0x9d, 0xd4, 0x19, 0x76, 0xd2, 0xb0,
+	0x7d, 0x31, 0xae, 0x96, 0xf8, 0x4e, 0x97, 0x82, 0x14, 0x94, 0x8d, 0xbf, 0xd9, 0x06, 0xbf, 0x02,
+	0x59, 0x37, 0xa0, 0x5c, 0xfe, 0x12, 0x93, 0xbf, 0x3d, 0xb3, 0x25, 0x77, 0xb8, 0xfc, 0x44, 0x8e,
+	0x82, 0x96, 0xdd, 0x80, 0x32, 0xe9, 0x87, 0x00, 0x0c, 0x42, 0x62, 0x63, 0xf3, 0xd0, 0xf2, 0x9d,
+	0xf2, 0x32, 0x93, 0xdf, 0x9c, 0x59, 0xff, 0xbb, 0x5c, 0xfe, 0x44, 0x92, 0x82, 0x72, 0x6c, 0xa0,
+	0x5a, 0xbe, 0x03, 0x6d, 0xb0, 0x62, 0x07, 0x81, 0x4b, 0xfc, 0xbe, 0x39, 0xc0, 0x21, 0x09, 0x9c,
+	0x72, 0xb6, 0x26, 0x6d, 0xe6, 0xb7, 0xd6, 0xeb, 0x3c, 0x52, 0xeb, 0x49, 0xa4, 0xd6, 0x35, 0x11,
+	0xa9, 0xea, 0x46, 0xac, 0xc2, 0xe4, 0x08, 0xa6, 0xe9, 0xca, 0x17, 0xaf, 0xaa, 0x12, 0x2a, 0x8a,
+	0xc9, 0x0e, 0x9b, 0x83, 0x9f, 0x80, 0x95, 0x43, 0x8b, 0xda, 0xc7, 0x26, 0xf1, 0x29, 0x0e, 0x4f,
+	0x2d, 0xb7, 0x9c, 0xab, 0x49, 0x9b, 0x45, 0x75, 0x7d, 0x22, 0x65, 0x7a, 0x5d, 0x41, 0x45, 0x36,
+	0x61, 0x88, 0xf1, 0xc7, 0x8b, 0x5f, 0xfc, 0xbe, 0x3a, 0xa7, 0xbc, 0xca, 0x82, 0x0c, 0x3b, 0x5d,
+	0xf8, 0x11, 0xc8, 0xf2, 0x63, 0x27, 0x0e, 0x0b, 0xfd, 0x45, 0x75, 0xfd, 0x7c, 0x5c, 0x9d, 0x37,
+	0xb4, 0x89, 0x43, 0x93, 0x75, 0x05, 0x2d, 0xb3, 0x4f, 0xc3, 0x81, 0x4f, 0x41, 0x31, 0x4e, 0x48,
+	0x93, 0xf8, 0xe6, 0x51, 0x10, 0x67, 0xcd, 0x3c, 0x0b, 0xe8, 0xf5, 0xe9, 0x80, 0xee, 0x11, 0x0f,
+	0x1b, 0xfe, 0x4e, 0x0c, 0x50, 0xcb, 0x17, 0xe3, 0xea, 0x6a, 0x12, 0x00, 0x29, 0xa6, 0x82, 0xf2,
+	0x74, 0x02, 0x83, 0xef, 0x82, 0x4c, 0xf0, 0xdc, 0xc7, 0x21, 0xcb, 0x90, 0x9c, 0x5a, 0xba, 0x18,
+	0x57, 0x0b, 0x42, 0x8b, 0x78, 0x5a, 0x41, 0x7c, 0x19, 0x76, 0xc1, 0x1d, 0xdb, 0x25, 0xd8, 0xa7,
+	0xe6, 0xa5, 0xf6, 0x3c, 0x01, 0xde, 0x3f, 0x1f, 0x57, 0x8b, 0x4d, 0xb6, 0xc4, 0x0c, 0x64, 0x86,
+	0xdc, 0x17, 0x0e, 0x9e, 0x66, 0x28, 0xa8, 0x68, 0xa7, 0x80, 0x0e, 0xdc, 0xbd, 0x2c, 0x02, 0x19,
+	0x71, 0x74, 0x3c, 0x12, 0xea, 0x71, 0x11, 0xa9, 0x8b, 0x22, 0x52, 0x6f, 0x06, 0xc4, 0x57, 0xd7,
+	0xc4, 0xd1, 0xbd, 0xa1, 0x46, 0x50, 0x50, 0xe2, 0x5f, 0x66, 0x88, 0x3d, 0x8b, 0xf8, 0xc4, 0xef,
+	0x8b, 0xb0, 0x36, 0x66, 0x0e, 0xeb, 0x77, 0xd2, 0x5b, 0x4c, 0xe4, 0x29, 0xe8, 0x0e, 0x9f, 0x42,
+	0xc9, 0x0c, 0x7c, 0x06, 0x8a, 0x02, 0x75, 0x44, 0x5c, 0x17, 0x27, 0x91, 0xbe, 0x33, 0xf3, 0x96,
+	0xab, 0x53, 0x5b, 0x72, 0x61, 0x0a, 0x2a, 0xf0, 0xf1, 0x0e, 0x1b, 0xc2, 0xa7, 0xd3, 0x65, 0x30,
+	0x7b, 0x9b, 0xc7, 0x64, 0xe1, 0xb1, 0x5b, 0xab, 0xe4, 0x4b, 0x00, 0x53, 0xc3, 0xc4, 0x94, 0x1c,
+	0x33, 0xe5, 0xc9, 0xcc, 0xa6, 0xac, 0x5f, 0xdb, 0xee, 0xd2, 0x9e, 0xbb, 0xa9, 0x49, 0x61, 0x54,
+	0x07, 0x2c, 0xdb, 0x21, 0xb6, 0x28, 0x76, 0xca, 0x80, 0x19, 0x24, 0x5f, 0xcb, 0xde, 0x5e, 0xd2,
+	0x88, 0x2e, 0x2d, 0x5a, 0x11, 0xd1, 0xc5, 0x89, 0xca, 0xe7, 0x71, 0xde, 0x26, 0x62, 0xe0, 0x73,
+	0xb0, 0x16, 0x61, 0xf7, 0xc8, 0xa4, 0xa1, 0xe5, 0x60, 0x73, 0x10, 0xe2, 0x53, 0xec, 0x33, 0x87,
+	0xe5, 0x59, 0xc6, 0x6c, 0x4c, 0x67, 0x4c, 0x17, 0xbb, 0x47, 0xbd, 0x18, 0xd9, 0xb9, 0x04, 0xaa,
+	0xb5, 0x8b, 0x71, 0xf5, 0x3b, 0xe2, 0x40, 0x6e, 0x92, 0xa4, 0xa0, 0x7b, 0xd1, 0x75, 0x1a, 0x7c,
+	0x0e, 0x96, 0x1d, 0x3c, 0x08, 0x22, 0x42, 0xcb, 0x85, 0xda, 0xc2, 0xd7, 0x9f, 0x8d, 0x3a, 0x6d,
+	0x89, 0xe0, 0x29, 0x7f, 0x7a, 0x55, 0xdd, 0x7c, 0x0b, 0x47, 0xc7, 0x22, 0x22, 0x94, 0xec, 0x26,
+	0x2a, 0xcc, 0x5f, 0x25, 0x50, 0xd4, 0xcf, 0xb0, 0x7d, 0x12, 0x2b, 0xd3, 0x71, 0x2d, 0x1f, 0x6a,
+	0x20, 0xc3, 0xaa, 0xa5, 0xe8, 0xb0, 0xf5, 0xd9, 0xea, 0x2f, 0xe2, 0x64, 0xf8, 0x11, 0xc8, 0x1f,
+	0x91, 0x30, 0x12, 0x59, 0xcc, 0xea, 0x4e, 0x7e, 0xeb, 0xde, 0xb4, 0x17, 0x59, 0x3e, 0x23, 0xc0,
+	0x70, 0xec, 0x1b, 0x3e, 0x02, 0x85, 0x08, 0xdb, 0x81, 0xef, 0x08, 0xda, 0xc2, 0x9b, 0x69, 0x79,
+	0x0e, 0x64, 0x03, 0x61, 0xcb, 0xbf, 0xe7, 0x01, 0xd8, 0x67, 0x30, 0xcd, 0xa2, 0x16, 0xbc, 0x3f,
+	0x7d, 0x57, 0xb8, 0x4c, 0xfa, 0xda, 0x0d, 0x17, 0x83, 0xab, 0x17, 0x00, 0xe0, 0x5a, 0x11, 0x35,
+	0xb9, 0x1f, 0x78, 0x89, 0x7b, 0x30, 0x83, 0x0f, 0x72, 0x31, 0xbb, 0xc3, 0xfc, 0xf0, 0xf3, 0xb8,
+	0x23, 0x8b, 0x48, 0x2c, 0x2f, 0xde, 0x1a, 0xab, 0x8b, 0x2c, 0x2a, 0x27, 0x94, 0xb8, 0x80, 0x86,
+	0xf8, 0x08, 0x87, 0xd8, 0xb7, 0xb1, 0xd0, 0x27, 0x33, 0xb3, 0x3e, 0x2b, 0x97, 0x22, 0xb8, 0x52,
+	0x4d, 0x50, 0x38, 0xb6, 0x5c, 0x8a, 0x1d, 0xf3, 0xc4, 0xa7, 0xc4, 0x2d, 0x2f, 0xbd, 0xa5, 0x5e,
+	0x79, 0xce, 0x3a, 0x88, 0x49, 0xca, 0x9f, 0xb3, 0x20, 0xd7, 0xa5, 0xc1, 0xe0, 0xdb, 0xfe, 0xf4,
+	0xff, 0xeb, 0x4f, 0x57, 0x8a, 0xf7, 0xd2, 0x37, 0x56, 0xbc, 0x7f, 0x2d, 0x81, 0x92, 0x67, 0x9d,
+	0x11, 0xef, 0xc4, 0x33, 0x23, 0x97, 0x0c, 0x06, 0x56, 0x1f, 0x8b, 0x36, 0xf4, 0xd9, 0x6c, 0x09,
+	0x7f, 0x3e, 0xae, 0xe6, 0xf7, 0xad, 0xb3, 0xae, 0x10, 0x32, 0x69, 0x84, 0x57, 0xc5, 0x2b, 0xe8,
+	0x8e, 0x98, 0x4a, 0xb0, 0x71, 0x23, 0xa4, 0x21, 0xe9, 0xf7, 0x71, 0x28, 0x42, 0x3b, 0x3b, 0x73,
+	0x23, 0xe4, 0x57, 0xbe, 0x24, 0x22, 0xd2, 0xc2, 0x14, 0x54, 0x10, 0x63, 0x1e, 0xf4, 0x5b, 0x20,
+	0x27, 0xc6, 0xa2, 0x4d, 0x65, 0xd5, 0xd5, 0xd4, 0x6d, 0x37, 0x59, 0x52, 0xd0, 0x04, 0xf6, 0x6d,
+	0x9f, 0xf9, 0xc6, 0xfa, 0xcc, 0xef, 0xe6, 0x41, 0xb1, 0x87, 0x43, 0x8f, 0xf8, 0x96, 0xcb, 0x2b,
+	0xc6, 0x2f, 0x40, 0x86, 0x17, 0x79, 0xe9, 0x8d, 0x45, 0x5e, 0x5d, 0x15, 0x8a, 0x14, 0x52, 0x15,
+	0x24, 0xce, 0x5d, 0x26, 0xe0, 0x13, 0x90, 0x89, 0x5f, 0x59, 0x49, 0xd1, 0x28, 0xdf, 0x20, 0x20,
+	0x7e, 0xa0, 0xe1, 0x74, 0xf6, 0x33, 0x82, 0x82, 0x38, 0x31, 0x7e, 0x4d, 0x1e, 0x63, 0xd2, 0x3f,
+	0xa6, 0xac, 0x4c, 0x2c, 0xa4, 0x1f, 0x72, 0x7c, 0x5e, 0x41, 0x02, 0x00, 0xf7, 0xc1, 0x92, 0xed,
+	0x06, 0x11, 0x76, 0xde, 0xa2, 0x88, 0xaf, 0x4f, 0x27, 0x35, 0xe7, 0xf1, 0x38, 0x10, 0x42, 0x84,
+	0x53, 0x7e, 0xb3, 0x00, 0x96, 0x3a, 0x56, 0x68, 0x79, 0x11, 0xfc, 0x19, 0x28, 0xba, 0xb8, 0x6f,
+	0xd9, 0x2f, 0x4c, 0x76, 0x62, 0x11, 0xf3, 0x4a, 0x36, 0x5d, 0xee, 0xa6, 0x96, 0x15, 0x54, 0xe0,
+	0x63, 0x9d, 0x0d, 0xa1, 0x0a, 0xe2, 0xe4, 0x32, 0x83, 0x01, 0x16, 0xcf, 0xc3, 0x88, 0x79, 0xa5,
+	0xa8, 0xca, 0x93, 0xb2, 0x75, 0x05, 0xa0, 0xa0, 0xa2, 0x67, 0x9d, 0xb5, 0x07, 0x98, 0xbf, 0x1f,
+	0xe3, 0xb7, 0x6a, 0xe5, 0x0a, 0x24, 0x7e, 0xe2, 0x98, 0xe4, 0xf2, 0xb9, 0xcb, 0xbc, 0x54, 0x54,
+	0xdf, 0xbb, 0x18, 0x57, 0x7f, 0x70, 0xa3, 0xc8, 0x2b, 0x78, 0x05, 0xc9, 0x53, 0x3b, 0x74, 0x70,
+	0x98, 0x7a, 0xca, 0xff, 0x56, 0x02, 0x45, 0x46, 0x33, 0x93, 0xb8, 0x5c, 0xbc, 0x2d, 0x2e, 0x77,
+	0x85, 0x63, 0x57, 0xd3, 0x0d, 0xe5, 0x7f, 0x8a, 0xce, 0x02, 0xe3, 0x6a, 0x9c, 0xfa, 0xe0, 0x5f,
+	0x12, 0xc8, 0xa7, 0x9a, 0x0c, 0xac, 0x83, 0xf5, 0x9e, 0xb1, 0xaf, 0x9b, 0x46, 0xcb, 0xdc, 0x69,
+	0xa3, 0xa6, 0x6e, 0x1e, 0xb4, 0xba, 0x1d, 0xbd, 0x69, 0xec, 0x18, 0xba, 0x56, 0x9a, 0x93, 0xef,
+	0x0c, 0x47, 0xb5, 0xfc, 0x81, 0x1f, 0x0d, 0xb0, 0x4d, 0x8e, 0x08, 0x76, 0xe0, 0x23, 0x50, 0x99,
+	0xc6, 0x3f, 0x6e, 0xb7, 0x35, 0xb3, 0x67, 0xec, 0xed, 0x99, 0xcd, 0xed, 0x56, 0x53, 0xdf, 0x2b,
+	0x49, 0x32, 0x1c, 0x8e, 0x6a, 0x2b, 0x8f, 0x83, 0xc0, 0xe9, 0x11, 0xd7, 0x6d, 0x5a, 0xbe, 0x8d,
+	0x5d, 0xf8, 0x53, 0xb0, 0x31, 0xcd, 0x33, 0xf6, 0xf7, 0x75, 0xcd, 0xd8, 0xee, 0xe9, 0x66, 0x1b,
+	0x25, 0xd4, 0x79, 0x79, 0x6d, 0x38, 0xaa, 0xdd, 0x35, 0x3c, 0x0f, 0x3b, 0xc4, 0xa2, 0xb8, 0x1d,
+	0x0a, 0x76, 0x1d, 0xc8, 0xd3, 0xec, 0x9d, 0x78, 0xc3, 0x36, 0x32, 0x9f, 0x18, 0x7b, 0x7b, 0xa5,
+	0x05, 0x79, 0x65, 0x38, 0xaa, 0x81, 0xf8, 0xc2, 0xdc, 0x0e, 0x9f, 0x10, 0xd7, 0x95, 0x17, 0xff,
+	0xf8, 0x87, 0x8a, 0xf4, 0xe0, 0x62, 0x1e, 0xdc, 0xbb, 0xa1, 0xac, 0xc0, 0x47, 0x60, 0xa3, 0xab,
+	0xef, 0xed, 0x98, 0x3d, 0xb4, 0xad, 0xe9, 0x66, 0x07, 0xe9, 0x9f, 0xea, 0xad, 0x9e, 0xd1, 0x6e,
+	0xdd, 0x66, 0xfb, 0x26, 0x90, 0x6f, 0xe6, 0xb5, 0xda, 0x2d, 0xbd, 0x24, 0xc9, 0xd9, 0xe1, 0xa8,
+	0xb6, 0xd8, 0x0a, 0x7c, 0x0c, 0x7f, 0x02, 0xbe, 0x77, 0x33, 0x92, 0x1b, 0x6a, 0xb6, 0xf4, 0xa7,
+	0x7a, 0xb7, 0x57, 0x9a, 0x97, 0x4b, 0xc3, 0x51, 0xad, 0xc0, 0x8d, 0x6c, 0xe1, 0xe7, 0x38, 0xa2,
+	0xb7, 0x52, 0xdb, 0x7b, 0x5a, 0x4c, 0x5d, 0x48, 0x53, 0xdb, 0x6e, 0xdc, 0xf0, 0xe0, 0x8f, 0xc0,
+	0xc6, 0xd7, 0x52, 0xd5, 0x76, 0x6f, 0xb7, 0xb4, 0xc8, 0x9d, 0xc5, 0x89, 0x6a, 0x40, 0x8f, 0xe1,
+	0x0e, 0x78, 0x70, 0x33, 0x4d, 0xd3, 0x9b, 0x48, 0xdf, 0xd7, 0x5b, 0x3d, 0x73, 0xbb, 0xa5, 0x25,
+	0x67, 0x94, 0x91, 0xef, 0x0f, 0x47, 0x35, 0xa8, 0x61, 0x3b, 0xc4, 0x71, 0x70, 0x6f, 0xfb, 0x0e,
+	0x97, 0x25, 0x9c, 0xfe, 0x17, 0x09, 0x80, 0x49, 0x41, 0x82, 0x1f, 0x80, 0x77, 0xda, 0x48, 0xd3,
+	0x91, 0xd9, 0xed, 0xc5, 0x67, 0x7d, 0x8b, 0x87, 0x15, 0x00, 0xd3, 0xe8, 0xf8, 0x94, 0x75, 0xad,
+	0x24, 0xc9, 0x60, 0x38, 0xaa, 0x2d, 0x89, 0x07, 0xd1, 0x26, 0x58, 0x4b, 0x63, 0xb8, 0x5a, 0x31,
+	0x6c, 0x5e, 0x2e, 0x0e, 0x47, 0xb5, 0x1c, 0xd7, 0x26, 0x46, 0x7e, 0x1f, 0xdc, 0x4b, 0x23, 0xf5,
+	0xcf, 0x3a, 0x06, 0xd2, 0xb5, 0xd2, 0x82, 0x9c, 0x1f, 0x8e, 0x6a, 0xcb, 0xfa, 0xd9, 0x80, 0x84,
+	0xd8, 0x11, 0x6a, 0xff, 0x4d, 0x02, 0xa5, 0xab, 0xff, 0x76, 0xc1, 0x2d, 0xf0, 0x5d, 0xa3, 0xd5,
+	0xed, 0xa1, 0x03, 0xe6, 0x84, 0x58, 0xca, 0x41, 0xf7, 0xf6, 0x20, 0x29, 0x5f, 0xe7, 0x6c, 0x37,
+	0x7b, 0xc6, 0xa7, 0x7a, 0x62, 0xc8, 0xb6, 0x4d, 0xc9, 0x29, 0xbe, 0x19, 0xb9, 0xbb, 0xbd, 0xd7,
+	0x63, 0xb6, 0x30, 0xe4, 0x2e, 0xbb, 0x84, 0xc2, 0x0f, 0x80, 0x7c, 0x1d, 0xa9, 0xe9, 0x7b, 0x46,
+	0xb7, 0xc7, 0xec, 0x29, 0x0c, 0x47, 0xb5, 0xac, 0x86, 0x5d, 0x12, 0xd1, 0xc4, 0x20, 0x55, 0xff,
+	0xf2, 0xbc, 0x22, 0x7d, 0x75, 0x5e, 0x91, 0xfe, 0x79, 0x5e, 0x91, 0x3e, 0x7f, 0x5d, 0x99, 0xfb,
+	0xea, 0x75, 0x65, 0xee, 0xef, 0xaf, 0x2b, 0x73, 0xbf, 0x7c, 0x3f, 0x55, 0x3a, 0xf0, 0x87, 0x5e,
+	0xe0, 0xe3, 0x17, 0x0d, 0xec, 0x7d, 0xe8, 0x62, 0xa7, 0x8f, 0xc3, 0xc6, 0x59, 0xf2, 0x1f, 0x29,
+	0xab, 0x21, 0x87, 0x4b, 0xac, 0xe8, 0xff, 0xf0, 0xbf, 0x03, 0x00, 0xea, 0x32, 0x13, 0xd2, 0x3d,
+	0x15, 0x00, 0x00,
 }
 
 func (m *Instrument) Marshal() (dAtA []byte, err error) {
@@ -409,33 +937,91 @@ func (m *Instrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+	if m.BatchInterval != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.BatchInterval))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x48
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CoolingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CoolingPeriod):])
+	if err1 != nil {
+		return 0, err1
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i -= n1
+	i = encodeVarintMarket(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PriceBand.Size()
+		i -= size
+		if _, err := m.PriceBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinOrderSize.Size()
+		i -= size
+		if _, err := m.MinOrderSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Status != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -446,12 +1032,31 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err1 != nil {
-		return 0, err1
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	i -= n1
-	i = encodeVarintMarket(dAtA, i, uint64(n1))
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMarket(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -608,13 +1213,35 @@ func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HaltedUntil != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.HaltedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltedUntil):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMarket(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReferencePrice != nil {
+		{
+			size := m.ReferencePrice.Size()
+			i -= size
+			if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Timestamp != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintMarket(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintMarket(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -647,6 +1274,238 @@ func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StopOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMarket(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x52
+	if m.Triggered {
+		i--
+		if m.Triggered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClientOrderID) > 0 {
+		i -= len(m.ClientOrderID)
+		copy(dAtA[i:], m.ClientOrderID)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Closed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Closed):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintMarket(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderDeposit) > 0 {
+		for iNdEx := len(m.OrderDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxOpenOrdersPerInstrument != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxOpenOrdersPerInstrument))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOpenOrders != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxOpenOrders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LegacyEvents {
+		i--
+		if m.LegacyEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -672,6 +1531,22 @@ func (m *Instrument) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovMarket(uint64(m.Status))
+	}
+	l = m.MinOrderSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.TickSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.PriceBand.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CoolingPeriod)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.BatchInterval != 0 {
+		n += 1 + sovMarket(uint64(m.BatchInterval))
+	}
 	return n
 }
 
@@ -707,6 +1582,15 @@ func (m *Order) Size() (n int) {
 	n += 1 + l + sovMarket(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
 	n += 1 + l + sovMarket(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovMarket(uint64(m.SelfTradePrevention))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -751,13 +1635,109 @@ func (m *MarketData) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
 		n += 1 + l + sovMarket(uint64(l))
 	}
+	if m.ReferencePrice != nil {
+		l = m.ReferencePrice.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.HaltedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltedUntil)
+		n += 1 + l + sovMarket(uint64(l))
+	}
 	return n
 }
 
-func sovMarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarket(x uint64) (n int) {
+func (m *StopOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovMarket(uint64(m.TimeInForce))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.ClientOrderID)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Triggered {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovMarket(uint64(m.SelfTradePrevention))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TerminalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovMarket(uint64(m.State))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Closed)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LegacyEvents {
+		n += 2
+	}
+	if m.MaxOpenOrders != 0 {
+		n += 1 + sovMarket(uint64(m.MaxOpenOrders))
+	}
+	if m.MaxOpenOrdersPerInstrument != 0 {
+		n += 1 + sovMarket(uint64(m.MaxOpenOrdersPerInstrument))
+	}
+	if len(m.OrderDeposit) > 0 {
+		for _, e := range m.OrderDeposit {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarket(x uint64) (n int) {
 	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Instrument) Unmarshal(dAtA []byte) error {
@@ -770,28 +1750,1047 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowMarket
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Instrument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Instrument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= InstrumentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoolingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CoolingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchInterval", wireType)
+			}
+			m.BatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstOrder == nil {
+				m.FirstOrder = &Order{}
+			}
+			if err := m.FirstOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondOrder == nil {
+				m.SecondOrder = &Order{}
+			}
+			if err := m.SecondOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Instrument: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Instrument: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -819,13 +2818,17 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ReferencePrice = &v
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -835,23 +2838,27 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			if m.HaltedUntil == nil {
+				m.HaltedUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.HaltedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -874,7 +2881,7 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *StopOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -897,10 +2904,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1040,9 +3047,9 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1052,29 +3059,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1102,15 +3108,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1120,30 +3126,51 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Triggered = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1153,29 +3180,47 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1202,7 +3247,8 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1227,7 +3273,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
+func (m *TerminalOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,17 +3296,17 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminalOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1270,31 +3316,30 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstOrder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1304,31 +3349,33 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= OrderState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FirstOrder == nil {
-				m.FirstOrder = &Order{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.FirstOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1355,10 +3402,7 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecondOrder == nil {
-				m.SecondOrder = &Order{}
-			}
-			if err := m.SecondOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Closed, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1383,7 +3427,7 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketData) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,17 +3450,17 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyEvents", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1426,29 +3470,17 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.LegacyEvents = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrders", wireType)
 			}
-			var stringLen uint64
+			m.MaxOpenOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1458,29 +3490,16 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxOpenOrders |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrdersPerInstrument", wireType)
 			}
-			var stringLen uint64
+			m.MaxOpenOrdersPerInstrument = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1490,31 +3509,14 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxOpenOrdersPerInstrument |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1541,10 +3543,8 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderDeposit = append(m.OrderDeposit, types.Coin{})
+			if err := m.OrderDeposit[len(m.OrderDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17,6 +17,10 @@ var (
 	_ sdk.Msg = &MsgCancelOrder{}
 	_ sdk.Msg = &MsgCancelReplaceLimitOrder{}
 	_ sdk.Msg = &MsgCancelReplaceMarketOrder{}
+	_ sdk.Msg = &MsgAddStopLimitOrder{}
+	_ sdk.Msg = &MsgAddStopMarketOrder{}
+	_ sdk.Msg = &MsgSetSelfTradePrevention{}
+	_ sdk.Msg = &MsgSetInstrument{}
 )
 
 func (m MsgAddMarketOrder) Route() string {
@@ -49,6 +53,10 @@ func (m MsgAddMarketOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source, m.Destination.Denom)
 	}
 
+	if err := ValidateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
 	return validateClientOrderID(m.ClientOrderId)
 }
 
@@ -162,6 +170,10 @@ func (m MsgAddLimitOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
 	}
 
+	if err := ValidateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
 	return validateClientOrderID(m.ClientOrderId)
 }
 
@@ -229,3 +241,167 @@ func (m MsgCancelReplaceMarketOrder) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+func (m MsgAddStopLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddStopLimitOrder) Type() string {
+	return "add_stop_limit_order"
+}
+
+func (m MsgAddStopLimitOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if !m.Destination.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "destination amount is invalid: %v", m.Destination.String())
+	}
+
+	if !m.Source.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source amount is invalid: %v", m.Source.String())
+	}
+
+	if m.Source.Denom == m.Destination.Denom {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
+	}
+
+	if err := validateTriggerPrice(m.TriggerPrice); err != nil {
+		return err
+	}
+
+	if err := ValidateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	return validateClientOrderID(m.ClientOrderId)
+}
+
+func (m MsgAddStopLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddStopLimitOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgAddStopMarketOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddStopMarketOrder) Type() string {
+	return "add_stop_market_order"
+}
+
+func (m MsgAddStopMarketOrder) ValidateBasic() error {
+	if m.MaxSlippage.IsNil() || m.MaxSlippage.LT(sdk.ZeroDec()) {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "Cannot be negative")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if !m.Destination.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "destination amount is invalid: %v", m.Destination.String())
+	}
+
+	if err := sdk.ValidateDenom(m.Source); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source denomination is invalid: %v", m.Source)
+	}
+
+	if m.Source == m.Destination.Denom {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source, m.Destination.Denom)
+	}
+
+	if err := validateTriggerPrice(m.TriggerPrice); err != nil {
+		return err
+	}
+
+	if err := ValidateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	return validateClientOrderID(m.ClientOrderId)
+}
+
+func (m MsgAddStopMarketOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddStopMarketOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func validateTriggerPrice(price sdk.Dec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidTriggerPrice, "trigger price must be positive: %v", price)
+	}
+
+	return nil
+}
+
+func (m MsgSetSelfTradePrevention) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetSelfTradePrevention) Type() string {
+	return "set_self_trade_prevention"
+}
+
+func (m MsgSetSelfTradePrevention) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return ValidateSelfTradePrevention(m.SelfTradePrevention)
+}
+
+func (m MsgSetSelfTradePrevention) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetSelfTradePrevention) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgSetInstrument) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetInstrument) Type() string {
+	return "set_instrument"
+}
+
+func (m MsgSetInstrument) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return m.Instrument.Validate()
+}
+
+func (m MsgSetInstrument) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetInstrument) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -0,0 +1,75 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const DefaultParamspace = ModuleName
+
+var (
+	KeyLegacyEvents               = []byte("LegacyEvents")
+	KeyMaxOpenOrders              = []byte("MaxOpenOrders")
+	KeyMaxOpenOrdersPerInstrument = []byte("MaxOpenOrdersPerInstrument")
+	KeyOrderDeposit               = []byte("OrderDeposit")
+)
+
+// Legacy events remain enabled until the authority disables them.
+const DefaultLegacyEvents = true
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func DefaultParams() Params {
+	return Params{
+		LegacyEvents: DefaultLegacyEvents,
+		OrderDeposit: sdk.NewCoins(),
+	}
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyLegacyEvents, &p.LegacyEvents, validateBool),
+		paramtypes.NewParamSetPair(KeyMaxOpenOrders, &p.MaxOpenOrders, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxOpenOrdersPerInstrument, &p.MaxOpenOrdersPerInstrument, validateUint32),
+		paramtypes.NewParamSetPair(KeyOrderDeposit, &p.OrderDeposit, validateOrderDeposit),
+	}
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateUint32(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateOrderDeposit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid order deposit: %w", err)
+	}
+
+	return nil
+}
@@ -7,12 +7,16 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 func (q QueryInstrumentResponse) String() string {
 	sb := new(strings.Builder)
 
 	sb.WriteString(fmt.Sprintf("%v => %v\n", q.Source, q.Destination))
+	if q.HaltedUntil != nil {
+		sb.WriteString(fmt.Sprintf("halted until %v\n", q.HaltedUntil.Format(time.RFC3339)))
+	}
 
 	for _, order := range q.Orders {
 		sb.WriteString(order.String())
@@ -30,6 +34,15 @@ func (q QueryByAccountResponse) String() string {
 	for _, order := range q.Orders {
 		sb.WriteString(order.String())
 	}
+	for _, order := range q.StopOrders {
+		sb.WriteString(order.String())
+	}
+	if q.MaxOpenOrders > 0 || q.MaxOpenOrdersPerInstrument > 0 {
+		sb.WriteString(fmt.Sprintf("\nmax open orders: %v per instrument: %v", q.MaxOpenOrders, q.MaxOpenOrdersPerInstrument))
+	}
+	if !q.OrderDeposit.Empty() {
+		sb.WriteString(fmt.Sprintf("\norder deposit: %v", q.OrderDeposit))
+	}
 
 	return sb.String()
 }
@@ -44,5 +57,54 @@ func (q QueryInstrumentsResponse) String() string {
 }
 
 func (q QueryInstrumentsResponse_Element) String() string {
-	return fmt.Sprintf("%v => %v", q.Source, q.Destination)
+	s := fmt.Sprintf("%v => %v", q.Source, q.Destination)
+	if q.Status != InstrumentStatus_Unspecified {
+		s = fmt.Sprintf("%v %v min: %v tick: %v lot: %v", s, q.Status, q.MinOrderSize, q.TickSize, q.LotSize)
+	}
+
+	if q.HaltedUntil != nil {
+		s = fmt.Sprintf("%v halted until %v", s, q.HaltedUntil.Format(time.RFC3339))
+	}
+
+	if q.BatchInterval > 0 {
+		s = fmt.Sprintf("%v batch: %v", s, q.BatchInterval)
+	}
+
+	return s
+}
+
+func (q QueryQuoteResponse) String() string {
+	sb := new(strings.Builder)
+	sb.WriteString(fmt.Sprintf("%v => %v @ %v (fill or kill: %v)\n", q.SourceFilled, q.DestinationFilled, q.AveragePrice, q.FillOrKill))
+
+	for _, fill := range q.Fills {
+		sb.WriteString(fill.String())
+	}
+
+	return sb.String()
+}
+
+func (q QuoteFill) String() string {
+	return fmt.Sprintf(" - %v %v => %v\n", q.OrderID, q.Source, q.Destination)
+}
+
+func (q QueryOrderByIDResponse) String() string {
+	if q.Order != nil {
+		return q.Order.String()
+	}
+
+	if q.Terminal != nil {
+		return q.Terminal.String()
+	}
+
+	return ""
+}
+
+func (q QueryOrderHistoryResponse) String() string {
+	sb := new(strings.Builder)
+	for _, order := range q.Orders {
+		sb.WriteString(order.String())
+	}
+
+	return sb.String()
 }
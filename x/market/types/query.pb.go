@@ -6,8 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -80,7 +81,15 @@ func (m *QueryByAccountRequest) GetAddress() string {
 }
 
 type QueryByAccountResponse struct {
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty" yaml:"orders"`
+	Orders     []*Order     `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty" yaml:"orders"`
+	StopOrders []*StopOrder `protobuf:"bytes,2,rep,name=stop_orders,json=stopOrders,proto3" json:"stop_orders,omitempty" yaml:"stop_orders"`
+	// self_trade_prevention is the default of the account's orders.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,3,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// Limits on the orders of the account in the order book. Zero is unlimited.
+	MaxOpenOrders              uint32 `protobuf:"varint,4,opt,name=max_open_orders,json=maxOpenOrders,proto3" json:"max_open_orders,omitempty" yaml:"max_open_orders"`
+	MaxOpenOrdersPerInstrument uint32 `protobuf:"varint,5,opt,name=max_open_orders_per_instrument,json=maxOpenOrdersPerInstrument,proto3" json:"max_open_orders_per_instrument,omitempty" yaml:"max_open_orders_per_instrument"`
+	// order_deposit is charged for each new order that rests in the order book.
+	OrderDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=order_deposit,json=orderDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"order_deposit" yaml:"order_deposit"`
 }
 
 func (m *QueryByAccountResponse) Reset()      { *m = QueryByAccountResponse{} }
@@ -122,6 +131,41 @@ func (m *QueryByAccountResponse) GetOrders() []*Order {
 	return nil
 }
 
+func (m *QueryByAccountResponse) GetStopOrders() []*StopOrder {
+	if m != nil {
+		return m.StopOrders
+	}
+	return nil
+}
+
+func (m *QueryByAccountResponse) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
+}
+
+func (m *QueryByAccountResponse) GetMaxOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxOpenOrders
+	}
+	return 0
+}
+
+func (m *QueryByAccountResponse) GetMaxOpenOrdersPerInstrument() uint32 {
+	if m != nil {
+		return m.MaxOpenOrdersPerInstrument
+	}
+	return 0
+}
+
+func (m *QueryByAccountResponse) GetOrderDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OrderDeposit
+	}
+	return nil
+}
+
 type QueryInstrumentsRequest struct {
 }
 
@@ -207,6 +251,17 @@ type QueryInstrumentsResponse_Element struct {
 	LastPrice   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty" yaml:"last_price"`
 	BestPrice   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=best_price,json=bestPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"best_price,omitempty" yaml:"best_price"`
 	LastTraded  *time.Time                              `protobuf:"bytes,5,opt,name=last_traded,json=lastTraded,proto3,stdtime" json:"last_traded,omitempty" yaml:"last_traded"`
+	// Listing of the instrument. Sizes are only set for listed instruments.
+	Status         InstrumentStatus                        `protobuf:"varint,6,opt,name=status,proto3,enum=em.market.v1.InstrumentStatus" json:"status,omitempty" yaml:"status"`
+	MinOrderSize   *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_order_size,json=minOrderSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_size,omitempty" yaml:"min_order_size"`
+	TickSize       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size,omitempty" yaml:"tick_size"`
+	LotSize        *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size,omitempty" yaml:"lot_size"`
+	ReferencePrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price,omitempty" yaml:"reference_price"`
+	// Set while the instrument is halted by its circuit breaker.
+	HaltedUntil *time.Time `protobuf:"bytes,11,opt,name=halted_until,json=haltedUntil,proto3,stdtime" json:"halted_until,omitempty" yaml:"halted_until"`
+	// Number of blocks between batch auctions, or zero if orders are matched
+	// continuously.
+	BatchInterval uint32 `protobuf:"varint,12,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty" yaml:"batch_interval"`
 }
 
 func (m *QueryInstrumentsResponse_Element) Reset()      { *m = QueryInstrumentsResponse_Element{} }
@@ -262,6 +317,27 @@ func (m *QueryInstrumentsResponse_Element) GetLastTraded() *time.Time {
 	return nil
 }
 
+func (m *QueryInstrumentsResponse_Element) GetStatus() InstrumentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return InstrumentStatus_Unspecified
+}
+
+func (m *QueryInstrumentsResponse_Element) GetHaltedUntil() *time.Time {
+	if m != nil {
+		return m.HaltedUntil
+	}
+	return nil
+}
+
+func (m *QueryInstrumentsResponse_Element) GetBatchInterval() uint32 {
+	if m != nil {
+		return m.BatchInterval
+	}
+	return 0
+}
+
 type QueryInstrumentRequest struct {
 	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
@@ -318,6 +394,9 @@ type QueryInstrumentResponse struct {
 	Source      string               `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
 	Destination string               `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
 	Orders      []QueryOrderResponse `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders" yaml:"orders"`
+	// Set while the instrument is halted by its circuit breaker. Orders may
+	// rest, but are not matched.
+	HaltedUntil *time.Time `protobuf:"bytes,4,opt,name=halted_until,json=haltedUntil,proto3,stdtime" json:"halted_until,omitempty" yaml:"halted_until"`
 }
 
 func (m *QueryInstrumentResponse) Reset()      { *m = QueryInstrumentResponse{} }
@@ -373,6 +452,13 @@ func (m *QueryInstrumentResponse) GetOrders() []QueryOrderResponse {
 	return nil
 }
 
+func (m *QueryInstrumentResponse) GetHaltedUntil() *time.Time {
+	if m != nil {
+		return m.HaltedUntil
+	}
+	return nil
+}
+
 type QueryOrderResponse struct {
 	ID              uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
 	Owner           string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
@@ -449,751 +535,3160 @@ func (m *QueryOrderResponse) GetCreated() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*QueryByAccountRequest)(nil), "em.market.v1.QueryByAccountRequest")
-	proto.RegisterType((*QueryByAccountResponse)(nil), "em.market.v1.QueryByAccountResponse")
-	proto.RegisterType((*QueryInstrumentsRequest)(nil), "em.market.v1.QueryInstrumentsRequest")
-	proto.RegisterType((*QueryInstrumentsResponse)(nil), "em.market.v1.QueryInstrumentsResponse")
-	proto.RegisterType((*QueryInstrumentsResponse_Element)(nil), "em.market.v1.QueryInstrumentsResponse.Element")
-	proto.RegisterType((*QueryInstrumentRequest)(nil), "em.market.v1.QueryInstrumentRequest")
-	proto.RegisterType((*QueryInstrumentResponse)(nil), "em.market.v1.QueryInstrumentResponse")
-	proto.RegisterType((*QueryOrderResponse)(nil), "em.market.v1.QueryOrderResponse")
+// QueryQuoteRequest simulates a market order that spends source tokens to buy
+// the destination amount, such as MsgAddMarketOrder.
+type QueryQuoteRequest struct {
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	// destination is the amount to buy, e.g. 1000eeur.
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// maximum_slippage relative to the last traded price, defaults to zero.
+	MaximumSlippage string `protobuf:"bytes,3,opt,name=maximum_slippage,json=maximumSlippage,proto3" json:"maximum_slippage,omitempty" yaml:"maximum_slippage"`
 }
 
-func init() { proto.RegisterFile("em/market/v1/query.proto", fileDescriptor_80bf874bc4a5bd31) }
-
-var fileDescriptor_80bf874bc4a5bd31 = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0x71, 0x22, 0x8f, 0x5b, 0xda, 0x4e, 0x69, 0xea, 0x1a, 0xb4, 0x6b, 0x4d, 0x53,
-	0x2b, 0x88, 0x66, 0x47, 0x09, 0x08, 0xa1, 0x0a, 0x81, 0x58, 0x35, 0x95, 0x72, 0x6a, 0x59, 0x45,
-	0x42, 0xe2, 0x80, 0xb5, 0xde, 0x7d, 0x98, 0x55, 0xbd, 0x3b, 0xee, 0xce, 0x38, 0x60, 0x45, 0xb9,
-	0x20, 0xae, 0x48, 0x95, 0x38, 0xc0, 0x09, 0xf8, 0x06, 0x7c, 0x8d, 0x1c, 0x2b, 0x21, 0x24, 0xc4,
-	0x61, 0x41, 0x0e, 0x9f, 0xc0, 0x9f, 0x00, 0xed, 0xcc, 0xac, 0x77, 0x9d, 0x98, 0x18, 0x38, 0xf4,
-	0xe4, 0x9d, 0xf7, 0xe7, 0x37, 0xbf, 0xf7, 0xde, 0x6f, 0x9e, 0x51, 0x0b, 0x22, 0x1a, 0x79, 0xc9,
-	0x53, 0x10, 0xf4, 0x68, 0x97, 0x3e, 0x1b, 0x43, 0x32, 0xb1, 0x47, 0x09, 0x13, 0x0c, 0x5f, 0x81,
-	0xc8, 0x56, 0x1e, 0xfb, 0x68, 0xb7, 0xfd, 0xea, 0x80, 0x0d, 0x98, 0x74, 0xd0, 0xec, 0x4b, 0xc5,
-	0xb4, 0x4d, 0x9f, 0xf1, 0x88, 0x71, 0xda, 0xf7, 0x38, 0xd0, 0xa3, 0xdd, 0x3e, 0x08, 0x6f, 0x97,
-	0xfa, 0x2c, 0x8c, 0xb5, 0xff, 0xf5, 0x01, 0x63, 0x83, 0x21, 0x50, 0x6f, 0x14, 0x52, 0x2f, 0x8e,
-	0x99, 0xf0, 0x44, 0xc8, 0x62, 0xae, 0xbd, 0x96, 0xf6, 0xca, 0x53, 0x7f, 0xfc, 0x19, 0x15, 0x61,
-	0x04, 0x5c, 0x78, 0xd1, 0x48, 0x07, 0xdc, 0x59, 0x20, 0xa7, 0xc9, 0x48, 0x17, 0xd9, 0x47, 0xb7,
-	0x3e, 0xca, 0xc8, 0x3a, 0x93, 0x0f, 0x7d, 0x9f, 0x8d, 0x63, 0xe1, 0xc2, 0xb3, 0x31, 0x70, 0x81,
-	0xef, 0xa3, 0x0d, 0x2f, 0x08, 0x12, 0xe0, 0xbc, 0x65, 0x74, 0x8c, 0xed, 0x86, 0x83, 0x67, 0xa9,
-	0xf5, 0xca, 0xc4, 0x8b, 0x86, 0x0f, 0x88, 0x76, 0x10, 0x37, 0x0f, 0x21, 0x7d, 0xb4, 0x79, 0x1e,
-	0x86, 0x8f, 0x58, 0xcc, 0x01, 0x3b, 0x68, 0x9d, 0x25, 0x01, 0x24, 0x19, 0x4c, 0x6d, 0xbb, 0xb9,
-	0x77, 0xd3, 0x2e, 0xf7, 0xc3, 0x7e, 0x9c, 0xf9, 0x9c, 0x5b, 0xa7, 0xa9, 0x65, 0xcc, 0x52, 0xeb,
-	0xaa, 0xc2, 0x57, 0x09, 0xc4, 0xd5, 0x99, 0x0f, 0xd6, 0xbe, 0xff, 0xc9, 0xaa, 0x90, 0x3b, 0xe8,
-	0xb6, 0xbc, 0xe3, 0x20, 0xe6, 0x22, 0x19, 0x47, 0x10, 0x0b, 0xae, 0xc9, 0x92, 0x1f, 0xd6, 0x50,
-	0xeb, 0xa2, 0x4f, 0x33, 0x18, 0xa2, 0x66, 0x58, 0x98, 0x35, 0x0d, 0x7b, 0x91, 0xc6, 0x3f, 0x25,
-	0xdb, 0xfb, 0x43, 0xc8, 0x0c, 0x4e, 0xfb, 0x34, 0xb5, 0x2a, 0xb3, 0xd4, 0xc2, 0x8a, 0x61, 0x09,
-	0x90, 0xb8, 0x65, 0xf8, 0xf6, 0x37, 0x35, 0xb4, 0xa1, 0x93, 0xf0, 0x1b, 0x68, 0x9d, 0xb3, 0x71,
-	0xe2, 0x83, 0x6e, 0xe1, 0x8d, 0xa2, 0x44, 0x65, 0x27, 0xae, 0x0e, 0xc0, 0xef, 0xa2, 0x66, 0x00,
-	0x5c, 0x84, 0xb1, 0x9c, 0x6c, 0xab, 0x2a, 0xe3, 0x37, 0x8b, 0x0b, 0x4b, 0x4e, 0xe2, 0x96, 0x43,
-	0xf1, 0xa7, 0x08, 0x0d, 0x3d, 0x2e, 0x7a, 0xa3, 0x24, 0xf4, 0xa1, 0x55, 0x93, 0x89, 0x1f, 0xfc,
-	0x9e, 0x5a, 0xdd, 0x41, 0x28, 0x3e, 0x1f, 0xf7, 0x6d, 0x9f, 0x45, 0x54, 0xcb, 0x4b, 0xfd, 0xec,
-	0xf0, 0xe0, 0x29, 0x15, 0x93, 0x11, 0x70, 0xfb, 0x21, 0xf8, 0xb3, 0xd4, 0xba, 0xa1, 0xae, 0x28,
-	0x50, 0x88, 0xdb, 0xc8, 0x0e, 0x4f, 0xb2, 0xef, 0x0c, 0xbf, 0x0f, 0x73, 0xfc, 0xb5, 0xff, 0x8f,
-	0x5f, 0xa0, 0x10, 0xb7, 0x91, 0x1d, 0x14, 0xfe, 0xc7, 0xa8, 0x29, 0x6f, 0x16, 0x89, 0x17, 0x40,
-	0xd0, 0xaa, 0x77, 0x8c, 0xed, 0xe6, 0x5e, 0xdb, 0x56, 0x9a, 0xb6, 0x73, 0x4d, 0xdb, 0x87, 0xb9,
-	0xa6, 0x9d, 0x76, 0xd1, 0x95, 0x52, 0x22, 0x79, 0xfe, 0x87, 0x65, 0xb8, 0xb2, 0x15, 0x87, 0xd2,
-	0xa0, 0x54, 0xa3, 0xb5, 0xe3, 0x6a, 0x7d, 0x16, 0x23, 0xce, 0x75, 0xbe, 0xb9, 0x38, 0xa3, 0xf9,
-	0x40, 0x3a, 0x4b, 0x06, 0xb2, 0xd0, 0x78, 0xf2, 0xab, 0x71, 0x41, 0x90, 0x73, 0xcd, 0xbd, 0x94,
-	0xc9, 0x3f, 0x9e, 0x3f, 0xad, 0x9a, 0xd4, 0x74, 0x67, 0x89, 0xa6, 0xe5, 0xfb, 0xca, 0x69, 0xc9,
-	0x77, 0x56, 0x59, 0xf5, 0xce, 0x7e, 0xac, 0x21, 0x7c, 0x31, 0x17, 0xdf, 0x45, 0xd5, 0x30, 0x90,
-	0xe5, 0xac, 0x39, 0x37, 0xa7, 0xa9, 0x55, 0x3d, 0x78, 0x38, 0x4b, 0xad, 0x86, 0x7e, 0x0f, 0x01,
-	0x71, 0xab, 0x61, 0x80, 0xbb, 0xa8, 0xce, 0xbe, 0x88, 0x21, 0xd1, 0x65, 0x5c, 0x9f, 0xa5, 0xd6,
-	0x15, 0x7d, 0x57, 0x66, 0x26, 0xae, 0x72, 0xe3, 0x47, 0xe8, 0xba, 0x2a, 0xbf, 0x97, 0x40, 0xe4,
-	0x85, 0x71, 0x18, 0x0f, 0xb4, 0x74, 0x5f, 0x9b, 0xa5, 0xd6, 0xed, 0x72, 0xa7, 0x8a, 0x08, 0xe2,
-	0x5e, 0x53, 0x26, 0x37, 0xb7, 0xe0, 0x47, 0xe8, 0x9a, 0x3f, 0x0c, 0x21, 0x16, 0x3d, 0x59, 0x42,
-	0x2f, 0x0c, 0xb4, 0x42, 0x4d, 0xbd, 0x51, 0x36, 0x15, 0xd4, 0xb9, 0x20, 0xe2, 0x5e, 0x55, 0x16,
-	0x59, 0xe2, 0x41, 0x80, 0x0f, 0x51, 0x5d, 0xe9, 0xbb, 0x2e, 0xb3, 0xdf, 0xcf, 0xfa, 0xf4, 0x9f,
-	0x34, 0xae, 0xab, 0xd4, 0xf2, 0x56, 0x60, 0xf8, 0x09, 0xda, 0xf0, 0x13, 0xf0, 0x04, 0x04, 0xad,
-	0xf5, 0xd5, 0xb2, 0xd6, 0xb3, 0xd1, 0x3b, 0x56, 0x27, 0x2a, 0x59, 0xe7, 0x30, 0x6a, 0x42, 0x7b,
-	0x3f, 0xd7, 0x50, 0x5d, 0x4e, 0x08, 0x7f, 0x6d, 0xa0, 0xc6, 0x7c, 0xe7, 0xe2, 0xbb, 0x4b, 0x04,
-	0x70, 0x7e, 0xb1, 0xb7, 0xb7, 0x2e, 0x0f, 0x52, 0xd3, 0x26, 0xf7, 0xbf, 0xfa, 0xe5, 0xaf, 0x6f,
-	0xab, 0x5d, 0xbc, 0x45, 0x61, 0x27, 0x62, 0x31, 0x4c, 0x4a, 0x7f, 0x20, 0x9e, 0x8a, 0xa5, 0xc7,
-	0x7a, 0xfb, 0x9f, 0x64, 0x34, 0x9a, 0xa5, 0xed, 0x89, 0xef, 0xad, 0xda, 0xae, 0x8a, 0x4a, 0xf7,
-	0xdf, 0x2d, 0x61, 0xd2, 0x95, 0x64, 0x3a, 0xd8, 0x5c, 0x42, 0xa6, 0xb4, 0x7b, 0xf1, 0x77, 0x06,
-	0x42, 0x45, 0x3e, 0xde, 0xba, 0x14, 0x3e, 0x27, 0x71, 0x6f, 0x45, 0x94, 0xe6, 0xf0, 0x9e, 0xe4,
-	0xf0, 0x0e, 0x7e, 0xfb, 0x52, 0x0e, 0xf4, 0x58, 0x29, 0xf4, 0x84, 0x1e, 0x97, 0x5e, 0xea, 0x89,
-	0xb3, 0x7f, 0x3a, 0x35, 0x8d, 0x17, 0x53, 0xd3, 0xf8, 0x73, 0x6a, 0x1a, 0xcf, 0xcf, 0xcc, 0xca,
-	0x8b, 0x33, 0xb3, 0xf2, 0xdb, 0x99, 0x59, 0xf9, 0xe4, 0xcd, 0x92, 0xc4, 0x72, 0x64, 0x88, 0x76,
-	0x86, 0x10, 0x0c, 0x20, 0xa1, 0x5f, 0xe6, 0xb7, 0x48, 0xad, 0xf5, 0xd7, 0xa5, 0x6e, 0xde, 0xfa,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x42, 0x5e, 0xc4, 0x18, 0x6e, 0x08, 0x00, 0x00,
+func (m *QueryQuoteRequest) Reset()         { *m = QueryQuoteRequest{} }
+func (m *QueryQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteRequest) ProtoMessage()    {}
+func (*QueryQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{7}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	ByAccount(ctx context.Context, in *QueryByAccountRequest, opts ...grpc.CallOption) (*QueryByAccountResponse, error)
-	Instruments(ctx context.Context, in *QueryInstrumentsRequest, opts ...grpc.CallOption) (*QueryInstrumentsResponse, error)
-	Instrument(ctx context.Context, in *QueryInstrumentRequest, opts ...grpc.CallOption) (*QueryInstrumentResponse, error)
+func (m *QueryQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteRequest.Merge(m, src)
+}
+func (m *QueryQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ByAccount(ctx context.Context, in *QueryByAccountRequest, opts ...grpc.CallOption) (*QueryByAccountResponse, error) {
-	out := new(QueryByAccountResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Query/ByAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Instruments(ctx context.Context, in *QueryInstrumentsRequest, opts ...grpc.CallOption) (*QueryInstrumentsResponse, error) {
-	out := new(QueryInstrumentsResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Query/Instruments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQuoteRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Instrument(ctx context.Context, in *QueryInstrumentRequest, opts ...grpc.CallOption) (*QueryInstrumentResponse, error) {
-	out := new(QueryInstrumentResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Query/Instrument", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQuoteRequest) GetMaximumSlippage() string {
+	if m != nil {
+		return m.MaximumSlippage
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	ByAccount(context.Context, *QueryByAccountRequest) (*QueryByAccountResponse, error)
-	Instruments(context.Context, *QueryInstrumentsRequest) (*QueryInstrumentsResponse, error)
-	Instrument(context.Context, *QueryInstrumentRequest) (*QueryInstrumentResponse, error)
+type QueryQuoteResponse struct {
+	// fills are the expected trades against passive orders. Synthetic
+	// instruments are filled in two legs.
+	Fills             []QuoteFill `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills" yaml:"fills"`
+	SourceFilled      types.Coin  `protobuf:"bytes,2,opt,name=source_filled,json=sourceFilled,proto3" json:"source_filled" yaml:"source_filled"`
+	DestinationFilled types.Coin  `protobuf:"bytes,3,opt,name=destination_filled,json=destinationFilled,proto3" json:"destination_filled" yaml:"destination_filled"`
+	// average_price in destination per source, nil if nothing is filled.
+	AveragePrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=average_price,json=averagePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_price,omitempty" yaml:"average_price"`
+	LastPrice    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty" yaml:"last_price"`
+	// slippage of the average price relative to the last price, where a
+	// positive value is a worse price.
+	Slippage *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage,omitempty" yaml:"slippage"`
+	// fill_or_kill reports whether the order would be filled entirely.
+	FillOrKill bool `protobuf:"varint,7,opt,name=fill_or_kill,json=fillOrKill,proto3" json:"fill_or_kill,omitempty" yaml:"fill_or_kill"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryQuoteResponse) Reset()      { *m = QueryQuoteResponse{} }
+func (*QueryQuoteResponse) ProtoMessage() {}
+func (*QueryQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{8}
 }
-
-func (*UnimplementedQueryServer) ByAccount(ctx context.Context, req *QueryByAccountRequest) (*QueryByAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ByAccount not implemented")
+func (m *QueryQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Instruments(ctx context.Context, req *QueryInstrumentsRequest) (*QueryInstrumentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Instruments not implemented")
+func (m *QueryQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Instrument(ctx context.Context, req *QueryInstrumentRequest) (*QueryInstrumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Instrument not implemented")
+func (m *QueryQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteResponse.DiscardUnknown(m)
 }
 
-func _Query_ByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ByAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Query/ByAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ByAccount(ctx, req.(*QueryByAccountRequest))
+var xxx_messageInfo_QueryQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteResponse) GetFills() []QuoteFill {
+	if m != nil {
+		return m.Fills
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Instruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInstrumentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Instruments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Query/Instruments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Instruments(ctx, req.(*QueryInstrumentsRequest))
+func (m *QueryQuoteResponse) GetSourceFilled() types.Coin {
+	if m != nil {
+		return m.SourceFilled
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_Instrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInstrumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryQuoteResponse) GetDestinationFilled() types.Coin {
+	if m != nil {
+		return m.DestinationFilled
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Instrument(ctx, in)
+	return types.Coin{}
+}
+
+func (m *QueryQuoteResponse) GetFillOrKill() bool {
+	if m != nil {
+		return m.FillOrKill
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Query/Instrument",
+	return false
+}
+
+type QuoteFill struct {
+	OrderID uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	// source is the amount paid to the passive order.
+	Source types.Coin `protobuf:"bytes,2,opt,name=source,proto3" json:"source" yaml:"source"`
+	// destination is the amount received from the passive order.
+	Destination types.Coin `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+}
+
+func (m *QuoteFill) Reset()      { *m = QuoteFill{} }
+func (*QuoteFill) ProtoMessage() {}
+func (*QuoteFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{9}
+}
+func (m *QuoteFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Instrument(ctx, req.(*QueryInstrumentRequest))
+}
+func (m *QuoteFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteFill.Merge(m, src)
+}
+func (m *QuoteFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteFill proto.InternalMessageInfo
+
+func (m *QuoteFill) GetOrderID() uint64 {
+	if m != nil {
+		return m.OrderID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "em.market.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ByAccount",
-			Handler:    _Query_ByAccount_Handler,
-		},
-		{
-			MethodName: "Instruments",
-			Handler:    _Query_Instruments_Handler,
-		},
-		{
-			MethodName: "Instrument",
-			Handler:    _Query_Instrument_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "em/market/v1/query.proto",
+func (m *QuoteFill) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
+	}
+	return types.Coin{}
 }
 
-func (m *QueryByAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuoteFill) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOrderByIDRequest struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
 }
 
-func (m *QueryByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOrderByIDRequest) Reset()         { *m = QueryOrderByIDRequest{} }
+func (m *QueryOrderByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderByIDRequest) ProtoMessage()    {}
+func (*QueryOrderByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{10}
+}
+func (m *QueryOrderByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOrderByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderByIDRequest.Merge(m, src)
+}
+func (m *QueryOrderByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderByIDRequest.DiscardUnknown(m)
 }
 
-func (m *QueryByAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderByIDRequest proto.InternalMessageInfo
+
+func (m *QueryOrderByIDRequest) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryByAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderByIDResponse holds either the order in the order book, or the
+// terminal state of the order if it is retained in the node's order history.
+type QueryOrderByIDResponse struct {
+	Order    *Order         `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty" yaml:"order"`
+	Terminal *TerminalOrder `protobuf:"bytes,2,opt,name=terminal,proto3" json:"terminal,omitempty" yaml:"terminal"`
 }
 
-func (m *QueryByAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOrderByIDResponse) Reset()      { *m = QueryOrderByIDResponse{} }
+func (*QueryOrderByIDResponse) ProtoMessage() {}
+func (*QueryOrderByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{11}
+}
+func (m *QueryOrderByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOrderByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderByIDResponse.Merge(m, src)
+}
+func (m *QueryOrderByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderByIDResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInstrumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderByIDResponse proto.InternalMessageInfo
+
+func (m *QueryOrderByIDResponse) GetOrder() *Order {
+	if m != nil {
+		return m.Order
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryInstrumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderByIDResponse) GetTerminal() *TerminalOrder {
+	if m != nil {
+		return m.Terminal
+	}
+	return nil
 }
 
-func (m *QueryInstrumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryOrderHistoryRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryInstrumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrderHistoryRequest) Reset()         { *m = QueryOrderHistoryRequest{} }
+func (m *QueryOrderHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderHistoryRequest) ProtoMessage()    {}
+func (*QueryOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{12}
+}
+func (m *QueryOrderHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryInstrumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderHistoryRequest.Merge(m, src)
+}
+func (m *QueryOrderHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInstrumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Instruments) > 0 {
-		for iNdEx := len(m.Instruments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Instruments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryOrderHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryOrderHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryInstrumentsResponse_Element) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrderHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryInstrumentsResponse_Element) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderHistoryResponse lists the terminal orders of the account, most
+// recently closed first.
+type QueryOrderHistoryResponse struct {
+	Orders     []TerminalOrder     `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders" yaml:"orders"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryInstrumentsResponse_Element) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastTraded != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastTraded, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastTraded):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BestPrice != nil {
-		{
-			size := m.BestPrice.Size()
-			i -= size
-			if _, err := m.BestPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.LastPrice != nil {
-		{
-			size := m.LastPrice.Size()
-			i -= size
-			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOrderHistoryResponse) Reset()      { *m = QueryOrderHistoryResponse{} }
+func (*QueryOrderHistoryResponse) ProtoMessage() {}
+func (*QueryOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80bf874bc4a5bd31, []int{13}
+}
+func (m *QueryOrderHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInstrumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryOrderHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryInstrumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInstrumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryOrderHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryOrderHistoryResponse) GetOrders() []TerminalOrder {
+	if m != nil {
+		return m.Orders
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryOrderHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryInstrumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryByAccountRequest)(nil), "em.market.v1.QueryByAccountRequest")
+	proto.RegisterType((*QueryByAccountResponse)(nil), "em.market.v1.QueryByAccountResponse")
+	proto.RegisterType((*QueryInstrumentsRequest)(nil), "em.market.v1.QueryInstrumentsRequest")
+	proto.RegisterType((*QueryInstrumentsResponse)(nil), "em.market.v1.QueryInstrumentsResponse")
+	proto.RegisterType((*QueryInstrumentsResponse_Element)(nil), "em.market.v1.QueryInstrumentsResponse.Element")
+	proto.RegisterType((*QueryInstrumentRequest)(nil), "em.market.v1.QueryInstrumentRequest")
+	proto.RegisterType((*QueryInstrumentResponse)(nil), "em.market.v1.QueryInstrumentResponse")
+	proto.RegisterType((*QueryOrderResponse)(nil), "em.market.v1.QueryOrderResponse")
+	proto.RegisterType((*QueryQuoteRequest)(nil), "em.market.v1.QueryQuoteRequest")
+	proto.RegisterType((*QueryQuoteResponse)(nil), "em.market.v1.QueryQuoteResponse")
+	proto.RegisterType((*QuoteFill)(nil), "em.market.v1.QuoteFill")
+	proto.RegisterType((*QueryOrderByIDRequest)(nil), "em.market.v1.QueryOrderByIDRequest")
+	proto.RegisterType((*QueryOrderByIDResponse)(nil), "em.market.v1.QueryOrderByIDResponse")
+	proto.RegisterType((*QueryOrderHistoryRequest)(nil), "em.market.v1.QueryOrderHistoryRequest")
+	proto.RegisterType((*QueryOrderHistoryResponse)(nil), "em.market.v1.QueryOrderHistoryResponse")
+}
+
+func init() { proto.RegisterFile("em/market/v1/query.proto", fileDescriptor_80bf874bc4a5bd31) }
+
+var fileDescriptor_80bf874bc4a5bd31 = []byte{
+	// 1840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x77, 0xdb, 0x9e, 0xb1, 0xa7, 0x66, 0x6c, 0xef, 0x96, 0xd7, 0xf6, 0x78, 0xb2, 0x9a, 0x76,
+	0x6a, 0x77, 0x1d, 0x87, 0x64, 0xa7, 0xe5, 0x05, 0x01, 0x89, 0x80, 0x40, 0xaf, 0xd7, 0x59, 0xc3,
+	0x61, 0x9d, 0x5e, 0xa3, 0x40, 0x40, 0x8c, 0xda, 0xd3, 0xe5, 0xd9, 0x92, 0xfb, 0x6b, 0xbb, 0x6b,
+	0x9c, 0x75, 0x56, 0x2b, 0x24, 0x40, 0x02, 0x0e, 0x48, 0x2b, 0x71, 0x20, 0x37, 0x38, 0x73, 0xcb,
+	0x85, 0x33, 0x27, 0xb4, 0xc7, 0x48, 0x5c, 0x10, 0x87, 0x0e, 0xf2, 0x72, 0x8f, 0x34, 0x7f, 0x41,
+	0xd4, 0x55, 0xaf, 0x3f, 0x3d, 0xf6, 0xd8, 0x51, 0x94, 0x8b, 0x3d, 0x5d, 0xef, 0xbd, 0xdf, 0x7b,
+	0xf5, 0x3e, 0x7e, 0x55, 0xdd, 0xa8, 0x49, 0x1d, 0xcd, 0x31, 0x83, 0x43, 0xca, 0xb5, 0xa3, 0x4d,
+	0xed, 0xf1, 0x80, 0x06, 0xc7, 0x1d, 0x3f, 0xf0, 0xb8, 0x87, 0x1b, 0xd4, 0xe9, 0x48, 0x49, 0xe7,
+	0x68, 0xb3, 0x75, 0xad, 0xef, 0xf5, 0x3d, 0x21, 0xd0, 0xe2, 0x5f, 0x52, 0xa7, 0xd5, 0xee, 0x79,
+	0xa1, 0xe3, 0x85, 0xda, 0xbe, 0x19, 0x52, 0xed, 0x68, 0x73, 0x9f, 0x72, 0x73, 0x53, 0xeb, 0x79,
+	0xcc, 0x05, 0xf9, 0x37, 0xf2, 0x72, 0x01, 0x9e, 0x6a, 0xf9, 0x66, 0x9f, 0xb9, 0x26, 0x67, 0x5e,
+	0xa2, 0x7b, 0xbd, 0xef, 0x79, 0x7d, 0x9b, 0x6a, 0xa6, 0xcf, 0x34, 0xd3, 0x75, 0x3d, 0x2e, 0x84,
+	0x21, 0x48, 0x55, 0x90, 0x8a, 0xa7, 0xfd, 0xc1, 0x81, 0xc6, 0x99, 0x43, 0x43, 0x6e, 0x3a, 0x3e,
+	0x28, 0xac, 0x16, 0x36, 0x02, 0x81, 0x0b, 0x11, 0xb9, 0x87, 0x96, 0xde, 0x8b, 0x7d, 0xeb, 0xc7,
+	0x3f, 0xea, 0xf5, 0xbc, 0x81, 0xcb, 0x0d, 0xfa, 0x78, 0x40, 0x43, 0x8e, 0xdf, 0x44, 0x33, 0xa6,
+	0x65, 0x05, 0x34, 0x0c, 0x9b, 0xca, 0x9a, 0xb2, 0x51, 0xd3, 0xf1, 0x30, 0x52, 0xe7, 0x8f, 0x4d,
+	0xc7, 0x7e, 0x9b, 0x80, 0x80, 0x18, 0x89, 0x0a, 0xf9, 0x7c, 0x1a, 0x2d, 0x97, 0x71, 0x42, 0xdf,
+	0x73, 0x43, 0x8a, 0x75, 0x54, 0xf5, 0x02, 0x8b, 0x06, 0x31, 0xce, 0xd4, 0x46, 0xfd, 0xce, 0x62,
+	0x27, 0x9f, 0xbc, 0xce, 0x83, 0x58, 0xa6, 0x2f, 0xbd, 0x88, 0x54, 0x65, 0x18, 0xa9, 0x73, 0xd2,
+	0x81, 0x34, 0x20, 0x06, 0x58, 0xe2, 0x3d, 0x54, 0x0f, 0xb9, 0xe7, 0x77, 0x01, 0x68, 0x52, 0x00,
+	0xad, 0x14, 0x81, 0x1e, 0x72, 0xcf, 0x97, 0x60, 0x2d, 0x00, 0xc3, 0x12, 0x2c, 0x67, 0x49, 0x0c,
+	0x14, 0x26, 0x6a, 0x21, 0xfe, 0x10, 0x2d, 0x85, 0xd4, 0x3e, 0xe8, 0xf2, 0xc0, 0xb4, 0x68, 0xd7,
+	0x0f, 0xe8, 0x11, 0x75, 0xe3, 0xbc, 0x36, 0xa7, 0xd6, 0x94, 0x8d, 0xf9, 0x3b, 0xaf, 0x96, 0xf0,
+	0xa9, 0x7d, 0xb0, 0x17, 0x6b, 0xee, 0xa6, 0x8a, 0xfa, 0xda, 0x30, 0x52, 0xaf, 0x83, 0x97, 0x51,
+	0x48, 0xc4, 0x58, 0x0c, 0x4f, 0x9b, 0x61, 0x1d, 0x2d, 0x38, 0xe6, 0x93, 0xae, 0xe7, 0x53, 0x37,
+	0xd9, 0xd2, 0xf4, 0x9a, 0xb2, 0x31, 0xa7, 0xb7, 0x86, 0x91, 0xba, 0x2c, 0xf1, 0x4a, 0x0a, 0xc4,
+	0x98, 0x73, 0xcc, 0x27, 0x0f, 0x7c, 0xea, 0x42, 0xf0, 0x0e, 0x6a, 0x97, 0x54, 0xba, 0x3e, 0x0d,
+	0xba, 0xcc, 0x0d, 0x79, 0x30, 0x70, 0xa8, 0xcb, 0x9b, 0x15, 0x01, 0xf9, 0xfa, 0x30, 0x52, 0x6f,
+	0x8d, 0x84, 0x2c, 0xe9, 0x13, 0xa3, 0x55, 0xf0, 0xb0, 0x4b, 0x83, 0x9d, 0x54, 0x88, 0xff, 0xa0,
+	0xa0, 0x39, 0x61, 0xd6, 0xb5, 0xa8, 0xef, 0x85, 0x8c, 0x37, 0xab, 0xa2, 0x08, 0xab, 0x1d, 0xd9,
+	0xc6, 0x9d, 0xb8, 0x8d, 0x3b, 0xd0, 0xc0, 0x9d, 0xbb, 0x1e, 0x73, 0xf5, 0xfb, 0x2f, 0x22, 0x75,
+	0x62, 0x18, 0xa9, 0xd7, 0x72, 0x35, 0x4d, 0xac, 0xc9, 0xdf, 0x3f, 0x53, 0x37, 0xfa, 0x8c, 0x3f,
+	0x1a, 0xec, 0x77, 0x7a, 0x9e, 0xa3, 0xc1, 0x2c, 0xc8, 0x7f, 0xb7, 0x43, 0xeb, 0x50, 0xe3, 0xc7,
+	0x3e, 0x0d, 0x05, 0x50, 0x68, 0x34, 0x84, 0xed, 0x96, 0x34, 0x7d, 0x7b, 0xfa, 0xe3, 0xbf, 0xa9,
+	0x13, 0x64, 0x15, 0xad, 0x88, 0x86, 0xcb, 0x62, 0x0c, 0xa1, 0x75, 0xc9, 0xc7, 0x35, 0xd4, 0x3c,
+	0x2d, 0x83, 0x76, 0xb4, 0x51, 0x3d, 0xdb, 0x73, 0xd2, 0x93, 0x9d, 0x62, 0xa9, 0xcf, 0x32, 0xee,
+	0xdc, 0xb3, 0x69, 0xbc, 0xa0, 0xb7, 0x60, 0x6b, 0xd0, 0x61, 0x39, 0x40, 0x62, 0xe4, 0xe1, 0x5b,
+	0xbf, 0x9f, 0x45, 0x33, 0x60, 0x84, 0x5f, 0x47, 0xd5, 0xd0, 0x1b, 0x04, 0x3d, 0x0a, 0x03, 0x75,
+	0x35, 0xeb, 0x77, 0xb9, 0x4e, 0x0c, 0x50, 0xc0, 0xdf, 0x45, 0x75, 0x8b, 0x86, 0x1c, 0x48, 0xa0,
+	0x39, 0x29, 0xf4, 0x97, 0x33, 0x87, 0x39, 0x21, 0x31, 0xf2, 0xaa, 0xf8, 0x57, 0x08, 0xd9, 0x66,
+	0xc8, 0xbb, 0x7e, 0xc0, 0x7a, 0x54, 0x34, 0x72, 0x4d, 0x7f, 0xe7, 0xbf, 0x91, 0xba, 0x7e, 0x81,
+	0x64, 0x6f, 0xd1, 0xde, 0x30, 0x52, 0xaf, 0x4a, 0x17, 0x19, 0x0a, 0x31, 0x6a, 0xf1, 0xc3, 0x6e,
+	0xfc, 0x3b, 0xc6, 0xdf, 0xa7, 0x29, 0xfe, 0xf4, 0x97, 0xc7, 0xcf, 0x50, 0x88, 0x51, 0xdb, 0xa7,
+	0x09, 0xfe, 0xfb, 0xa8, 0x2e, 0x3c, 0x8b, 0x49, 0xb2, 0x44, 0x0f, 0xd7, 0xef, 0xb4, 0x3a, 0x92,
+	0xe1, 0x3a, 0x09, 0xc3, 0x75, 0xf6, 0x12, 0x86, 0xd3, 0x5b, 0x59, 0x56, 0x72, 0x86, 0xe4, 0xf9,
+	0x67, 0xaa, 0x62, 0x88, 0x54, 0x88, 0xe1, 0xb3, 0xf0, 0x0e, 0xaa, 0x86, 0xdc, 0xe4, 0x83, 0xb0,
+	0x59, 0x15, 0xd3, 0xdd, 0x2e, 0x96, 0x3c, 0xab, 0xf6, 0x43, 0xa1, 0x55, 0xa8, 0x8e, 0x58, 0x89,
+	0xab, 0x23, 0x7e, 0x60, 0x86, 0xe6, 0x1d, 0x06, 0x53, 0xd4, 0x0d, 0xd9, 0x47, 0xb4, 0x39, 0x23,
+	0xf2, 0x70, 0xf7, 0x82, 0x79, 0xd8, 0x71, 0xf9, 0x30, 0x52, 0x97, 0x60, 0x28, 0x0b, 0x48, 0xc4,
+	0x68, 0x38, 0x4c, 0x0e, 0xe0, 0x43, 0xf6, 0x11, 0xc5, 0xbf, 0x40, 0x35, 0xce, 0x7a, 0x87, 0xd2,
+	0xcb, 0xac, 0xf0, 0xf2, 0x83, 0x4b, 0x65, 0xfb, 0x8a, 0xf4, 0x92, 0x82, 0x10, 0x63, 0x36, 0xfe,
+	0x2d, 0xc0, 0x7f, 0x86, 0x66, 0x6d, 0x8f, 0x4b, 0xec, 0x9a, 0xc0, 0xfe, 0xfe, 0xa5, 0x76, 0xb0,
+	0x00, 0x69, 0x07, 0x0c, 0x62, 0xcc, 0xd8, 0x1e, 0x17, 0xc8, 0x0e, 0x5a, 0x08, 0xe8, 0x01, 0x0d,
+	0xa8, 0xdb, 0xa3, 0xd0, 0x2a, 0x48, 0x38, 0xd8, 0xba, 0x54, 0xf0, 0x40, 0x85, 0x25, 0x28, 0x62,
+	0xcc, 0xa7, 0x2b, 0xb2, 0x69, 0x3e, 0x40, 0x8d, 0x47, 0xa6, 0xcd, 0xa9, 0xd5, 0x1d, 0xb8, 0x9c,
+	0xd9, 0xcd, 0xfa, 0xd8, 0xae, 0x79, 0x65, 0x18, 0xa9, 0x8b, 0x12, 0x3d, 0x6f, 0x29, 0xdb, 0xa6,
+	0x2e, 0x97, 0x7e, 0x1a, 0xaf, 0xe0, 0x1f, 0xa2, 0xf9, 0x7d, 0x93, 0xf7, 0x1e, 0x75, 0x99, 0xcb,
+	0x69, 0x70, 0x64, 0xda, 0xcd, 0x86, 0xe0, 0xd5, 0xd5, 0xac, 0x84, 0x45, 0x39, 0x31, 0xe6, 0xc4,
+	0xc2, 0x0e, 0x3c, 0x4b, 0xbe, 0x92, 0x7f, 0x89, 0x01, 0xc7, 0x64, 0xd6, 0x6e, 0xc9, 0x79, 0xbb,
+	0x5c, 0x64, 0x87, 0x94, 0x0a, 0xd6, 0x46, 0x50, 0x41, 0x61, 0xe4, 0xc9, 0x27, 0x93, 0xa7, 0xa8,
+	0x30, 0x65, 0xbb, 0xaf, 0x85, 0x73, 0x1e, 0xa4, 0x27, 0xfc, 0x94, 0x60, 0xd3, 0xb5, 0x11, 0x6c,
+	0x2a, 0x5a, 0x3a, 0x09, 0x4b, 0x5f, 0x02, 0xfe, 0x3c, 0xe3, 0xb8, 0x2f, 0xd7, 0x73, 0xfa, 0xab,
+	0xab, 0x27, 0xd4, 0xe1, 0xaf, 0x53, 0x08, 0x9f, 0x8e, 0x0b, 0xdf, 0x40, 0x93, 0xcc, 0x12, 0xa9,
+	0x9a, 0xd6, 0x17, 0x4f, 0x22, 0x75, 0x72, 0x67, 0x6b, 0x18, 0xa9, 0x35, 0x60, 0x79, 0x8b, 0x18,
+	0x93, 0xcc, 0xc2, 0xeb, 0xa8, 0xe2, 0x7d, 0xe8, 0xd2, 0x00, 0x52, 0x74, 0x65, 0x18, 0xa9, 0x0d,
+	0xd8, 0x47, 0xbc, 0x4c, 0x0c, 0x29, 0xc6, 0xdb, 0xe8, 0x8a, 0x4c, 0x6d, 0x37, 0xa0, 0x8e, 0xc9,
+	0x5c, 0xe6, 0xf6, 0x81, 0x90, 0xe3, 0x68, 0x57, 0xf2, 0x55, 0xc8, 0x34, 0x88, 0xb1, 0x20, 0x97,
+	0x8c, 0x64, 0x05, 0x6f, 0xa3, 0x85, 0x9e, 0xcd, 0xa8, 0xcb, 0x81, 0x27, 0x98, 0x05, 0xbc, 0xdb,
+	0x86, 0x7b, 0x0e, 0x8c, 0x49, 0x49, 0x89, 0x18, 0x73, 0x72, 0x45, 0x6c, 0x71, 0xc7, 0xc2, 0x7b,
+	0xa8, 0x22, 0x47, 0xb1, 0x22, 0x79, 0x24, 0xae, 0xc1, 0xa5, 0xc6, 0x11, 0x76, 0x09, 0x43, 0x28,
+	0xc1, 0xf0, 0x2e, 0x9a, 0xe9, 0x05, 0xd4, 0xe4, 0xd4, 0x6a, 0x56, 0xc7, 0x96, 0x29, 0x39, 0x37,
+	0xe1, 0x1e, 0x09, 0x86, 0xb2, 0x4a, 0x09, 0x0c, 0x54, 0xe8, 0x9f, 0x0a, 0xba, 0x2a, 0x2a, 0xf4,
+	0xde, 0xc0, 0xe3, 0x34, 0x99, 0x92, 0xaf, 0xa5, 0x9f, 0xb7, 0xd1, 0x15, 0xc7, 0x7c, 0xc2, 0x9c,
+	0x81, 0xd3, 0x0d, 0x6d, 0xe6, 0xfb, 0x66, 0x9f, 0x9e, 0x2e, 0x5c, 0x59, 0x83, 0x18, 0x0b, 0xb0,
+	0xf4, 0x30, 0x59, 0xf9, 0x63, 0x05, 0xe1, 0xfc, 0x16, 0xa0, 0xc9, 0xee, 0xa2, 0xca, 0x01, 0xb3,
+	0xed, 0xe4, 0xee, 0xb1, 0x52, 0x9e, 0x16, 0x8f, 0xd3, 0x6d, 0x66, 0xdb, 0xfa, 0x35, 0x48, 0x16,
+	0xa4, 0x5d, 0xd8, 0x10, 0x43, 0xda, 0xe2, 0x5f, 0xa2, 0x39, 0x68, 0x9d, 0xf8, 0x99, 0x5a, 0x62,
+	0x7f, 0xe7, 0x5e, 0xc7, 0xae, 0x17, 0xaf, 0x63, 0x05, 0x6b, 0x62, 0x34, 0xe4, 0xf3, 0xb6, 0x78,
+	0xc4, 0x87, 0x08, 0xe7, 0x12, 0x92, 0xb8, 0x98, 0x1a, 0xe7, 0xe2, 0x55, 0x70, 0xb1, 0x7a, 0x2a,
+	0xc3, 0xa9, 0x9f, 0xab, 0xb9, 0x45, 0x70, 0xd6, 0x47, 0x73, 0xe6, 0x11, 0x0d, 0xcc, 0x3e, 0x2d,
+	0xdc, 0x2a, 0xf4, 0x4b, 0xf5, 0x26, 0xec, 0xaa, 0x00, 0x44, 0x8c, 0x06, 0x3c, 0xa7, 0x77, 0x97,
+	0xdc, 0xdd, 0xa8, 0xf2, 0x95, 0xdf, 0x8d, 0x7e, 0x8e, 0x66, 0xd3, 0x7e, 0xa9, 0x5e, 0xea, 0x3c,
+	0x95, 0xe8, 0x70, 0x9e, 0x66, 0x1d, 0x95, 0xc2, 0xe1, 0xb7, 0x50, 0x23, 0xce, 0x60, 0xd7, 0x0b,
+	0xba, 0x87, 0xcc, 0xb6, 0xc5, 0x85, 0x63, 0x56, 0x5f, 0xc9, 0x58, 0x2f, 0x2f, 0x25, 0x06, 0x8a,
+	0x1f, 0x1f, 0x04, 0x3f, 0x61, 0x76, 0x42, 0x78, 0x9f, 0x2b, 0xa8, 0x96, 0xb6, 0x16, 0x7e, 0x0b,
+	0xcd, 0xa6, 0x5c, 0x22, 0xd9, 0xae, 0x7d, 0x12, 0xa9, 0x33, 0x92, 0x29, 0xb6, 0xb2, 0x50, 0x32,
+	0x2e, 0x99, 0xf1, 0x80, 0x45, 0xee, 0xa7, 0x13, 0x38, 0xb6, 0xe3, 0x4a, 0x2c, 0x5f, 0x1e, 0xd0,
+	0xf7, 0x8b, 0x03, 0x3a, 0xb6, 0xbb, 0x4a, 0x97, 0xee, 0x33, 0xe7, 0x17, 0x76, 0xfc, 0x2e, 0xbc,
+	0xd9, 0xca, 0x77, 0xc2, 0xe3, 0x9d, 0xad, 0x84, 0x43, 0x3a, 0xa7, 0x36, 0xbf, 0x78, 0xde, 0x8e,
+	0xc9, 0x27, 0x0a, 0x5a, 0x2e, 0x23, 0xc1, 0x28, 0xbf, 0x83, 0x2a, 0x42, 0x4b, 0xe0, 0x9c, 0xf1,
+	0x6a, 0x7b, 0x0d, 0x58, 0xba, 0x91, 0x73, 0x10, 0x9f, 0x11, 0xf1, 0x7f, 0xbc, 0x8b, 0x66, 0x39,
+	0x0d, 0x1c, 0xe6, 0x9a, 0x36, 0xe4, 0xf3, 0x95, 0x22, 0xc6, 0x1e, 0x48, 0x25, 0xd6, 0x0a, 0x60,
+	0x41, 0xb0, 0x89, 0x69, 0x7c, 0xa9, 0x83, 0x9f, 0xb0, 0xf9, 0xe7, 0x0a, 0xbc, 0x02, 0x09, 0xbb,
+	0xfb, 0x2c, 0xe4, 0x5e, 0x70, 0xfc, 0xa5, 0x5e, 0xed, 0xf1, 0x36, 0x42, 0xd9, 0xf7, 0x08, 0x08,
+	0x72, 0xbd, 0x50, 0x25, 0xf9, 0x65, 0x24, 0xa9, 0xd5, 0xae, 0xd9, 0x4f, 0xe8, 0xda, 0xc8, 0x59,
+	0x92, 0x7f, 0x28, 0x68, 0x75, 0x44, 0x48, 0x90, 0xc9, 0x1f, 0x97, 0xbe, 0x12, 0x9c, 0x9b, 0x86,
+	0x31, 0xd7, 0x87, 0x77, 0x47, 0x44, 0xfc, 0xda, 0xd8, 0x88, 0x65, 0x20, 0xf9, 0x90, 0x65, 0x2e,
+	0xef, 0xfc, 0xab, 0x8a, 0x2a, 0x22, 0x70, 0xfc, 0x3b, 0x05, 0xd5, 0xd2, 0x0f, 0x1c, 0xf8, 0xc6,
+	0x88, 0x6b, 0x4e, 0xf9, 0x33, 0x4a, 0xeb, 0xe6, 0xf9, 0x4a, 0xd2, 0x29, 0x79, 0xf3, 0x37, 0xff,
+	0xfe, 0xff, 0x9f, 0x27, 0xd7, 0xf1, 0x4d, 0x8d, 0xde, 0x76, 0x3c, 0x97, 0x1e, 0xe7, 0x3e, 0xd7,
+	0x98, 0x52, 0x57, 0x7b, 0x0a, 0x05, 0x79, 0x16, 0x87, 0x51, 0xcf, 0xbd, 0x9d, 0xe2, 0x5b, 0xe3,
+	0xde, 0x5e, 0x65, 0x28, 0xeb, 0x17, 0x7b, 0xc9, 0x25, 0xeb, 0x22, 0x98, 0x35, 0xdc, 0x1e, 0x11,
+	0x4c, 0xee, 0xdd, 0x16, 0xff, 0x45, 0x41, 0x28, 0xb3, 0xc7, 0x37, 0xcf, 0x85, 0x4f, 0x82, 0xb8,
+	0x35, 0x46, 0x0b, 0x62, 0xf8, 0x9e, 0x88, 0xe1, 0xdb, 0xf8, 0x5b, 0xe7, 0xc6, 0xa0, 0x3d, 0x95,
+	0x54, 0xf2, 0x4c, 0x7b, 0x9a, 0x9b, 0xff, 0x67, 0xf8, 0xb7, 0x4a, 0x5c, 0x31, 0x8f, 0x53, 0xac,
+	0x8e, 0x70, 0x97, 0xbf, 0x50, 0xb4, 0xd6, 0xce, 0x56, 0x80, 0x50, 0xbe, 0x23, 0x42, 0xd9, 0xc4,
+	0xda, 0x88, 0x50, 0x1e, 0xc7, 0x9a, 0x67, 0x45, 0xf1, 0x6b, 0x54, 0x11, 0xed, 0x3a, 0xb2, 0x51,
+	0xca, 0xac, 0xd4, 0xba, 0x79, 0xbe, 0x12, 0x04, 0xf3, 0x86, 0x08, 0xe6, 0x16, 0xbe, 0x31, 0x22,
+	0x18, 0xd1, 0xfd, 0xda, 0xd3, 0x84, 0xc1, 0x9e, 0xe1, 0x3f, 0x29, 0xa8, 0x91, 0x1f, 0x36, 0xbc,
+	0x7e, 0x96, 0x8f, 0x22, 0x41, 0xb4, 0x5e, 0x1b, 0xab, 0x77, 0x81, 0xbe, 0x7d, 0x24, 0x75, 0xb3,
+	0xbe, 0xd5, 0xef, 0xbd, 0x38, 0x69, 0x2b, 0x9f, 0x9e, 0xb4, 0x95, 0xff, 0x9d, 0xb4, 0x95, 0xe7,
+	0x2f, 0xdb, 0x13, 0x9f, 0xbe, 0x6c, 0x4f, 0xfc, 0xe7, 0x65, 0x7b, 0xe2, 0x83, 0x37, 0x72, 0x67,
+	0x64, 0x82, 0x44, 0x9d, 0xdb, 0x36, 0xb5, 0xfa, 0x34, 0xd0, 0x9e, 0x24, 0xa8, 0xe2, 0xb0, 0xdc,
+	0xaf, 0x8a, 0x8b, 0xe5, 0x37, 0xbf, 0x18, 0x00, 0xd6, 0x6b, 0x24, 0x66, 0x9f, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	ByAccount(ctx context.Context, in *QueryByAccountRequest, opts ...grpc.CallOption) (*QueryByAccountResponse, error)
+	Instruments(ctx context.Context, in *QueryInstrumentsRequest, opts ...grpc.CallOption) (*QueryInstrumentsResponse, error)
+	Instrument(ctx context.Context, in *QueryInstrumentRequest, opts ...grpc.CallOption) (*QueryInstrumentResponse, error)
+	Quote(ctx context.Context, in *QueryQuoteRequest, opts ...grpc.CallOption) (*QueryQuoteResponse, error)
+	Order(ctx context.Context, in *QueryOrderByIDRequest, opts ...grpc.CallOption) (*QueryOrderByIDResponse, error)
+	OrderHistory(ctx context.Context, in *QueryOrderHistoryRequest, opts ...grpc.CallOption) (*QueryOrderHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ByAccount(ctx context.Context, in *QueryByAccountRequest, opts ...grpc.CallOption) (*QueryByAccountResponse, error) {
+	out := new(QueryByAccountResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Query/ByAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryInstrumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Instruments(ctx context.Context, in *QueryInstrumentsRequest, opts ...grpc.CallOption) (*QueryInstrumentsResponse, error) {
+	out := new(QueryInstrumentsResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Query/Instruments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryInstrumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (c *queryClient) Instrument(ctx context.Context, in *QueryInstrumentRequest, opts ...grpc.CallOption) (*QueryInstrumentResponse, error) {
+	out := new(QueryInstrumentResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Query/Instrument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *queryClient) Quote(ctx context.Context, in *QueryQuoteRequest, opts ...grpc.CallOption) (*QueryQuoteResponse, error) {
+	out := new(QueryQuoteResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Query/Quote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Order(ctx context.Context, in *QueryOrderByIDRequest, opts ...grpc.CallOption) (*QueryOrderByIDResponse, error) {
+	out := new(QueryOrderByIDResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Query/Order", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OrderHistory(ctx context.Context, in *QueryOrderHistoryRequest, opts ...grpc.CallOption) (*QueryOrderHistoryResponse, error) {
+	out := new(QueryOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Query/OrderHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	ByAccount(context.Context, *QueryByAccountRequest) (*QueryByAccountResponse, error)
+	Instruments(context.Context, *QueryInstrumentsRequest) (*QueryInstrumentsResponse, error)
+	Instrument(context.Context, *QueryInstrumentRequest) (*QueryInstrumentResponse, error)
+	Quote(context.Context, *QueryQuoteRequest) (*QueryQuoteResponse, error)
+	Order(context.Context, *QueryOrderByIDRequest) (*QueryOrderByIDResponse, error)
+	OrderHistory(context.Context, *QueryOrderHistoryRequest) (*QueryOrderHistoryResponse, error)
 }
 
-func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x22
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ByAccount(ctx context.Context, req *QueryByAccountRequest) (*QueryByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByAccount not implemented")
+}
+func (*UnimplementedQueryServer) Instruments(ctx context.Context, req *QueryInstrumentsRequest) (*QueryInstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Instruments not implemented")
+}
+func (*UnimplementedQueryServer) Instrument(ctx context.Context, req *QueryInstrumentRequest) (*QueryInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Instrument not implemented")
+}
+func (*UnimplementedQueryServer) Quote(ctx context.Context, req *QueryQuoteRequest) (*QueryQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quote not implemented")
+}
+func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderByIDRequest) (*QueryOrderByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
+}
+func (*UnimplementedQueryServer) OrderHistory(ctx context.Context, req *QueryOrderHistoryRequest) (*QueryOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SourceRemaining) > 0 {
-		i -= len(m.SourceRemaining)
-		copy(dAtA[i:], m.SourceRemaining)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceRemaining)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).ByAccount(ctx, in)
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Query/ByAccount",
 	}
-	if m.ID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ByAccount(ctx, req.(*QueryByAccountRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_Instruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryByAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).Instruments(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Query/Instruments",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Instruments(ctx, req.(*QueryInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryByAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Instrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Instrument(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Query/Instrument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Instrument(ctx, req.(*QueryInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInstrumentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryInstrumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).Quote(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.Instruments) > 0 {
-		for _, e := range m.Instruments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Query/Quote",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Quote(ctx, req.(*QueryQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInstrumentsResponse_Element) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Order(ctx, in)
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Query/Order",
 	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Order(ctx, req.(*QueryOrderByIDRequest))
 	}
-	if m.BestPrice != nil {
-		l = m.BestPrice.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LastTraded != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastTraded)
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OrderHistory(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Query/OrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderHistory(ctx, req.(*QueryOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInstrumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "em.market.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ByAccount",
+			Handler:    _Query_ByAccount_Handler,
+		},
+		{
+			MethodName: "Instruments",
+			Handler:    _Query_Instruments_Handler,
+		},
+		{
+			MethodName: "Instrument",
+			Handler:    _Query_Instrument_Handler,
+		},
+		{
+			MethodName: "Quote",
+			Handler:    _Query_Quote_Handler,
+		},
+		{
+			MethodName: "Order",
+			Handler:    _Query_Order_Handler,
+		},
+		{
+			MethodName: "OrderHistory",
+			Handler:    _Query_OrderHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "em/market/v1/query.proto",
+}
+
+func (m *QueryByAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInstrumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryByAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OrderDeposit) > 0 {
+		for iNdEx := len(m.OrderDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxOpenOrdersPerInstrument != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOpenOrdersPerInstrument))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxOpenOrders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOpenOrders))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StopOrders) > 0 {
+		for iNdEx := len(m.StopOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StopOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInstrumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstrumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstrumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstrumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SourceRemaining)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstrumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstrumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Instruments) > 0 {
+		for iNdEx := len(m.Instruments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Instruments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstrumentsResponse_Element) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstrumentsResponse_Element) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstrumentsResponse_Element) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.HaltedUntil != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.HaltedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltedUntil):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ReferencePrice != nil {
+		{
+			size := m.ReferencePrice.Size()
+			i -= size
+			if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LotSize != nil {
+		{
+			size := m.LotSize.Size()
+			i -= size
+			if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TickSize != nil {
+		{
+			size := m.TickSize.Size()
+			i -= size
+			if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MinOrderSize != nil {
+		{
+			size := m.MinOrderSize.Size()
+			i -= size
+			if _, err := m.MinOrderSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastTraded != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastTraded, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastTraded):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BestPrice != nil {
+		{
+			size := m.BestPrice.Size()
+			i -= size
+			if _, err := m.BestPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastPrice != nil {
+		{
+			size := m.LastPrice.Size()
+			i -= size
+			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstrumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstrumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstrumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstrumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstrumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstrumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltedUntil != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.HaltedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltedUntil):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceRemaining) > 0 {
+		i -= len(m.SourceRemaining)
+		copy(dAtA[i:], m.SourceRemaining)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceRemaining)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaximumSlippage) > 0 {
+		i -= len(m.MaximumSlippage)
+		copy(dAtA[i:], m.MaximumSlippage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaximumSlippage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FillOrKill {
+		i--
+		if m.FillOrKill {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Slippage != nil {
+		{
+			size := m.Slippage.Size()
+			i -= size
+			if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastPrice != nil {
+		{
+			size := m.LastPrice.Size()
+			i -= size
+			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AveragePrice != nil {
+		{
+			size := m.AveragePrice.Size()
+			i -= size
+			if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.DestinationFilled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SourceFilled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuoteFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Terminal != nil {
+		{
+			size, err := m.Terminal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryByAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.StopOrders) > 0 {
+		for _, e := range m.StopOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovQuery(uint64(m.SelfTradePrevention))
+	}
+	if m.MaxOpenOrders != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOpenOrders))
+	}
+	if m.MaxOpenOrdersPerInstrument != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOpenOrdersPerInstrument))
+	}
+	if len(m.OrderDeposit) > 0 {
+		for _, e := range m.OrderDeposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInstrumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInstrumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Instruments) > 0 {
+		for _, e := range m.Instruments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInstrumentsResponse_Element) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BestPrice != nil {
+		l = m.BestPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastTraded != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastTraded)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.MinOrderSize != nil {
+		l = m.MinOrderSize.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TickSize != nil {
+		l = m.TickSize.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LotSize != nil {
+		l = m.LotSize.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ReferencePrice != nil {
+		l = m.ReferencePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HaltedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltedUntil)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchInterval != 0 {
+		n += 1 + sovQuery(uint64(m.BatchInterval))
+	}
+	return n
+}
+
+func (m *QueryInstrumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInstrumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.HaltedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltedUntil)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceRemaining)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaximumSlippage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SourceFilled.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DestinationFilled.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AveragePrice != nil {
+		l = m.AveragePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Slippage != nil {
+		l = m.Slippage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FillOrKill {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuoteFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderID != 0 {
+		n += 1 + sovQuery(uint64(m.OrderID))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryOrderByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Terminal != nil {
+		l = m.Terminal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryByAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopOrders = append(m.StopOrders, &StopOrder{})
+			if err := m.StopOrders[len(m.StopOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrders", wireType)
+			}
+			m.MaxOpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrdersPerInstrument", wireType)
+			}
+			m.MaxOpenOrdersPerInstrument = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrdersPerInstrument |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderDeposit = append(m.OrderDeposit, types.Coin{})
+			if err := m.OrderDeposit[len(m.OrderDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstrumentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstrumentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstrumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstrumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstrumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstrumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instruments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instruments = append(m.Instruments, QueryInstrumentsResponse_Element{})
+			if err := m.Instruments[len(m.Instruments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Element: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Element: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.BestPrice = &v
+			if err := m.BestPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTraded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTraded == nil {
+				m.LastTraded = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastTraded, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= InstrumentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinOrderSize = &v
+			if err := m.MinOrderSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TickSize = &v
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.LotSize = &v
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ReferencePrice = &v
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HaltedUntil == nil {
+				m.HaltedUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.HaltedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchInterval", wireType)
+			}
+			m.BatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstrumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstrumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstrumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstrumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstrumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstrumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, QueryOrderResponse{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HaltedUntil == nil {
+				m.HaltedUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.HaltedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryByAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1216,15 +3711,34 @@ func (m *QueryByAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1252,7 +3766,138 @@ func (m *QueryByAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRemaining = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1275,7 +3920,7 @@ func (m *QueryByAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryByAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1298,17 +3943,81 @@ func (m *QueryByAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1318,25 +4027,23 @@ func (m *QueryByAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaximumSlippage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1359,7 +4066,7 @@ func (m *QueryByAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInstrumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1382,65 +4089,49 @@ func (m *QueryInstrumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInstrumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInstrumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInstrumentsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Fills = append(m.Fills, QuoteFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInstrumentsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInstrumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instruments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1467,64 +4158,46 @@ func (m *QueryInstrumentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instruments = append(m.Instruments, QueryInstrumentsResponse_Element{})
-			if err := m.Instruments[len(m.Instruments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Element: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Element: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1552,11 +4225,15 @@ func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.AveragePrice = &v
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1584,11 +4261,15 @@ func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1617,16 +4298,105 @@ func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slippage = &v
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillOrKill", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FillOrKill = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			m.OrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1636,31 +4406,28 @@ func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.BestPrice = &v
-			if err := m.BestPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTraded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1687,10 +4454,7 @@ func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTraded == nil {
-				m.LastTraded = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastTraded, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1715,7 +4479,7 @@ func (m *QueryInstrumentsResponse_Element) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInstrumentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1738,49 +4502,17 @@ func (m *QueryInstrumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInstrumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInstrumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1790,24 +4522,11 @@ func (m *QueryInstrumentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1829,7 +4548,7 @@ func (m *QueryInstrumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInstrumentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderByIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1852,17 +4571,17 @@ func (m *QueryInstrumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInstrumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderByIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInstrumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1872,59 +4591,31 @@ func (m *QueryInstrumentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Order == nil {
+				m.Order = &Order{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1951,8 +4642,10 @@ func (m *QueryInstrumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, QueryOrderResponse{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Terminal == nil {
+				m.Terminal = &TerminalOrder{}
+			}
+			if err := m.Terminal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1977,7 +4670,7 @@ func (m *QueryInstrumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,34 +4693,15 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2055,13 +4729,13 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2071,61 +4745,83 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceRemaining = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2135,29 +4831,29 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, TerminalOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2184,7 +4880,10 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32,11 +32,12 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgAddLimitOrder struct {
-	Owner         string      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	ClientOrderId string      `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
-	TimeInForce   TimeInForce `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Source        types.Coin  `protobuf:"bytes,4,opt,name=source,proto3" json:"source" yaml:"source"`
-	Destination   types.Coin  `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	Owner               string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId       string              `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce         TimeInForce         `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              types.Coin          `protobuf:"bytes,4,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination         types.Coin          `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,6,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
 }
 
 func (m *MsgAddLimitOrder) Reset()         { *m = MsgAddLimitOrder{} }
@@ -107,6 +108,13 @@ func (m *MsgAddLimitOrder) GetDestination() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddLimitOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
+}
+
 type MsgAddLimitOrderResponse struct {
 }
 
@@ -144,12 +152,13 @@ func (m *MsgAddLimitOrderResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgAddLimitOrderResponse proto.InternalMessageInfo
 
 type MsgAddMarketOrder struct {
-	Owner         string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	ClientOrderId string                                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
-	TimeInForce   TimeInForce                            `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Source        string                                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
-	Destination   types.Coin                             `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
-	MaxSlippage   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	Owner               string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId       string                                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              string                                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination         types.Coin                             `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,7,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
 }
 
 func (m *MsgAddMarketOrder) Reset()         { *m = MsgAddMarketOrder{} }
@@ -220,6 +229,13 @@ func (m *MsgAddMarketOrder) GetDestination() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddMarketOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
+}
+
 type MsgAddMarketOrderResponse struct {
 }
 
@@ -585,918 +601,2719 @@ func (m *MsgCancelReplaceMarketOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelReplaceMarketOrderResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddLimitOrder)(nil), "em.market.v1.MsgAddLimitOrder")
-	proto.RegisterType((*MsgAddLimitOrderResponse)(nil), "em.market.v1.MsgAddLimitOrderResponse")
-	proto.RegisterType((*MsgAddMarketOrder)(nil), "em.market.v1.MsgAddMarketOrder")
-	proto.RegisterType((*MsgAddMarketOrderResponse)(nil), "em.market.v1.MsgAddMarketOrderResponse")
-	proto.RegisterType((*MsgCancelOrder)(nil), "em.market.v1.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "em.market.v1.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgCancelReplaceLimitOrder)(nil), "em.market.v1.MsgCancelReplaceLimitOrder")
-	proto.RegisterType((*MsgCancelReplaceLimitOrderResponse)(nil), "em.market.v1.MsgCancelReplaceLimitOrderResponse")
-	proto.RegisterType((*MsgCancelReplaceMarketOrder)(nil), "em.market.v1.MsgCancelReplaceMarketOrder")
-	proto.RegisterType((*MsgCancelReplaceMarketOrderResponse)(nil), "em.market.v1.MsgCancelReplaceMarketOrderResponse")
+type MsgAddStopLimitOrder struct {
+	Owner               string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId       string                                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              types.Coin                             `protobuf:"bytes,4,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination         types.Coin                             `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	TriggerPrice        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,7,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
 }
 
-func init() { proto.RegisterFile("em/market/v1/tx.proto", fileDescriptor_636272ab2288df51) }
-
-var fileDescriptor_636272ab2288df51 = []byte{
-	// 780 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0x0f, 0xd4, 0x4d, 0x3f, 0x52, 0xd3, 0x0f, 0xc7, 0x45, 0x76, 0x65, 0x4a, 0x49,
-	0x85, 0x6a, 0x93, 0x70, 0x41, 0xdc, 0x48, 0x01, 0x51, 0x89, 0x50, 0x61, 0x90, 0x8a, 0x7a, 0x89,
-	0x1c, 0x7b, 0x6b, 0x56, 0xf5, 0x7a, 0x83, 0xed, 0xb4, 0xa9, 0xc4, 0x8d, 0x3f, 0xc0, 0x89, 0xdf,
-	0xd4, 0x63, 0x8f, 0x88, 0x83, 0x85, 0xd2, 0x7f, 0x90, 0x23, 0x87, 0x0a, 0xd9, 0xeb, 0x04, 0xc7,
-	0x69, 0xd2, 0x52, 0x95, 0x1e, 0x10, 0xa7, 0x36, 0x3b, 0xef, 0xbd, 0x19, 0xcd, 0x8c, 0xdf, 0x2e,
-	0x58, 0x80, 0x58, 0xc1, 0x9a, 0xb3, 0x0f, 0x3d, 0xe5, 0xa0, 0xac, 0x78, 0x6d, 0xb9, 0xe9, 0x10,
-	0x8f, 0xb0, 0x53, 0x10, 0xcb, 0xf4, 0x58, 0x3e, 0x28, 0xf3, 0xf3, 0x26, 0x31, 0x49, 0x18, 0x50,
-	0x82, 0xff, 0x28, 0x86, 0x17, 0x74, 0xe2, 0x62, 0xe2, 0x2a, 0x0d, 0xcd, 0x85, 0xca, 0x41, 0xb9,
-	0x01, 0x3d, 0xad, 0xac, 0xe8, 0x04, 0xd9, 0x51, 0xbc, 0x38, 0x20, 0x1d, 0xa9, 0xd1, 0x90, 0x68,
-	0x12, 0x62, 0x5a, 0x50, 0x09, 0x7f, 0x35, 0x5a, 0x7b, 0x8a, 0x87, 0x30, 0x74, 0x3d, 0x0d, 0x37,
-	0x29, 0x40, 0xfa, 0x39, 0x01, 0x0a, 0x35, 0xd7, 0x7c, 0x6a, 0x18, 0xaf, 0x10, 0x46, 0xde, 0xb6,
-	0x63, 0x40, 0x87, 0x5d, 0x03, 0x59, 0x72, 0x68, 0x43, 0x87, 0x63, 0x56, 0x98, 0xd2, 0x64, 0xb5,
-	0xd0, 0xf5, 0xc5, 0xa9, 0x23, 0x0d, 0x5b, 0x4f, 0xa4, 0xf0, 0x58, 0x52, 0x69, 0x98, 0xad, 0x82,
-	0x59, 0xdd, 0x42, 0xd0, 0xf6, 0xea, 0x24, 0xe0, 0xd5, 0x91, 0xc1, 0x4d, 0x84, 0x0c, 0xbe, 0xeb,
-	0x8b, 0x8b, 0x94, 0x91, 0x00, 0x48, 0xea, 0x34, 0x3d, 0x09, 0x33, 0x6d, 0x19, 0xec, 0x0e, 0x98,
-	0x0e, 0x6a, 0xaa, 0x23, 0xbb, 0xbe, 0x47, 0x1c, 0x1d, 0x72, 0xe9, 0x15, 0xa6, 0x34, 0x53, 0x29,
-	0xca, 0xf1, 0xc6, 0xc8, 0xef, 0x10, 0x86, 0x5b, 0xf6, 0x8b, 0x00, 0x50, 0xe5, 0xba, 0xbe, 0x38,
-	0x4f, 0xc5, 0x07, 0x98, 0x92, 0x9a, 0xf7, 0x7e, 0xc3, 0xd8, 0x97, 0x20, 0xe7, 0x92, 0x56, 0xa0,
-	0x98, 0x59, 0x61, 0x4a, 0xf9, 0x4a, 0x51, 0xa6, 0x6d, 0x94, 0x83, 0x36, 0xca, 0x51, 0x1b, 0xe5,
-	0x4d, 0x82, 0xec, 0xea, 0xc2, 0xb1, 0x2f, 0xa6, 0xba, 0xbe, 0x38, 0x4d, 0x55, 0x29, 0x4d, 0x52,
-	0x23, 0x3e, 0xbb, 0x03, 0xf2, 0x06, 0x74, 0x3d, 0x64, 0x6b, 0x1e, 0x22, 0x36, 0x97, 0xbd, 0x48,
-	0x8e, 0x8f, 0xe4, 0x58, 0x2a, 0x17, 0xe3, 0x4a, 0x6a, 0x5c, 0x49, 0xe2, 0x01, 0x97, 0xec, 0xbd,
-	0x0a, 0xdd, 0x26, 0xb1, 0x5d, 0x28, 0x75, 0xd2, 0x60, 0x8e, 0x06, 0x6b, 0x61, 0x17, 0xfe, 0xa1,
-	0xc9, 0xac, 0x0f, 0x4c, 0x66, 0xb2, 0x3a, 0x77, 0xf3, 0xad, 0x67, 0x3f, 0x33, 0xa0, 0x80, 0xb5,
-	0x36, 0xc2, 0x2d, 0x5c, 0x77, 0x2d, 0xd4, 0x6c, 0x6a, 0x26, 0xe4, 0x72, 0x61, 0x39, 0xef, 0x03,
-	0x8d, 0xef, 0xbe, 0xb8, 0x66, 0x22, 0xef, 0x43, 0xab, 0x21, 0xeb, 0x04, 0x2b, 0xd1, 0x17, 0x48,
-	0xff, 0x6c, 0xb8, 0xc6, 0xbe, 0xe2, 0x1d, 0x35, 0xa1, 0x2b, 0x3f, 0x83, 0x7a, 0xc7, 0x17, 0xf3,
-	0x35, 0xad, 0xfd, 0x36, 0x12, 0xe9, 0xfa, 0xe2, 0x12, 0x4d, 0x9e, 0x94, 0x97, 0xd4, 0xd9, 0xe8,
-	0xa8, 0x87, 0x95, 0x96, 0x41, 0x71, 0x68, 0xc6, 0xfd, 0x0d, 0xf8, 0x04, 0x66, 0x6a, 0xae, 0xb9,
-	0xa9, 0xd9, 0x3a, 0xb4, 0x6e, 0x7c, 0xfa, 0x12, 0x07, 0x16, 0x07, 0xb3, 0xf7, 0xeb, 0xfa, 0x9a,
-	0x01, 0x7c, 0x3f, 0xa4, 0xc2, 0xa6, 0xa5, 0xe9, 0xf0, 0x0a, 0xe6, 0xf1, 0x11, 0x70, 0xc4, 0x41,
-	0x26, 0xb2, 0x35, 0xab, 0x7e, 0x7e, 0xb5, 0x8f, 0x3b, 0xbe, 0x38, 0xb7, 0xed, 0x20, 0x73, 0x33,
-	0x5e, 0x59, 0xd7, 0x17, 0xc5, 0x48, 0x6f, 0x04, 0x5d, 0x52, 0x17, 0x7a, 0xa1, 0x01, 0x26, 0xab,
-	0x81, 0xdb, 0x36, 0x3c, 0x1c, 0xca, 0x96, 0x0e, 0xb3, 0x55, 0x3a, 0xbe, 0x58, 0x78, 0x0d, 0x0f,
-	0x93, 0xc9, 0x78, 0x9a, 0xec, 0x1c, 0xa2, 0xa4, 0x16, 0xec, 0x04, 0x7e, 0xf8, 0xa3, 0xc9, 0x5c,
-	0xbb, 0x9d, 0x65, 0xaf, 0xd7, 0xce, 0x72, 0xd7, 0x66, 0x67, 0xab, 0x40, 0x1a, 0xbd, 0x17, 0xfd,
-	0xf5, 0x39, 0xcb, 0x80, 0xe5, 0x24, 0xec, 0x2a, 0x16, 0xf7, 0x7f, 0x7f, 0xae, 0x68, 0xba, 0xd9,
-	0x3f, 0x34, 0xdd, 0xdc, 0xdf, 0x35, 0xdd, 0x5b, 0x37, 0x6d, 0xba, 0xf7, 0xc0, 0xdd, 0x31, 0xfb,
-	0xd7, 0xdb, 0xd3, 0xca, 0x59, 0x1a, 0xa4, 0x6b, 0xae, 0x19, 0x4c, 0x64, 0xf0, 0x75, 0x24, 0x0c,
-	0xce, 0x22, 0x79, 0x83, 0xf3, 0x6b, 0xe3, 0xe3, 0xbd, 0x04, 0xec, 0x2e, 0x98, 0x49, 0xdc, 0xee,
-	0xe2, 0x79, 0xcc, 0x18, 0x80, 0xbf, 0x7f, 0x01, 0xa0, 0xaf, 0xfd, 0x06, 0xe4, 0xe3, 0x17, 0xc7,
-	0x9d, 0x21, 0x5e, 0x2c, 0xca, 0xaf, 0x8e, 0x8b, 0xf6, 0x25, 0x5b, 0x60, 0x69, 0x94, 0xe5, 0x97,
-	0x46, 0x08, 0x0c, 0x21, 0xf9, 0x87, 0x97, 0x45, 0xf6, 0xd3, 0xb6, 0x01, 0x37, 0xd2, 0x2a, 0xd6,
-	0xc7, 0xab, 0xc5, 0x3b, 0x57, 0xbe, 0x34, 0xb4, 0x97, 0xb9, 0xfa, 0xfc, 0xb8, 0x23, 0x30, 0x27,
-	0x1d, 0x81, 0xf9, 0xd1, 0x11, 0x98, 0x2f, 0xa7, 0x42, 0xea, 0xe4, 0x54, 0x48, 0x7d, 0x3b, 0x15,
-	0x52, 0xbb, 0x0f, 0x62, 0x4b, 0x0a, 0x37, 0x30, 0xb1, 0xe1, 0x91, 0x02, 0xf1, 0x86, 0x05, 0x0d,
-	0x13, 0x3a, 0x4a, 0xbb, 0xf7, 0x18, 0x0f, 0xb7, 0xb5, 0x91, 0x0b, 0x1f, 0xda, 0x8f, 0x7e, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0x1f, 0x6d, 0x63, 0x01, 0x0c, 0x00, 0x00,
+func (m *MsgAddStopLimitOrder) Reset()         { *m = MsgAddStopLimitOrder{} }
+func (m *MsgAddStopLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStopLimitOrder) ProtoMessage()    {}
+func (*MsgAddStopLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error)
-	AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error)
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error)
-	CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error)
+func (m *MsgAddStopLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAddStopLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStopLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddStopLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStopLimitOrder.Merge(m, src)
+}
+func (m *MsgAddStopLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStopLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStopLimitOrder.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error) {
-	out := new(MsgAddLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddStopLimitOrder proto.InternalMessageInfo
+
+func (m *MsgAddStopLimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error) {
-	out := new(MsgAddMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopLimitOrder) GetClientOrderId() string {
+	if m != nil {
+		return m.ClientOrderId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopLimitOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
 	}
-	return out, nil
+	return TimeInForce_Unspecified
 }
 
-func (c *msgClient) CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error) {
-	out := new(MsgCancelReplaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopLimitOrder) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error) {
-	out := new(MsgCancelReplaceMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopLimitOrder) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddLimitOrder(context.Context, *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error)
-	AddMarketOrder(context.Context, *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error)
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	CancelReplaceLimitOrder(context.Context, *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error)
-	CancelReplaceMarketOrder(context.Context, *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error)
+func (m *MsgAddStopLimitOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgAddStopLimitOrderResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddLimitOrder(ctx context.Context, req *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLimitOrder not implemented")
+func (m *MsgAddStopLimitOrderResponse) Reset()         { *m = MsgAddStopLimitOrderResponse{} }
+func (m *MsgAddStopLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStopLimitOrderResponse) ProtoMessage()    {}
+func (*MsgAddStopLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{11}
 }
-func (*UnimplementedMsgServer) AddMarketOrder(ctx context.Context, req *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMarketOrder not implemented")
+func (m *MsgAddStopLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *MsgAddStopLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStopLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelReplaceLimitOrder(ctx context.Context, req *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceLimitOrder not implemented")
+func (m *MsgAddStopLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStopLimitOrderResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelReplaceMarketOrder(ctx context.Context, req *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceMarketOrder not implemented")
+func (m *MsgAddStopLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStopLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStopLimitOrderResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgAddStopLimitOrderResponse proto.InternalMessageInfo
+
+type MsgAddStopMarketOrder struct {
+	Owner               string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId       string                                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              string                                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination         types.Coin                             `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	TriggerPrice        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,8,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
 }
 
-func _Msg_AddLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/AddLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddLimitOrder(ctx, req.(*MsgAddLimitOrder))
+func (m *MsgAddStopMarketOrder) Reset()         { *m = MsgAddStopMarketOrder{} }
+func (m *MsgAddStopMarketOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStopMarketOrder) ProtoMessage()    {}
+func (*MsgAddStopMarketOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{12}
+}
+func (m *MsgAddStopMarketOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddStopMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStopMarketOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddStopMarketOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStopMarketOrder.Merge(m, src)
+}
+func (m *MsgAddStopMarketOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStopMarketOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStopMarketOrder.DiscardUnknown(m)
 }
 
-func _Msg_AddMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMarketOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/AddMarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMarketOrder(ctx, req.(*MsgAddMarketOrder))
+var xxx_messageInfo_MsgAddStopMarketOrder proto.InternalMessageInfo
+
+func (m *MsgAddStopMarketOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+func (m *MsgAddStopMarketOrder) GetClientOrderId() string {
+	if m != nil {
+		return m.ClientOrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelReplaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelReplaceLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/CancelReplaceLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, req.(*MsgCancelReplaceLimitOrder))
+func (m *MsgAddStopMarketOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
 	}
-	return interceptor(ctx, in, info, handler)
+	return TimeInForce_Unspecified
 }
 
-func _Msg_CancelReplaceMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelReplaceMarketOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, in)
+func (m *MsgAddStopMarketOrder) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/CancelReplaceMarketOrder",
+	return ""
+}
+
+func (m *MsgAddStopMarketOrder) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, req.(*MsgCancelReplaceMarketOrder))
+	return types.Coin{}
+}
+
+func (m *MsgAddStopMarketOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
 	}
-	return interceptor(ctx, in, info, handler)
+	return SelfTradePrevention_Unspecified
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "em.market.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddLimitOrder",
-			Handler:    _Msg_AddLimitOrder_Handler,
-		},
-		{
-			MethodName: "AddMarketOrder",
-			Handler:    _Msg_AddMarketOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "CancelReplaceLimitOrder",
-			Handler:    _Msg_CancelReplaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "CancelReplaceMarketOrder",
-			Handler:    _Msg_CancelReplaceMarketOrder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "em/market/v1/tx.proto",
+type MsgAddStopMarketOrderResponse struct {
 }
 
-func (m *MsgAddLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddStopMarketOrderResponse) Reset()         { *m = MsgAddStopMarketOrderResponse{} }
+func (m *MsgAddStopMarketOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStopMarketOrderResponse) ProtoMessage()    {}
+func (*MsgAddStopMarketOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{13}
 }
-
-func (m *MsgAddLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddStopMarketOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAddLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAddStopMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStopMarketOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgAddStopMarketOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStopMarketOrderResponse.Merge(m, src)
+}
+func (m *MsgAddStopMarketOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStopMarketOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStopMarketOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddStopMarketOrderResponse proto.InternalMessageInfo
+
+// MsgSetSelfTradePrevention sets the self-trade prevention of the owner's
+// orders that do not specify one.
+type MsgSetSelfTradePrevention struct {
+	Owner               string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,2,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+}
+
+func (m *MsgSetSelfTradePrevention) Reset()         { *m = MsgSetSelfTradePrevention{} }
+func (m *MsgSetSelfTradePrevention) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSelfTradePrevention) ProtoMessage()    {}
+func (*MsgSetSelfTradePrevention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{14}
+}
+func (m *MsgSetSelfTradePrevention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSelfTradePrevention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSelfTradePrevention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetSelfTradePrevention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSelfTradePrevention.Merge(m, src)
+}
+func (m *MsgSetSelfTradePrevention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSelfTradePrevention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSelfTradePrevention.DiscardUnknown(m)
 }
 
-func (m *MsgAddLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetSelfTradePrevention proto.InternalMessageInfo
+
+func (m *MsgSetSelfTradePrevention) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSelfTradePrevention) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Unspecified
 }
 
-func (m *MsgAddLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetSelfTradePreventionResponse struct {
 }
 
-func (m *MsgAddMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSelfTradePreventionResponse) Reset()         { *m = MsgSetSelfTradePreventionResponse{} }
+func (m *MsgSetSelfTradePreventionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSelfTradePreventionResponse) ProtoMessage()    {}
+func (*MsgSetSelfTradePreventionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{15}
+}
+func (m *MsgSetSelfTradePreventionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSelfTradePreventionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSelfTradePreventionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetSelfTradePreventionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSelfTradePreventionResponse.Merge(m, src)
+}
+func (m *MsgSetSelfTradePreventionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSelfTradePreventionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSelfTradePreventionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetSelfTradePreventionResponse proto.InternalMessageInfo
+
+// MsgSetInstrument lists an instrument or updates its listing. Only the chain
+// authority can manage listings.
+type MsgSetInstrument struct {
+	Authority  string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Instrument Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument" yaml:"instrument"`
 }
 
-func (m *MsgAddMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxSlippage.Size()
-		i -= size
-		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetInstrument) Reset()         { *m = MsgSetInstrument{} }
+func (m *MsgSetInstrument) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstrument) ProtoMessage()    {}
+func (*MsgSetInstrument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{16}
+}
+func (m *MsgSetInstrument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstrument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstrument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetInstrument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstrument.Merge(m, src)
+}
+func (m *MsgSetInstrument) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstrument) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstrument.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarketOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetInstrument proto.InternalMessageInfo
+
+func (m *MsgSetInstrument) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetInstrument) GetInstrument() Instrument {
+	if m != nil {
+		return m.Instrument
+	}
+	return Instrument{}
 }
 
-func (m *MsgAddMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetInstrumentResponse struct {
 }
 
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetInstrumentResponse) Reset()         { *m = MsgSetInstrumentResponse{} }
+func (m *MsgSetInstrumentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstrumentResponse) ProtoMessage()    {}
+func (*MsgSetInstrumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{17}
+}
+func (m *MsgSetInstrumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstrumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstrumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetInstrumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstrumentResponse.Merge(m, src)
 }
-
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetInstrumentResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetInstrumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstrumentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetInstrumentResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddLimitOrder)(nil), "em.market.v1.MsgAddLimitOrder")
+	proto.RegisterType((*MsgAddLimitOrderResponse)(nil), "em.market.v1.MsgAddLimitOrderResponse")
+	proto.RegisterType((*MsgAddMarketOrder)(nil), "em.market.v1.MsgAddMarketOrder")
+	proto.RegisterType((*MsgAddMarketOrderResponse)(nil), "em.market.v1.MsgAddMarketOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "em.market.v1.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "em.market.v1.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgCancelReplaceLimitOrder)(nil), "em.market.v1.MsgCancelReplaceLimitOrder")
+	proto.RegisterType((*MsgCancelReplaceLimitOrderResponse)(nil), "em.market.v1.MsgCancelReplaceLimitOrderResponse")
+	proto.RegisterType((*MsgCancelReplaceMarketOrder)(nil), "em.market.v1.MsgCancelReplaceMarketOrder")
+	proto.RegisterType((*MsgCancelReplaceMarketOrderResponse)(nil), "em.market.v1.MsgCancelReplaceMarketOrderResponse")
+	proto.RegisterType((*MsgAddStopLimitOrder)(nil), "em.market.v1.MsgAddStopLimitOrder")
+	proto.RegisterType((*MsgAddStopLimitOrderResponse)(nil), "em.market.v1.MsgAddStopLimitOrderResponse")
+	proto.RegisterType((*MsgAddStopMarketOrder)(nil), "em.market.v1.MsgAddStopMarketOrder")
+	proto.RegisterType((*MsgAddStopMarketOrderResponse)(nil), "em.market.v1.MsgAddStopMarketOrderResponse")
+	proto.RegisterType((*MsgSetSelfTradePrevention)(nil), "em.market.v1.MsgSetSelfTradePrevention")
+	proto.RegisterType((*MsgSetSelfTradePreventionResponse)(nil), "em.market.v1.MsgSetSelfTradePreventionResponse")
+	proto.RegisterType((*MsgSetInstrument)(nil), "em.market.v1.MsgSetInstrument")
+	proto.RegisterType((*MsgSetInstrumentResponse)(nil), "em.market.v1.MsgSetInstrumentResponse")
 }
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("em/market/v1/tx.proto", fileDescriptor_636272ab2288df51) }
+
+var fileDescriptor_636272ab2288df51 = []byte{
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0xb7, 0x69, 0x4b, 0x27, 0x6d, 0x37, 0xf5, 0xb6, 0x5d, 0xd7, 0x5b, 0xec, 0xee, 0x74,
+	0x29, 0x5d, 0x56, 0xb5, 0x69, 0xb8, 0x20, 0x6e, 0xa4, 0xb0, 0xa2, 0x12, 0x61, 0x17, 0x67, 0xa5,
+	0xa2, 0xbd, 0x44, 0x8e, 0x33, 0xf1, 0x8e, 0x6a, 0x7b, 0x8c, 0x3d, 0x69, 0x53, 0x89, 0x1b, 0x7f,
+	0x80, 0x13, 0x42, 0xe2, 0xaf, 0xf0, 0x03, 0xf6, 0xc0, 0x61, 0x8f, 0x08, 0x24, 0x0b, 0xa5, 0xfc,
+	0x82, 0x70, 0x47, 0xc8, 0x1f, 0x71, 0xec, 0xc4, 0x4e, 0xb3, 0x55, 0x3f, 0xa4, 0x55, 0x4f, 0x89,
+	0xfd, 0x3e, 0xef, 0xf3, 0xcc, 0x8c, 0x9f, 0x79, 0xfd, 0x8e, 0xc1, 0x2a, 0x32, 0x65, 0x53, 0x75,
+	0x8e, 0x10, 0x95, 0x8f, 0xf7, 0x64, 0xda, 0x91, 0x6c, 0x87, 0x50, 0xc2, 0x2e, 0x20, 0x53, 0x0a,
+	0x6f, 0x4b, 0xc7, 0x7b, 0xfc, 0x8a, 0x4e, 0x74, 0x12, 0x04, 0x64, 0xff, 0x5f, 0x88, 0xe1, 0x05,
+	0x8d, 0xb8, 0x26, 0x71, 0xe5, 0x86, 0xea, 0x22, 0xf9, 0x78, 0xaf, 0x81, 0xa8, 0xba, 0x27, 0x6b,
+	0x04, 0x5b, 0x51, 0x7c, 0x3d, 0x45, 0x1d, 0xb1, 0x85, 0x21, 0x51, 0x27, 0x44, 0x37, 0x90, 0x1c,
+	0x5c, 0x35, 0xda, 0x2d, 0x99, 0x62, 0x13, 0xb9, 0x54, 0x35, 0xed, 0x10, 0x00, 0xff, 0x9d, 0x06,
+	0xa5, 0xaa, 0xab, 0x7f, 0xde, 0x6c, 0x7e, 0x8d, 0x4d, 0x4c, 0x9f, 0x39, 0x4d, 0xe4, 0xb0, 0xdb,
+	0x60, 0x86, 0x9c, 0x58, 0xc8, 0xe1, 0x98, 0x4d, 0x66, 0x67, 0xbe, 0x52, 0xea, 0x79, 0xe2, 0xc2,
+	0xa9, 0x6a, 0x1a, 0x9f, 0xc1, 0xe0, 0x36, 0x54, 0xc2, 0x30, 0x5b, 0x01, 0x77, 0x35, 0x03, 0x23,
+	0x8b, 0xd6, 0x89, 0x9f, 0x57, 0xc7, 0x4d, 0xee, 0x4e, 0x90, 0xc1, 0xf7, 0x3c, 0x71, 0x2d, 0xcc,
+	0x18, 0x02, 0x40, 0x65, 0x31, 0xbc, 0x13, 0x28, 0x1d, 0x34, 0xd9, 0x43, 0xb0, 0xe8, 0x8f, 0xa9,
+	0x8e, 0xad, 0x7a, 0x8b, 0x38, 0x1a, 0xe2, 0xa6, 0x37, 0x99, 0x9d, 0xa5, 0xf2, 0xba, 0x94, 0x5c,
+	0x18, 0xe9, 0x05, 0x36, 0xd1, 0x81, 0xf5, 0xd4, 0x07, 0x54, 0xb8, 0x9e, 0x27, 0xae, 0x84, 0xe4,
+	0xa9, 0x4c, 0xa8, 0x14, 0xe9, 0x00, 0xc6, 0x7e, 0x05, 0x66, 0x5d, 0xd2, 0xf6, 0x19, 0x0b, 0x9b,
+	0xcc, 0x4e, 0xb1, 0xbc, 0x2e, 0x85, 0xcb, 0x28, 0xf9, 0xcb, 0x28, 0x45, 0xcb, 0x28, 0xed, 0x13,
+	0x6c, 0x55, 0x56, 0x5f, 0x7b, 0xe2, 0x54, 0xcf, 0x13, 0x17, 0x43, 0xd6, 0x30, 0x0d, 0x2a, 0x51,
+	0x3e, 0x7b, 0x08, 0x8a, 0x4d, 0xe4, 0x52, 0x6c, 0xa9, 0x14, 0x13, 0x8b, 0x9b, 0x39, 0x8f, 0x8e,
+	0x8f, 0xe8, 0xd8, 0x90, 0x2e, 0x91, 0x0b, 0x95, 0x24, 0x13, 0x7b, 0x02, 0x56, 0x5d, 0x64, 0xb4,
+	0xea, 0xd4, 0x51, 0x9b, 0xa8, 0x6e, 0x3b, 0xe8, 0x18, 0x59, 0x81, 0xc4, 0x6c, 0xb0, 0x06, 0x0f,
+	0xd3, 0x6b, 0x50, 0x43, 0x46, 0xeb, 0x85, 0x8f, 0x7c, 0x1e, 0x03, 0x2b, 0x9b, 0x3d, 0x4f, 0xdc,
+	0x88, 0x46, 0x9d, 0xc5, 0x04, 0x95, 0x7b, 0xee, 0x68, 0x1a, 0xe4, 0x01, 0x37, 0xfc, 0xd0, 0x15,
+	0xe4, 0xda, 0xc4, 0x72, 0x11, 0xfc, 0xab, 0x00, 0x96, 0xc3, 0x60, 0x35, 0x90, 0x7e, 0x87, 0x2c,
+	0xf1, 0x38, 0x65, 0x89, 0xf9, 0xca, 0xf2, 0x0d, 0x3c, 0xf3, 0x1f, 0x19, 0x50, 0x32, 0xd5, 0x0e,
+	0x36, 0xdb, 0x66, 0xdd, 0x35, 0xb0, 0x6d, 0xab, 0x3a, 0x0a, 0x9e, 0xf7, 0x7c, 0xe5, 0x3b, 0x9f,
+	0xe3, 0x4f, 0x4f, 0xdc, 0xd6, 0x31, 0x7d, 0xd5, 0x6e, 0x48, 0x1a, 0x31, 0xe5, 0x68, 0xeb, 0x87,
+	0x3f, 0xbb, 0x6e, 0xf3, 0x48, 0xa6, 0xa7, 0x36, 0x72, 0xa5, 0x2f, 0x90, 0xd6, 0xf5, 0xc4, 0x62,
+	0x55, 0xed, 0xd4, 0x22, 0x92, 0x9e, 0x27, 0xde, 0x0f, 0xc5, 0x87, 0xe9, 0xa1, 0x72, 0x37, 0xba,
+	0xd5, 0xc7, 0xe6, 0x3b, 0x6f, 0xee, 0x8a, 0x9d, 0xf7, 0x00, 0xac, 0x8f, 0x98, 0x2b, 0xb6, 0xde,
+	0x0f, 0x60, 0xa9, 0xea, 0xea, 0xfb, 0xaa, 0xa5, 0x21, 0xe3, 0xda, 0x6d, 0x07, 0x39, 0xb0, 0x96,
+	0x56, 0x8f, 0xc7, 0xf5, 0x73, 0x01, 0xf0, 0x71, 0x48, 0x41, 0xb6, 0xa1, 0x6a, 0xe8, 0x02, 0xe5,
+	0xf2, 0x7b, 0xc0, 0x11, 0x07, 0xeb, 0xd8, 0x52, 0x8d, 0x7a, 0xf6, 0x68, 0x3f, 0xed, 0x7a, 0xe2,
+	0xf2, 0x33, 0x07, 0xeb, 0xfb, 0xc9, 0x91, 0xf5, 0x3c, 0x51, 0x8c, 0xf8, 0x72, 0xd2, 0xa1, 0xb2,
+	0xda, 0x0f, 0xa5, 0x32, 0x59, 0x15, 0xdc, 0xb3, 0xd0, 0xc9, 0x88, 0xda, 0x74, 0xa0, 0x56, 0xee,
+	0x7a, 0x62, 0xe9, 0x1b, 0x74, 0x32, 0x2c, 0xc6, 0x87, 0x62, 0x19, 0x89, 0x50, 0x29, 0x59, 0x43,
+	0xf8, 0xd1, 0xdd, 0x5a, 0xb8, 0xf4, 0x02, 0x3e, 0x73, 0xb9, 0x05, 0x7c, 0xf6, 0xb2, 0x36, 0x33,
+	0x7c, 0x04, 0x60, 0xbe, 0x2f, 0x62, 0xfb, 0xfc, 0x57, 0x00, 0x0f, 0x86, 0x61, 0x17, 0xa9, 0xad,
+	0xb7, 0xfe, 0xb9, 0x60, 0xb5, 0x9f, 0x79, 0xcb, 0x6a, 0x3f, 0x7b, 0xb5, 0xd5, 0x7e, 0xee, 0x9a,
+	0xab, 0x3d, 0xfc, 0x00, 0x6c, 0x8d, 0xf1, 0x5f, 0xec, 0xd3, 0x7f, 0x0a, 0x60, 0x25, 0x2c, 0xce,
+	0x35, 0x4a, 0xec, 0xdb, 0x7e, 0xf0, 0xda, 0x7b, 0x83, 0x23, 0xb0, 0x48, 0x1d, 0xac, 0xeb, 0xc8,
+	0xa9, 0xdb, 0x0e, 0xd6, 0xfa, 0x7d, 0xc1, 0xd3, 0xb7, 0x73, 0x4a, 0x62, 0x39, 0x92, 0x64, 0x50,
+	0x59, 0x88, 0xae, 0x9f, 0xfb, 0x97, 0x37, 0xd7, 0x02, 0x08, 0x60, 0x23, 0xcb, 0x65, 0xb1, 0x0d,
+	0x7f, 0x9f, 0x01, 0xab, 0x03, 0xc0, 0x6d, 0x13, 0xfa, 0xce, 0x36, 0xa1, 0x23, 0x76, 0x9f, 0xbb,
+	0x09, 0xbb, 0xbf, 0x77, 0xc5, 0x76, 0x17, 0xc1, 0xfb, 0x99, 0x6e, 0x8e, 0xfd, 0xfe, 0x1b, 0x13,
+	0xf4, 0xc4, 0x35, 0x44, 0x33, 0x54, 0x27, 0xf6, 0x7c, 0xee, 0xfc, 0xee, 0x5c, 0xf1, 0xfc, 0xb6,
+	0xc0, 0xc3, 0xdc, 0xd1, 0xc7, 0x73, 0xfc, 0x95, 0x09, 0x3e, 0x33, 0xd4, 0x10, 0x3d, 0xb0, 0x5c,
+	0xea, 0xb4, 0x4d, 0x64, 0x51, 0xb6, 0x0c, 0xe6, 0xd5, 0x36, 0x7d, 0x45, 0x1c, 0x4c, 0x4f, 0xa3,
+	0xe9, 0xad, 0xf4, 0x3c, 0xb1, 0x14, 0x8e, 0x21, 0x0e, 0x41, 0x65, 0x00, 0x63, 0x6b, 0x00, 0xe0,
+	0x98, 0x21, 0x98, 0x5b, 0xb1, 0xcc, 0xa5, 0xe7, 0x36, 0x50, 0xa8, 0xac, 0x47, 0x1b, 0x62, 0x39,
+	0xa4, 0x1c, 0x64, 0x42, 0x25, 0x41, 0x13, 0x1d, 0x87, 0x53, 0x83, 0xeb, 0x8f, 0xbc, 0xfc, 0xcb,
+	0x1c, 0x98, 0xae, 0xba, 0xba, 0x5f, 0x0f, 0xd2, 0x1f, 0x49, 0x84, 0xb4, 0xea, 0xf0, 0x79, 0x9a,
+	0xdf, 0x1e, 0x1f, 0xef, 0x0b, 0xb0, 0x2f, 0xc1, 0xd2, 0xd0, 0x59, 0x5b, 0xcc, 0xca, 0x4c, 0x00,
+	0xf8, 0x0f, 0xcf, 0x01, 0xc4, 0xdc, 0xdf, 0x82, 0x62, 0xf2, 0x34, 0xb5, 0x31, 0x92, 0x97, 0x88,
+	0xf2, 0x8f, 0xc6, 0x45, 0x63, 0xca, 0x36, 0xb8, 0x9f, 0x77, 0x0e, 0xda, 0xc9, 0x21, 0x18, 0x41,
+	0xf2, 0x1f, 0x4f, 0x8a, 0x8c, 0x65, 0x3b, 0x80, 0xcb, 0xed, 0x9f, 0x1f, 0x8f, 0x67, 0x4b, 0xae,
+	0xdc, 0xde, 0xc4, 0xd0, 0x58, 0x59, 0x03, 0xcb, 0xa3, 0x1d, 0x11, 0xcc, 0x7a, 0x02, 0x69, 0x0c,
+	0xff, 0xd1, 0xf9, 0x98, 0x58, 0xa4, 0x05, 0xd8, 0x8c, 0xf7, 0xdd, 0x56, 0x1e, 0x43, 0x72, 0x4a,
+	0x4f, 0x26, 0x00, 0xc5, 0x3a, 0x0e, 0x58, 0xcb, 0xa9, 0x33, 0xa3, 0x9e, 0xca, 0x06, 0xf2, 0xf2,
+	0x84, 0xc0, 0x58, 0xf3, 0x10, 0x2c, 0xa6, 0xf7, 0xbd, 0x90, 0xc5, 0x30, 0x88, 0xf3, 0xdb, 0xe3,
+	0xe3, 0x7d, 0xe2, 0xca, 0x97, 0xaf, 0xbb, 0x02, 0xf3, 0xa6, 0x2b, 0x30, 0x7f, 0x77, 0x05, 0xe6,
+	0xa7, 0x33, 0x61, 0xea, 0xcd, 0x99, 0x30, 0xf5, 0xc7, 0x99, 0x30, 0xf5, 0xf2, 0x49, 0xe2, 0xd5,
+	0x81, 0x76, 0x4d, 0x62, 0xa1, 0x53, 0x19, 0x99, 0xbb, 0x06, 0x6a, 0xea, 0xc8, 0x91, 0x3b, 0xfd,
+	0xaf, 0xa5, 0xc1, 0x3b, 0xa4, 0x31, 0x1b, 0x7c, 0x09, 0xfd, 0xe4, 0xff, 0x01, 0x00, 0x73, 0x29,
+	0xe7, 0xcd, 0xa2, 0x15, 0x00, 0x00,
 }
 
-func (m *MsgCancelReplaceLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error)
+	AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error)
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error)
+	CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error)
+	AddStopLimitOrder(ctx context.Context, in *MsgAddStopLimitOrder, opts ...grpc.CallOption) (*MsgAddStopLimitOrderResponse, error)
+	AddStopMarketOrder(ctx context.Context, in *MsgAddStopMarketOrder, opts ...grpc.CallOption) (*MsgAddStopMarketOrderResponse, error)
+	SetSelfTradePrevention(ctx context.Context, in *MsgSetSelfTradePrevention, opts ...grpc.CallOption) (*MsgSetSelfTradePreventionResponse, error)
+	SetInstrument(ctx context.Context, in *MsgSetInstrument, opts ...grpc.CallOption) (*MsgSetInstrumentResponse, error)
 }
 
-func (m *MsgCancelReplaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCancelReplaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewClientOrderId) > 0 {
-		i -= len(m.NewClientOrderId)
-		copy(dAtA[i:], m.NewClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrigClientOrderId) > 0 {
-		i -= len(m.OrigClientOrderId)
-		copy(dAtA[i:], m.OrigClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error) {
+	out := new(MsgAddLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddLimitOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error) {
+	out := new(MsgAddMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddMarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error) {
+	out := new(MsgCancelReplaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceLimitOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error) {
+	out := new(MsgCancelReplaceMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceMarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxSlippage.Size()
-		i -= size
-		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewClientOrderId) > 0 {
-		i -= len(m.NewClientOrderId)
-		copy(dAtA[i:], m.NewClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrigClientOrderId) > 0 {
-		i -= len(m.OrigClientOrderId)
-		copy(dAtA[i:], m.OrigClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddStopLimitOrder(ctx context.Context, in *MsgAddStopLimitOrder, opts ...grpc.CallOption) (*MsgAddStopLimitOrderResponse, error) {
+	out := new(MsgAddStopLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddStopLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddStopMarketOrder(ctx context.Context, in *MsgAddStopMarketOrder, opts ...grpc.CallOption) (*MsgAddStopMarketOrderResponse, error) {
+	out := new(MsgAddStopMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddStopMarketOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetSelfTradePrevention(ctx context.Context, in *MsgSetSelfTradePrevention, opts ...grpc.CallOption) (*MsgSetSelfTradePreventionResponse, error) {
+	out := new(MsgSetSelfTradePreventionResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetSelfTradePrevention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetInstrument(ctx context.Context, in *MsgSetInstrument, opts ...grpc.CallOption) (*MsgSetInstrumentResponse, error) {
+	out := new(MsgSetInstrumentResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetInstrument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddLimitOrder(context.Context, *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error)
+	AddMarketOrder(context.Context, *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error)
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	CancelReplaceLimitOrder(context.Context, *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error)
+	CancelReplaceMarketOrder(context.Context, *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error)
+	AddStopLimitOrder(context.Context, *MsgAddStopLimitOrder) (*MsgAddStopLimitOrderResponse, error)
+	AddStopMarketOrder(context.Context, *MsgAddStopMarketOrder) (*MsgAddStopMarketOrderResponse, error)
+	SetSelfTradePrevention(context.Context, *MsgSetSelfTradePrevention) (*MsgSetSelfTradePreventionResponse, error)
+	SetInstrument(context.Context, *MsgSetInstrument) (*MsgSetInstrumentResponse, error)
 }
-func (m *MsgAddLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddLimitOrder(ctx context.Context, req *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) AddMarketOrder(ctx context.Context, req *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelReplaceLimitOrder(ctx context.Context, req *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelReplaceMarketOrder(ctx context.Context, req *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) AddStopLimitOrder(ctx context.Context, req *MsgAddStopLimitOrder) (*MsgAddStopLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStopLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) AddStopMarketOrder(ctx context.Context, req *MsgAddStopMarketOrder) (*MsgAddStopMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStopMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) SetSelfTradePrevention(ctx context.Context, req *MsgSetSelfTradePrevention) (*MsgSetSelfTradePreventionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSelfTradePrevention not implemented")
+}
+func (*UnimplementedMsgServer) SetInstrument(ctx context.Context, req *MsgSetInstrument) (*MsgSetInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInstrument not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddLimitOrder(ctx, in)
 	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddLimitOrder",
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLimitOrder(ctx, req.(*MsgAddLimitOrder))
 	}
-	l = m.Source.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddMarketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarketOrder(ctx, req.(*MsgAddMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelOrder",
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
 	}
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSlippage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelReplaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelReplaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelReplaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, req.(*MsgCancelReplaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelReplaceMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelReplaceMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, in)
 	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelReplaceMarketOrder",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, req.(*MsgCancelReplaceMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddStopLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddStopLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddStopLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddStopLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddStopLimitOrder(ctx, req.(*MsgAddStopLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelReplaceLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_AddStopMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddStopMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.OrigClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddStopMarketOrder(ctx, in)
 	}
-	l = len(m.NewClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddStopMarketOrder",
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddStopMarketOrder(ctx, req.(*MsgAddStopMarketOrder))
 	}
-	l = m.Source.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetSelfTradePrevention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSelfTradePrevention)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetSelfTradePrevention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetSelfTradePrevention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSelfTradePrevention(ctx, req.(*MsgSetSelfTradePrevention))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelReplaceMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInstrument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetInstrument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInstrument(ctx, req.(*MsgSetInstrument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "em.market.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddLimitOrder",
+			Handler:    _Msg_AddLimitOrder_Handler,
+		},
+		{
+			MethodName: "AddMarketOrder",
+			Handler:    _Msg_AddMarketOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "CancelReplaceLimitOrder",
+			Handler:    _Msg_CancelReplaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelReplaceMarketOrder",
+			Handler:    _Msg_CancelReplaceMarketOrder_Handler,
+		},
+		{
+			MethodName: "AddStopLimitOrder",
+			Handler:    _Msg_AddStopLimitOrder_Handler,
+		},
+		{
+			MethodName: "AddStopMarketOrder",
+			Handler:    _Msg_AddStopMarketOrder_Handler,
+		},
+		{
+			MethodName: "SetSelfTradePrevention",
+			Handler:    _Msg_SetSelfTradePrevention_Handler,
+		},
+		{
+			MethodName: "SetInstrument",
+			Handler:    _Msg_SetInstrument_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "em/market/v1/tx.proto",
+}
+
+func (m *MsgAddLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.OrigClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.NewClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewClientOrderId) > 0 {
+		i -= len(m.NewClientOrderId)
+		copy(dAtA[i:], m.NewClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrigClientOrderId) > 0 {
+		i -= len(m.OrigClientOrderId)
+		copy(dAtA[i:], m.OrigClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewClientOrderId) > 0 {
+		i -= len(m.NewClientOrderId)
+		copy(dAtA[i:], m.NewClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrigClientOrderId) > 0 {
+		i -= len(m.OrigClientOrderId)
+		copy(dAtA[i:], m.OrigClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStopLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStopLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStopLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStopLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStopLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStopLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStopMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStopMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStopMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStopMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStopMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStopMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSelfTradePrevention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSelfTradePrevention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSelfTradePrevention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSelfTradePreventionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSelfTradePreventionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSelfTradePreventionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstrument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstrument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Instrument.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstrumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstrumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstrumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgAddLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgAddMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelReplaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrigClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelReplaceMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrigClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddStopLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgAddStopLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddStopMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgAddStopMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSelfTradePrevention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgSetSelfTradePreventionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInstrument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Instrument.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetInstrumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarketOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSlippage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCancelReplaceMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1515,19 +3332,51 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLimitOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1555,11 +3404,11 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1587,9 +3436,9 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 			}
@@ -1608,7 +3457,7 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
@@ -1641,7 +3490,7 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
@@ -1695,7 +3544,7 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,10 +3567,10 @@ func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1745,7 +3594,7 @@ func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,10 +3617,10 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1808,7 +3657,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1836,9 +3685,41 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 			}
@@ -1857,7 +3738,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
@@ -1889,7 +3770,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
@@ -1922,7 +3803,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
@@ -1977,7 +3858,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelReplaceMarketOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,10 +3881,10 @@ func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarketOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2027,7 +3908,7 @@ func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgAddStopLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,10 +3931,10 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddStopLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddStopLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2120,6 +4001,144 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2141,7 +4160,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddStopLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2164,10 +4183,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddStopLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddStopLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2191,7 +4210,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgAddStopMarketOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2214,10 +4233,10 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddStopMarketOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddStopMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2254,7 +4273,7 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2282,11 +4301,30 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2314,13 +4352,13 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			m.TimeInForce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2330,16 +4368,30 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2349,30 +4401,31 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2382,25 +4435,45 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2422,7 +4495,7 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddStopMarketOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +4518,10 @@ func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddStopMarketOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddStopMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2472,7 +4545,7 @@ func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSelfTradePrevention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2495,10 +4568,10 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSelfTradePrevention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSelfTradePrevention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2534,10 +4607,10 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
 			}
-			var stringLen uint64
+			m.SelfTradePrevention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2547,78 +4620,114 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSelfTradePreventionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSelfTradePreventionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSelfTradePreventionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetInstrument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.TimeInForce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetInstrument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetInstrument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2646,11 +4755,11 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2677,41 +4786,7 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Instrument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2736,7 +4811,7 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceMarketOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstrumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2759,10 +4834,10 @@ func (m *MsgCancelReplaceMarketOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstrumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstrumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
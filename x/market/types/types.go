@@ -64,6 +64,10 @@ func (o Order) IsValid() error {
 		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "Unknown 'time in force' specified : %v", o.TimeInForce)
 	}
 
+	if err := ValidateSelfTradePrevention(o.SelfTradePrevention); err != nil {
+		return err
+	}
+
 	if o.Source.Amount.LTE(sdk.ZeroInt()) {
 		return sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", o.Source.Amount, o.Destination.Amount)
 	}
@@ -173,3 +177,134 @@ func TimeInForceFromString(p string) (TimeInForce, error) {
 
 	return 0, fmt.Errorf("unknown time-in-force value: %v", p)
 }
+
+func NewStopLimitOrder(
+	createdTm time.Time,
+	timeInForce TimeInForce,
+	src, dst sdk.Coin,
+	triggerPrice sdk.Dec,
+	seller sdk.AccAddress,
+	clientOrderId string,
+) (StopOrder, error) {
+	if src.Amount.LTE(sdk.ZeroInt()) {
+		return StopOrder{}, sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", src.Amount, dst.Amount)
+	}
+
+	o := StopOrder{
+		TimeInForce:   timeInForce,
+		Owner:         seller.String(),
+		ClientOrderID: clientOrderId,
+		Source:        src,
+		Destination:   dst,
+		MaxSlippage:   sdk.ZeroDec(),
+		TriggerPrice:  triggerPrice,
+		Created:       createdTm,
+	}
+
+	if err := o.IsValid(); err != nil {
+		return StopOrder{}, err
+	}
+
+	return o, nil
+}
+
+func NewStopMarketOrder(
+	createdTm time.Time,
+	timeInForce TimeInForce,
+	srcDenom string,
+	dst sdk.Coin,
+	maxSlippage, triggerPrice sdk.Dec,
+	seller sdk.AccAddress,
+	clientOrderId string,
+) (StopOrder, error) {
+	o := StopOrder{
+		TimeInForce:   timeInForce,
+		Owner:         seller.String(),
+		ClientOrderID: clientOrderId,
+		Source:        sdk.NewCoin(srcDenom, sdk.ZeroInt()),
+		Destination:   dst,
+		MaxSlippage:   maxSlippage,
+		TriggerPrice:  triggerPrice,
+		Created:       createdTm,
+	}
+
+	if err := o.IsValid(); err != nil {
+		return StopOrder{}, err
+	}
+
+	return o, nil
+}
+
+// IsStopMarket signals whether the source amount is derived from the market price when the order is triggered.
+func (o StopOrder) IsStopMarket() bool {
+	return o.Source.Amount.IsZero()
+}
+
+func (o StopOrder) IsValid() error {
+	switch o.TimeInForce {
+	case TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel:
+	default:
+		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "Unknown 'time in force' specified : %v", o.TimeInForce)
+	}
+
+	if err := ValidateSelfTradePrevention(o.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	if o.Source.Amount.IsNegative() || o.Destination.Amount.LTE(sdk.ZeroInt()) {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", o.Source.Amount, o.Destination.Amount)
+	}
+
+	if o.Source.Denom == o.Destination.Denom {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", o.Source.Denom, o.Destination.Denom)
+	}
+
+	if o.MaxSlippage.IsNil() || o.MaxSlippage.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "Cannot be negative")
+	}
+
+	if o.TriggerPrice.IsNil() || !o.TriggerPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidTriggerPrice, "Trigger price must be positive: %v", o.TriggerPrice)
+	}
+
+	return nil
+}
+
+func (o StopOrder) String() string {
+	source := o.Source.String()
+	if o.IsStopMarket() {
+		source = fmt.Sprintf("%v (max slippage %v)", o.Source.Denom, o.MaxSlippage)
+	}
+
+	return fmt.Sprintf("%d : %v -> %v stop @ %v (triggered: %v)\n%v\n", o.ID, source, o.Destination, o.TriggerPrice, o.Triggered, o.Owner)
+}
+
+func ValidateSelfTradePrevention(mode SelfTradePrevention) error {
+	if _, found := SelfTradePrevention_name[int32(mode)]; !found {
+		return sdkerrors.Wrapf(ErrUnknownSelfTradePrevention, "%v", mode)
+	}
+
+	return nil
+}
+
+// Convert from SelfTradePrevention string representation to the internal enum type. Case insensitive.
+func SelfTradePreventionFromString(p string) (SelfTradePrevention, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "":
+		return SelfTradePrevention_Unspecified, nil
+	case "none":
+		return SelfTradePrevention_None, nil
+	case "cancel-newest":
+		return SelfTradePrevention_CancelNewest, nil
+	case "cancel-oldest":
+		return SelfTradePrevention_CancelOldest, nil
+	case "cancel-both":
+		return SelfTradePrevention_CancelBoth, nil
+	case "decrement":
+		return SelfTradePrevention_DecrementAndCancel, nil
+	}
+
+	return 0, fmt.Errorf("unknown self-trade prevention value: %v", p)
+}
@@ -127,6 +127,68 @@ func TestTimeInForce(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSelfTradePrevention(t *testing.T) {
+	stp, err := SelfTradePreventionFromString("Cancel-Oldest")
+	require.NoError(t, err)
+	require.Equal(t, SelfTradePrevention_CancelOldest, stp)
+
+	stp, err = SelfTradePreventionFromString("")
+	require.NoError(t, err)
+	require.Equal(t, SelfTradePrevention_Unspecified, stp)
+
+	_, err = SelfTradePreventionFromString("cancel")
+	require.Error(t, err)
+
+	require.ErrorIs(t, ValidateSelfTradePrevention(SelfTradePrevention(6)), ErrUnknownSelfTradePrevention)
+}
+
+func TestInstrumentListing(t *testing.T) {
+	listing := NewInstrument("eur", "usd", InstrumentStatus_Active, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05"), sdk.NewInt(10))
+	require.NoError(t, listing.Validate())
+
+	invalid := listing
+	invalid.Status = InstrumentStatus_Unspecified
+	require.ErrorIs(t, invalid.Validate(), ErrInvalidListing)
+
+	invalid = listing
+	invalid.TickSize = sdk.NewDec(-1)
+	require.ErrorIs(t, invalid.Validate(), ErrInvalidListing)
+
+	require.Equal(t, sdk.MustNewDecFromStr("1.15"), listing.RoundPriceToTick(sdk.MustNewDecFromStr("1.1001")))
+	require.Equal(t, sdk.MustNewDecFromStr("1.15"), listing.RoundPriceToTick(sdk.MustNewDecFromStr("1.15")))
+
+	invalid = listing.WithCircuitBreaker(sdk.MustNewDecFromStr("0.1"), 0)
+	require.ErrorIs(t, invalid.Validate(), ErrInvalidListing)
+
+	breaker := listing.WithCircuitBreaker(sdk.MustNewDecFromStr("0.1"), time.Minute)
+	require.NoError(t, breaker.Validate())
+	require.False(t, breaker.BreachesPriceBand(sdk.MustNewDecFromStr("1.1"), sdk.OneDec()))
+	require.True(t, breaker.BreachesPriceBand(sdk.MustNewDecFromStr("0.89"), sdk.OneDec()))
+	require.False(t, listing.BreachesPriceBand(sdk.NewDec(2), sdk.OneDec()))
+
+	status, err := InstrumentStatusFromString("Halted")
+	require.NoError(t, err)
+	require.Equal(t, InstrumentStatus_Halted, status)
+
+	_, err = InstrumentStatusFromString("closed")
+	require.Error(t, err)
+}
+
+func TestParams(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.LegacyEvents)
+	require.Zero(t, params.MaxOpenOrders)
+	require.True(t, params.OrderDeposit.Empty())
+
+	require.NoError(t, validateBool(params.LegacyEvents))
+	require.NoError(t, validateUint32(params.MaxOpenOrders))
+	require.NoError(t, validateOrderDeposit(params.OrderDeposit))
+	require.NoError(t, validateOrderDeposit(sdk.NewCoins(coin("10eur"))))
+	require.Error(t, validateOrderDeposit(sdk.Coins{sdk.Coin{Denom: "eur", Amount: sdk.NewInt(-1)}}))
+	require.Error(t, validateOrderDeposit(coin("10eur")))
+	require.Error(t, validateUint32(-1))
+}
+
 func coin(s string) sdk.Coin {
 	coin, err := sdk.ParseCoinNormalized(s)
 	if err != nil {
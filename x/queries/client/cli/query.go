@@ -5,6 +5,11 @@
 package cli
 
 import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,7 +26,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetQuerySpendableBalance(),
 		GetQueryCirculatingSupplyCmd(),
+		GetQuerySupplyBreakdownCmd(),
 		GetQueryMissedBlocksCmd(),
+		GetQueryValidatorsLivenessCmd(),
 	)
 
 	return cmd
@@ -82,6 +89,35 @@ func GetQueryCirculatingSupplyCmd() *cobra.Command {
 	return cmd
 }
 
+func GetQuerySupplyBreakdownCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-breakdown [denom]",
+		Short: "Display how the circulating supply of each denomination is derived from its total supply",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySupplyBreakdownRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyBreakdown(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetQueryMissedBlocksCmd Replacing the SDK slashing signing info
 func GetQueryMissedBlocksCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -113,3 +149,71 @@ func GetQueryMissedBlocksCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+const flagMaxMissedTimes = "max-missed-times"
+
+func GetQueryValidatorsLivenessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness",
+		Short: "Query the liveness of all bonded validators over the signed blocks window",
+		Long: `Query the liveness of all bonded validators over the signed blocks window.
+
+The result is printed as a table unless --output json is given.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxMissedTimes, err := cmd.Flags().GetUint32(flagMaxMissedTimes)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorsLiveness(cmd.Context(), &types.QueryValidatorsLivenessRequest{
+				MaxMissedTimes: maxMissedTimes,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "json" {
+				return clientCtx.PrintProto(res)
+			}
+
+			return printLivenessTable(cmd.OutOrStdout(), res)
+		},
+	}
+	cmd.Flags().Uint32(flagMaxMissedTimes, 0, "Maximum number of recent missed block times per validator (default 10)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liveness")
+	return cmd
+}
+
+func printLivenessTable(out io.Writer, res *types.QueryValidatorsLivenessResponse) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "jail threshold: %v\n\n", res.JailThreshold)
+	fmt.Fprintln(w, "VALIDATOR\tJAILED\tMISSED\tWINDOW\tRATIO\tUNTIL JAIL\tLAST MISSED")
+
+	for _, v := range res.Validators {
+		lastMissed := "-"
+		if n := len(v.RecentMissedBlockTimes); n > 0 {
+			lastMissed = v.RecentMissedBlockTimes[n-1].Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%d\t%d\t%.4f\t%d\t%v\n",
+			v.OperatorAddress, v.Jailed, v.MissedBlocksCounter, v.TotalBlocksCounter,
+			v.MissedRatio.MustFloat64(), v.MissedBlocksUntilJail, lastMissed,
+		)
+	}
+
+	return w.Flush()
+}
@@ -1,13 +1,18 @@
 package queries
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	IterateAccounts(ctx sdk.Context, cb func(account authtypes.AccountI) (stop bool))
 }
 
 type BankKeeper interface {
@@ -20,4 +25,15 @@ type BankKeeper interface {
 
 type SlashingKeeper interface {
 	GetMissedBlocks(ctx sdk.Context, consAddr sdk.ConsAddress) (int64, int64)
+	GetMissedBlockTimes(ctx sdk.Context, consAddr sdk.ConsAddress) ([]time.Time, int64)
+	MinSignedPerWindow(ctx sdk.Context) sdk.Dec
+}
+
+type StakingKeeper interface {
+	GetLastValidators(ctx sdk.Context) []stakingtypes.Validator
+	BondDenom(ctx sdk.Context) string
+}
+
+type DistributionKeeper interface {
+	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
 }
@@ -5,25 +5,29 @@ import (
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/e-money/em-ledger/x/buyback"
 	"github.com/e-money/em-ledger/x/queries/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-const stakingDenom = "ungm"
-
 var _ types.QueryServer = Querier{}
 
 type Querier struct {
-	accK AccountKeeper
-	bk   BankKeeper
-	sk   SlashingKeeper
+	accK     AccountKeeper
+	bk       BankKeeper
+	sk       SlashingKeeper
+	stakingK StakingKeeper
+	distrK   DistributionKeeper
 }
 
-func NewQuerier(accK AccountKeeper, bk BankKeeper, sk SlashingKeeper) *Querier {
-	return &Querier{accK: accK, bk: bk, sk: sk}
+func NewQuerier(accK AccountKeeper, bk BankKeeper, sk SlashingKeeper, stakingK StakingKeeper, distrK DistributionKeeper) *Querier {
+	return &Querier{accK: accK, bk: bk, sk: sk, stakingK: stakingK, distrK: distrK}
 }
 
 func (k Querier) Circulating(c context.Context, req *types.QueryCirculatingRequest) (*types.QueryCirculatingResponse, error) {
@@ -32,15 +36,15 @@ func (k Querier) Circulating(c context.Context, req *types.QueryCirculatingReque
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	total := calculateCirculatingSupply(ctx, k.accK, k.bk)
+	total := calculateCirculatingSupply(ctx, k.accK, k.bk, k.stakingK.BondDenom(ctx))
 
 	return &types.QueryCirculatingResponse{Total: total}, nil
 }
 
-func calculateCirculatingSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeeper) sdk.Coins {
-	denomsSupply, stakingDenomIdx := getDenomsSupply(ctx, bk)
+func calculateCirculatingSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeeper, stakingDenom string) sdk.Coins {
+	denomsSupply, stakingDenomIdx := getDenomsSupply(ctx, bk, stakingDenom)
 
-	ngmbalance := calcStakingSpendableSupply(ctx, accK, bk)
+	ngmbalance := calcStakingSpendableSupply(ctx, accK, bk, stakingDenom)
 
 	// Replace staking token balance with the one calculated above, which omits
 	// vesting and staked tokens.
@@ -49,7 +53,7 @@ func calculateCirculatingSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeep
 	return denomsSupply
 }
 
-func getDenomsSupply(ctx sdk.Context, bk BankKeeper) (sdk.Coins, int) {
+func getDenomsSupply(ctx sdk.Context, bk BankKeeper, stakingDenom string) (sdk.Coins, int) {
 	denoms := bk.GetAllDenomMetaData(ctx)
 	sort.Slice(denoms, func(i, j int) bool {
 		return denoms[i].Base < denoms[j].Base
@@ -74,7 +78,7 @@ func getDenomsSupply(ctx sdk.Context, bk BankKeeper) (sdk.Coins, int) {
 	return denomsSupply, stakingDenomIdx
 }
 
-func calcStakingSpendableSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeeper) sdk.Int {
+func calcStakingSpendableSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeeper, stakingDenom string) sdk.Int {
 	stakingAccounts := []sdk.AccAddress{
 		accK.GetModuleAccount(ctx, stakingtypes.NotBondedPoolName).GetAddress(),
 		accK.GetModuleAccount(ctx, stakingtypes.BondedPoolName).GetAddress(),
@@ -82,7 +86,7 @@ func calcStakingSpendableSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeep
 
 	bondedAndUnbondingBalance := sdk.ZeroInt()
 	for _, acc := range stakingAccounts {
-		bal := bk.GetBalance(ctx, acc, "ungm")
+		bal := bk.GetBalance(ctx, acc, stakingDenom)
 		bondedAndUnbondingBalance = bondedAndUnbondingBalance.Add(bal.Amount)
 	}
 
@@ -90,6 +94,76 @@ func calcStakingSpendableSupply(ctx sdk.Context, accK AccountKeeper, bk BankKeep
 	return totalSupply.Amount.Sub(bondedAndUnbondingBalance)
 }
 
+func (k Querier) SupplyBreakdown(c context.Context, req *types.QuerySupplyBreakdownRequest) (*types.QuerySupplyBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var denoms []string
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		denoms = []string{req.Denom}
+	} else {
+		for _, metadata := range k.bk.GetAllDenomMetaData(ctx) {
+			denoms = append(denoms, metadata.Base)
+		}
+		sort.Strings(denoms)
+	}
+
+	var (
+		bondedPool    = k.accK.GetModuleAddress(stakingtypes.BondedPoolName)
+		notBondedPool = k.accK.GetModuleAddress(stakingtypes.NotBondedPoolName)
+		buybackPool   = k.accK.GetModuleAddress(buyback.ModuleName)
+		vestingLocked = calcVestingLocked(ctx, k.accK)
+		communityPool = k.distrK.GetFeePoolCommunityCoins(ctx)
+	)
+
+	breakdowns := make([]types.SupplyBreakdown, len(denoms))
+	for i, denom := range denoms {
+		breakdown := types.SupplyBreakdown{
+			Denom:         denom,
+			Total:         k.bk.GetSupply(ctx, denom).Amount,
+			Bonded:        k.bk.GetBalance(ctx, bondedPool, denom).Amount,
+			Unbonding:     k.bk.GetBalance(ctx, notBondedPool, denom).Amount,
+			VestingLocked: vestingLocked.AmountOf(denom),
+			Buyback:       k.bk.GetBalance(ctx, buybackPool, denom).Amount,
+			CommunityPool: communityPool.AmountOf(denom).TruncateInt(),
+		}
+
+		circulating := breakdown.Total.
+			Sub(breakdown.Bonded).
+			Sub(breakdown.Unbonding).
+			Sub(breakdown.VestingLocked).
+			Sub(breakdown.Buyback).
+			Sub(breakdown.CommunityPool)
+		breakdown.Circulating = sdk.MaxInt(circulating, sdk.ZeroInt())
+
+		breakdowns[i] = breakdown
+	}
+
+	return &types.QuerySupplyBreakdownResponse{
+		StakingDenom: k.stakingK.BondDenom(ctx),
+		Breakdowns:   breakdowns,
+	}, nil
+}
+
+// calcVestingLocked sums the tokens that are still vesting across all vesting accounts. Delegated vesting tokens are
+// excluded as they are already accounted for by the staking pools.
+func calcVestingLocked(ctx sdk.Context, accK AccountKeeper) sdk.Coins {
+	locked := sdk.NewCoins()
+	accK.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		if vacc, ok := account.(vestexported.VestingAccount); ok {
+			locked = locked.Add(vacc.LockedCoins(ctx.BlockTime())...)
+		}
+		return false
+	})
+
+	return locked
+}
+
 func (k Querier) Spendable(c context.Context, req *types.QuerySpendableRequest) (*types.QuerySpendableResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -123,3 +197,100 @@ func (k Querier) MissedBlocks(c context.Context, req *types.QueryMissedBlocksReq
 		},
 	}, nil
 }
+
+const defaultMaxMissedTimes = 10
+
+func (k Querier) ValidatorsLiveness(c context.Context, req *types.QueryValidatorsLivenessRequest) (*types.QueryValidatorsLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validators := k.stakingK.GetLastValidators(ctx)
+	start, end, pageRes, err := paginate(len(validators), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	maxMissedTimes := int(req.MaxMissedTimes)
+	if maxMissedTimes == 0 {
+		maxMissedTimes = defaultMaxMissedTimes
+	}
+
+	jailThreshold := sdk.OneDec().Sub(k.sk.MinSignedPerWindow(ctx))
+
+	liveness := make([]types.ValidatorLiveness, 0, end-start)
+	for _, validator := range validators[start:end] {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		missedTimes, blockCount := k.sk.GetMissedBlockTimes(ctx, consAddr)
+		missedCount := int64(len(missedTimes))
+
+		// A validator is jailed once its missed ratio exceeds the threshold
+		jailedAt := jailThreshold.MulInt64(blockCount).TruncateInt64() + 1
+		untilJail := jailedAt - missedCount
+		if untilJail < 0 {
+			untilJail = 0
+		}
+
+		missedRatio := sdk.ZeroDec()
+		if blockCount > 0 {
+			missedRatio = sdk.NewDec(missedCount).QuoInt64(blockCount)
+		}
+
+		if len(missedTimes) > maxMissedTimes {
+			missedTimes = missedTimes[len(missedTimes)-maxMissedTimes:]
+		}
+
+		liveness = append(liveness, types.ValidatorLiveness{
+			OperatorAddress:        validator.OperatorAddress,
+			ConsAddress:            consAddr.String(),
+			Jailed:                 validator.IsJailed(),
+			MissedBlocksCounter:    missedCount,
+			TotalBlocksCounter:     blockCount,
+			MissedRatio:            missedRatio,
+			MissedBlocksUntilJail:  untilJail,
+			RecentMissedBlockTimes: missedTimes,
+		})
+	}
+
+	return &types.QueryValidatorsLivenessResponse{
+		Validators:    liveness,
+		JailThreshold: jailThreshold,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// paginate applies offset based pagination to a collection of the given length.
+func paginate(length int, pageReq *query.PageRequest) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start, end = length, length
+	if pageReq.Offset < uint64(length) {
+		start = int(pageReq.Offset)
+	}
+	if uint64(start)+limit < uint64(end) {
+		end = start + int(limit)
+	}
+
+	pageRes = &query.PageResponse{}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(length)
+	}
+
+	return start, end, pageRes, nil
+}
@@ -3,11 +3,15 @@ package queries
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/e-money/em-ledger/x/queries/types"
@@ -16,6 +20,16 @@ import (
 	"github.com/tendermint/tendermint/libs/rand"
 )
 
+const stakingDenom = "ungm"
+
+var (
+	livenessPubKeys = []*ed25519.PubKey{
+		ed25519.GenPrivKey().PubKey().(*ed25519.PubKey),
+		ed25519.GenPrivKey().PubKey().(*ed25519.PubKey),
+	}
+	livenessStart = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
 func newQServer() (context.Context, sdk.Context, types.QueryClient, bankKeeperMock) {
 	sdkCtx := sdk.Context{}.WithContext(context.Background())
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -48,10 +62,27 @@ func newQServer() (context.Context, sdk.Context, types.QueryClient, bankKeeperMo
 				TotalBlocksCounter:  10,
 			},
 		},
+		missedTimesMap: map[string][]time.Time{
+			sdk.ConsAddress(livenessPubKeys[0].Address()).String(): {
+				livenessStart.Add(time.Minute), livenessStart.Add(2 * time.Minute), livenessStart.Add(3 * time.Minute),
+			},
+		},
+		blockCount:         10,
+		minSignedPerWindow: sdk.NewDecWithPrec(5, 1),
+	}
+
+	var validators []stakingtypes.Validator
+	for i, pk := range livenessPubKeys {
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+		if err != nil {
+			panic(err)
+		}
+		validator.Jailed = i == 1
+		validators = append(validators, validator)
 	}
 
 	types.RegisterQueryServer(
-		queryHelper, NewQuerier(&accountKeeper, bkMock, skMock),
+		queryHelper, NewQuerier(&accountKeeper, bkMock, skMock, stakingKeeperMock{validators: validators}, distrKeeperMock{}),
 	)
 	queryClient := types.NewQueryClient(queryHelper)
 
@@ -96,6 +127,116 @@ func TestMissedBlocks(t *testing.T) {
 	assert.Equal(t, int64(10), gotMBRsp.MissedBlocksInfo.TotalBlocksCounter)
 }
 
+func TestValidatorsLiveness(t *testing.T) {
+	ctx, _, queryClient, _ := newQServer()
+
+	gotRsp, err := queryClient.ValidatorsLiveness(ctx, &types.QueryValidatorsLivenessRequest{MaxMissedTimes: 2})
+	require.NoError(t, err)
+	require.Len(t, gotRsp.Validators, 2)
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), gotRsp.JailThreshold)
+
+	absent := gotRsp.Validators[0]
+	assert.Equal(t, sdk.ValAddress(livenessPubKeys[0].Address()).String(), absent.OperatorAddress)
+	assert.Equal(t, sdk.ConsAddress(livenessPubKeys[0].Address()).String(), absent.ConsAddress)
+	assert.False(t, absent.Jailed)
+	assert.Equal(t, int64(3), absent.MissedBlocksCounter)
+	assert.Equal(t, int64(10), absent.TotalBlocksCounter)
+	assert.Equal(t, sdk.NewDecWithPrec(3, 1), absent.MissedRatio)
+	// Jailed once more than 5 out of 10 blocks are missed
+	assert.Equal(t, int64(3), absent.MissedBlocksUntilJail)
+	assert.Equal(t, []time.Time{livenessStart.Add(2 * time.Minute), livenessStart.Add(3 * time.Minute)}, absent.RecentMissedBlockTimes)
+
+	present := gotRsp.Validators[1]
+	assert.True(t, present.Jailed)
+	assert.Equal(t, int64(0), present.MissedBlocksCounter)
+	assert.True(t, present.MissedRatio.IsZero())
+	assert.Equal(t, int64(6), present.MissedBlocksUntilJail)
+	assert.Empty(t, present.RecentMissedBlockTimes)
+
+	gotRsp, err = queryClient.ValidatorsLiveness(ctx, &types.QueryValidatorsLivenessRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotRsp.Validators, 1)
+	assert.Equal(t, sdk.ConsAddress(livenessPubKeys[1].Address()).String(), gotRsp.Validators[0].ConsAddress)
+	assert.Equal(t, uint64(2), gotRsp.Pagination.Total)
+
+	gotRsp, err = queryClient.ValidatorsLiveness(ctx, &types.QueryValidatorsLivenessRequest{
+		Pagination: &query.PageRequest{Offset: 5},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, gotRsp.Validators)
+
+	_, err = queryClient.ValidatorsLiveness(ctx, &types.QueryValidatorsLivenessRequest{
+		Pagination: &query.PageRequest{Key: []byte("key")},
+	})
+	require.Error(t, err)
+}
+
+func TestSupplyBreakdown(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockTime(livenessStart)
+
+	var (
+		holder  sdk.AccAddress = rand.Bytes(20)
+		vesting sdk.AccAddress = rand.Bytes(20)
+	)
+
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vesting), mustParseCoins("100blx,60"+stakingDenom),
+		livenessStart.Add(-time.Hour).Unix(), livenessStart.Add(time.Hour).Unix(),
+	)
+	// Delegated vesting tokens are held by the bonded pool
+	vestingAcc.TrackDelegation(livenessStart, mustParseCoins("100blx,60"+stakingDenom), mustParseCoins("10"+stakingDenom))
+
+	bkMock := bankKeeperMock{
+		balances: map[string]sdk.Coins{
+			holder.String():  mustParseCoins("1000blx,500" + stakingDenom),
+			vesting.String(): mustParseCoins("100blx,50" + stakingDenom),
+			authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String():    mustParseCoins("300" + stakingDenom),
+			authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(): mustParseCoins("40" + stakingDenom),
+			authtypes.NewModuleAddress("buyback").String():                      mustParseCoins("7blx,5" + stakingDenom),
+			authtypes.NewModuleAddress("distribution").String():                 mustParseCoins("20" + stakingDenom),
+		},
+	}
+
+	querier := NewQuerier(
+		&accountKeeperMock{accounts: []authtypes.AccountI{authtypes.NewBaseAccountWithAddress(holder), vestingAcc}},
+		bkMock, slashingKeeperMock{}, stakingKeeperMock{}, distrKeeperMock{communityPool: sdk.NewDecCoins(sdk.NewDecCoinFromDec(stakingDenom, sdk.NewDecWithPrec(125, 1)))},
+	)
+
+	gotRsp, err := querier.SupplyBreakdown(sdk.WrapSDKContext(ctx), &types.QuerySupplyBreakdownRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, stakingDenom, gotRsp.StakingDenom)
+	require.Len(t, gotRsp.Breakdowns, 2)
+
+	blx := gotRsp.Breakdowns[0]
+	assert.Equal(t, "blx", blx.Denom)
+	assert.Equal(t, sdk.NewInt(1107), blx.Total)
+	assert.True(t, blx.Bonded.IsZero())
+	assert.Equal(t, sdk.NewInt(50), blx.VestingLocked)
+	assert.Equal(t, sdk.NewInt(7), blx.Buyback)
+	assert.Equal(t, sdk.NewInt(1050), blx.Circulating)
+
+	ngm := gotRsp.Breakdowns[1]
+	assert.Equal(t, stakingDenom, ngm.Denom)
+	assert.Equal(t, sdk.NewInt(915), ngm.Total)
+	assert.Equal(t, sdk.NewInt(300), ngm.Bonded)
+	assert.Equal(t, sdk.NewInt(40), ngm.Unbonding)
+	// Half of the 60 tokens have vested, 10 of the remaining 30 are delegated
+	assert.Equal(t, sdk.NewInt(20), ngm.VestingLocked)
+	assert.Equal(t, sdk.NewInt(5), ngm.Buyback)
+	assert.Equal(t, sdk.NewInt(12), ngm.CommunityPool)
+	assert.Equal(t, sdk.NewInt(538), ngm.Circulating)
+
+	gotRsp, err = querier.SupplyBreakdown(sdk.WrapSDKContext(ctx), &types.QuerySupplyBreakdownRequest{Denom: stakingDenom})
+	require.NoError(t, err)
+	require.Len(t, gotRsp.Breakdowns, 1)
+	assert.Equal(t, ngm, gotRsp.Breakdowns[0])
+
+	_, err = querier.SupplyBreakdown(sdk.WrapSDKContext(ctx), &types.QuerySupplyBreakdownRequest{Denom: "!"})
+	require.Error(t, err)
+}
+
 func mustParseCoins(s string) sdk.Coins {
 	if c, err := sdk.ParseCoinsNormalized(s); err == nil {
 		return c
@@ -104,14 +245,39 @@ func mustParseCoins(s string) sdk.Coins {
 	}
 }
 
-type accountKeeperMock struct{}
+type accountKeeperMock struct {
+	accounts []authtypes.AccountI
+}
 
 func (a accountKeeperMock) GetModuleAccount(_ sdk.Context, moduleName string) authtypes.ModuleAccountI {
 	return authtypes.NewEmptyModuleAccount(moduleName)
 }
 
+func (a accountKeeperMock) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (a accountKeeperMock) IterateAccounts(_ sdk.Context, cb func(account authtypes.AccountI) bool) {
+	for _, acc := range a.accounts {
+		if cb(acc) {
+			return
+		}
+	}
+}
+
 type slashingKeeperMock struct {
-	missedBlocksMap map[string]types.MissedBlocksInfo
+	missedBlocksMap    map[string]types.MissedBlocksInfo
+	missedTimesMap     map[string][]time.Time
+	blockCount         int64
+	minSignedPerWindow sdk.Dec
+}
+
+func (s slashingKeeperMock) GetMissedBlockTimes(_ sdk.Context, consAddr sdk.ConsAddress) ([]time.Time, int64) {
+	return s.missedTimesMap[consAddr.String()], s.blockCount
+}
+
+func (s slashingKeeperMock) MinSignedPerWindow(_ sdk.Context) sdk.Dec {
+	return s.minSignedPerWindow
 }
 
 func (s slashingKeeperMock) GetMissedBlocks(_ sdk.Context, consAddr sdk.ConsAddress) (int64, int64) {
@@ -119,6 +285,26 @@ func (s slashingKeeperMock) GetMissedBlocks(_ sdk.Context, consAddr sdk.ConsAddr
 		s.missedBlocksMap[consAddr.String()].TotalBlocksCounter
 }
 
+type stakingKeeperMock struct {
+	validators []stakingtypes.Validator
+}
+
+func (s stakingKeeperMock) GetLastValidators(_ sdk.Context) []stakingtypes.Validator {
+	return s.validators
+}
+
+func (s stakingKeeperMock) BondDenom(_ sdk.Context) string {
+	return stakingDenom
+}
+
+type distrKeeperMock struct {
+	communityPool sdk.DecCoins
+}
+
+func (d distrKeeperMock) GetFeePoolCommunityCoins(_ sdk.Context) sdk.DecCoins {
+	return d.communityPool
+}
+
 type bankKeeperMock struct {
 	balances map[string]sdk.Coins
 	vesting  sdk.Coins
@@ -169,7 +355,9 @@ func (b bankKeeperMock) GetSupply(_ sdk.Context, denom string) sdk.Coin {
 }
 
 var (
-	_ AccountKeeper  = &accountKeeperMock{}
-	_ BankKeeper     = &bankKeeperMock{}
-	_ SlashingKeeper = &slashingKeeperMock{}
+	_ AccountKeeper      = &accountKeeperMock{}
+	_ BankKeeper         = &bankKeeperMock{}
+	_ SlashingKeeper     = &slashingKeeperMock{}
+	_ StakingKeeper      = &stakingKeeperMock{}
+	_ DistributionKeeper = &distrKeeperMock{}
 )
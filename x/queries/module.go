@@ -30,9 +30,11 @@ type AppModuleBasic struct{}
 
 type AppModule struct {
 	AppModuleBasic
-	ak AccountKeeper
-	bk BankKeeper
-	sk SlashingKeeper
+	ak       AccountKeeper
+	bk       BankKeeper
+	sk       SlashingKeeper
+	stakingK StakingKeeper
+	distrK   DistributionKeeper
 }
 
 func (amb AppModuleBasic) Name() string { return types.ModuleName }
@@ -68,11 +70,13 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
-func NewAppModule(ak AccountKeeper, bk BankKeeper, sk SlashingKeeper) AppModule {
+func NewAppModule(ak AccountKeeper, bk BankKeeper, sk SlashingKeeper, stakingK StakingKeeper, distrK DistributionKeeper) AppModule {
 	return AppModule{
-		ak: ak,
-		bk: bk,
-		sk: sk,
+		ak:       ak,
+		bk:       bk,
+		sk:       sk,
+		stakingK: stakingK,
+		distrK:   distrK,
 	}
 }
 
@@ -100,7 +104,7 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(am.ak, am.bk, am.sk))
+	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(am.ak, am.bk, am.sk, am.stakingK, am.distrK))
 }
 
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
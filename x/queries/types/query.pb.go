@@ -8,9 +8,11 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -19,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -352,6 +356,368 @@ func (m *MissedBlocksInfo) GetTotalBlocksCounter() int64 {
 	return 0
 }
 
+type QueryValidatorsLivenessRequest struct {
+	// max_missed_times limits the number of recent missed block times returned
+	// per validator. Defaults to 10.
+	MaxMissedTimes uint32 `protobuf:"varint,1,opt,name=max_missed_times,json=maxMissedTimes,proto3" json:"max_missed_times,omitempty" yaml:"max_missed_times"`
+	// Only offset based pagination is supported.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsLivenessRequest) Reset()         { *m = QueryValidatorsLivenessRequest{} }
+func (m *QueryValidatorsLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsLivenessRequest) ProtoMessage()    {}
+func (*QueryValidatorsLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{7}
+}
+func (m *QueryValidatorsLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsLivenessRequest.Merge(m, src)
+}
+func (m *QueryValidatorsLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsLivenessRequest) GetMaxMissedTimes() uint32 {
+	if m != nil {
+		return m.MaxMissedTimes
+	}
+	return 0
+}
+
+func (m *QueryValidatorsLivenessRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryValidatorsLivenessResponse struct {
+	Validators []ValidatorLiveness `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators" yaml:"validators"`
+	// jail_threshold is the ratio of missed blocks within the signed blocks
+	// window above which a validator is jailed.
+	JailThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=jail_threshold,json=jailThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jail_threshold" yaml:"jail_threshold"`
+	Pagination    *query.PageResponse                    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsLivenessResponse) Reset()         { *m = QueryValidatorsLivenessResponse{} }
+func (m *QueryValidatorsLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsLivenessResponse) ProtoMessage()    {}
+func (*QueryValidatorsLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{8}
+}
+func (m *QueryValidatorsLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsLivenessResponse.Merge(m, src)
+}
+func (m *QueryValidatorsLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsLivenessResponse) GetValidators() []ValidatorLiveness {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryValidatorsLivenessResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ValidatorLiveness struct {
+	OperatorAddress     string                                 `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	ConsAddress         string                                 `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty" yaml:"cons_address"`
+	Jailed              bool                                   `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty" yaml:"jailed"`
+	MissedBlocksCounter int64                                  `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	TotalBlocksCounter  int64                                  `protobuf:"varint,5,opt,name=total_blocks_counter,json=totalBlocksCounter,proto3" json:"total_blocks_counter,omitempty" yaml:"total_blocks_counter"`
+	MissedRatio         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=missed_ratio,json=missedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"missed_ratio" yaml:"missed_ratio"`
+	// missed_blocks_until_jail is the number of additional blocks the validator
+	// can miss within the current window before crossing the jail threshold.
+	MissedBlocksUntilJail int64 `protobuf:"varint,7,opt,name=missed_blocks_until_jail,json=missedBlocksUntilJail,proto3" json:"missed_blocks_until_jail,omitempty" yaml:"missed_blocks_until_jail"`
+	// Most recent missed block times, newest last.
+	RecentMissedBlockTimes []time.Time `protobuf:"bytes,8,rep,name=recent_missed_block_times,json=recentMissedBlockTimes,proto3,stdtime" json:"recent_missed_block_times" yaml:"recent_missed_block_times"`
+}
+
+func (m *ValidatorLiveness) Reset()         { *m = ValidatorLiveness{} }
+func (m *ValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiveness) ProtoMessage()    {}
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{9}
+}
+func (m *ValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiveness.Merge(m, src)
+}
+func (m *ValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiveness proto.InternalMessageInfo
+
+func (m *ValidatorLiveness) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorLiveness) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *ValidatorLiveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ValidatorLiveness) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *ValidatorLiveness) GetTotalBlocksCounter() int64 {
+	if m != nil {
+		return m.TotalBlocksCounter
+	}
+	return 0
+}
+
+func (m *ValidatorLiveness) GetMissedBlocksUntilJail() int64 {
+	if m != nil {
+		return m.MissedBlocksUntilJail
+	}
+	return 0
+}
+
+func (m *ValidatorLiveness) GetRecentMissedBlockTimes() []time.Time {
+	if m != nil {
+		return m.RecentMissedBlockTimes
+	}
+	return nil
+}
+
+type QuerySupplyBreakdownRequest struct {
+	// denom optionally limits the breakdown to a single denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QuerySupplyBreakdownRequest) Reset()         { *m = QuerySupplyBreakdownRequest{} }
+func (m *QuerySupplyBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyBreakdownRequest) ProtoMessage()    {}
+func (*QuerySupplyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{10}
+}
+func (m *QuerySupplyBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyBreakdownRequest.Merge(m, src)
+}
+func (m *QuerySupplyBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyBreakdownRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyBreakdownRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QuerySupplyBreakdownResponse struct {
+	// The staking denomination as configured in the staking module.
+	StakingDenom string            `protobuf:"bytes,1,opt,name=staking_denom,json=stakingDenom,proto3" json:"staking_denom,omitempty" yaml:"staking_denom"`
+	Breakdowns   []SupplyBreakdown `protobuf:"bytes,2,rep,name=breakdowns,proto3" json:"breakdowns" yaml:"breakdowns"`
+}
+
+func (m *QuerySupplyBreakdownResponse) Reset()         { *m = QuerySupplyBreakdownResponse{} }
+func (m *QuerySupplyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyBreakdownResponse) ProtoMessage()    {}
+func (*QuerySupplyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{11}
+}
+func (m *QuerySupplyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyBreakdownResponse.Merge(m, src)
+}
+func (m *QuerySupplyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyBreakdownResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyBreakdownResponse) GetStakingDenom() string {
+	if m != nil {
+		return m.StakingDenom
+	}
+	return ""
+}
+
+func (m *QuerySupplyBreakdownResponse) GetBreakdowns() []SupplyBreakdown {
+	if m != nil {
+		return m.Breakdowns
+	}
+	return nil
+}
+
+// SupplyBreakdown explains how the circulating amount of a denomination is
+// derived from its total supply:
+// circulating = total - bonded - unbonding - vesting_locked - buyback - community_pool
+type SupplyBreakdown struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total" yaml:"total"`
+	// Balance of the bonded tokens pool.
+	Bonded github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded" yaml:"bonded"`
+	// Balance of the not bonded tokens pool, i.e. unbonding delegations.
+	Unbonding github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unbonding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding" yaml:"unbonding"`
+	// Still vesting tokens held by vesting accounts, excluding delegated ones.
+	VestingLocked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=vesting_locked,json=vestingLocked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vesting_locked" yaml:"vesting_locked"`
+	// Balance of the buyback module.
+	Buyback       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=buyback,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"buyback" yaml:"buyback"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool" yaml:"community_pool"`
+	Circulating   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=circulating,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"circulating" yaml:"circulating"`
+}
+
+func (m *SupplyBreakdown) Reset()         { *m = SupplyBreakdown{} }
+func (m *SupplyBreakdown) String() string { return proto.CompactTextString(m) }
+func (*SupplyBreakdown) ProtoMessage()    {}
+func (*SupplyBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c8a9303ec3ad728, []int{12}
+}
+func (m *SupplyBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyBreakdown.Merge(m, src)
+}
+func (m *SupplyBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyBreakdown proto.InternalMessageInfo
+
+func (m *SupplyBreakdown) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryCirculatingRequest)(nil), "em.queries.v1.QueryCirculatingRequest")
 	proto.RegisterType((*QueryCirculatingResponse)(nil), "em.queries.v1.QueryCirculatingResponse")
@@ -360,55 +726,106 @@ func init() {
 	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "em.queries.v1.QueryMissedBlocksRequest")
 	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "em.queries.v1.QueryMissedBlocksResponse")
 	proto.RegisterType((*MissedBlocksInfo)(nil), "em.queries.v1.MissedBlocksInfo")
+	proto.RegisterType((*QueryValidatorsLivenessRequest)(nil), "em.queries.v1.QueryValidatorsLivenessRequest")
+	proto.RegisterType((*QueryValidatorsLivenessResponse)(nil), "em.queries.v1.QueryValidatorsLivenessResponse")
+	proto.RegisterType((*ValidatorLiveness)(nil), "em.queries.v1.ValidatorLiveness")
+	proto.RegisterType((*QuerySupplyBreakdownRequest)(nil), "em.queries.v1.QuerySupplyBreakdownRequest")
+	proto.RegisterType((*QuerySupplyBreakdownResponse)(nil), "em.queries.v1.QuerySupplyBreakdownResponse")
+	proto.RegisterType((*SupplyBreakdown)(nil), "em.queries.v1.SupplyBreakdown")
 }
 
 func init() { proto.RegisterFile("em/queries/v1/query.proto", fileDescriptor_2c8a9303ec3ad728) }
 
 var fileDescriptor_2c8a9303ec3ad728 = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xcf, 0x35, 0xdf, 0x7e, 0x4b, 0x2f, 0x05, 0x55, 0x47, 0x0b, 0x49, 0xa8, 0xec, 0x60, 0x95,
-	0x92, 0xa1, 0xf5, 0x91, 0xb0, 0xa0, 0x4a, 0x48, 0xe0, 0x0a, 0x24, 0x06, 0x86, 0xa6, 0x4c, 0x2c,
-	0xd5, 0xd9, 0xb9, 0xba, 0x56, 0xed, 0x3b, 0x37, 0x77, 0x29, 0x44, 0x15, 0x0b, 0x42, 0x6c, 0x48,
-	0x20, 0x16, 0x24, 0x96, 0xce, 0xec, 0xfc, 0x0f, 0x1d, 0x2b, 0xb1, 0x30, 0x05, 0xd4, 0x32, 0x74,
-	0xce, 0x5f, 0x80, 0x7c, 0xbe, 0x54, 0xce, 0x0f, 0x14, 0x98, 0xe2, 0xbc, 0xf7, 0xb9, 0xcf, 0xfb,
-	0x7c, 0xde, 0xbd, 0x77, 0xb0, 0x44, 0x23, 0xbc, 0xdf, 0xa6, 0xad, 0x80, 0x0a, 0x7c, 0x50, 0x53,
-	0x9f, 0x1d, 0x3b, 0x6e, 0x71, 0xc9, 0xd1, 0x65, 0x1a, 0xd9, 0x3a, 0x65, 0x1f, 0xd4, 0xca, 0x0b,
-	0x3e, 0xf7, 0xb9, 0xca, 0xe0, 0xe4, 0x2b, 0x05, 0x95, 0x0d, 0x8f, 0x8b, 0x88, 0x0b, 0xec, 0x12,
-	0x41, 0xf1, 0x41, 0xcd, 0xa5, 0x92, 0xd4, 0xb0, 0xc7, 0x03, 0xa6, 0xf3, 0x4b, 0x3e, 0xe7, 0x7e,
-	0x48, 0x31, 0x89, 0x03, 0x4c, 0x18, 0xe3, 0x92, 0xc8, 0x80, 0x33, 0xa1, 0xb3, 0xa6, 0xce, 0xaa,
-	0x7f, 0x6e, 0x7b, 0x07, 0xcb, 0x20, 0xa2, 0x42, 0x92, 0x28, 0x4e, 0x01, 0x56, 0x09, 0x5e, 0xdf,
-	0x4c, 0x24, 0x6d, 0x04, 0x2d, 0xaf, 0x1d, 0x12, 0x19, 0x30, 0xbf, 0x41, 0xf7, 0xdb, 0x54, 0x48,
-	0xeb, 0x1d, 0x80, 0xc5, 0xd1, 0x9c, 0x88, 0x39, 0x13, 0x14, 0xed, 0xc3, 0x69, 0xc9, 0x25, 0x09,
-	0x8b, 0xa0, 0x92, 0xaf, 0x16, 0xea, 0x25, 0x3b, 0x95, 0x69, 0x27, 0x32, 0x6d, 0x2d, 0xd3, 0xde,
-	0xe0, 0x01, 0x73, 0x1e, 0x1c, 0x77, 0xcd, 0x5c, 0xaf, 0x6b, 0xce, 0x75, 0x48, 0x14, 0xae, 0x5b,
-	0xea, 0x94, 0xf5, 0xe5, 0x87, 0x59, 0xf5, 0x03, 0xb9, 0xdb, 0x76, 0x6d, 0x8f, 0x47, 0x58, 0x7b,
-	0x4c, 0x7f, 0xd6, 0x44, 0x73, 0x0f, 0xcb, 0x4e, 0x4c, 0x85, 0x22, 0x10, 0x8d, 0xb4, 0x92, 0xf5,
-	0x08, 0x2e, 0x2a, 0x39, 0x5b, 0x31, 0x65, 0x4d, 0xe2, 0x86, 0x54, 0x0b, 0x45, 0xab, 0x70, 0x86,
-	0x34, 0x9b, 0x2d, 0x2a, 0x44, 0x11, 0x54, 0x40, 0x75, 0xd6, 0x41, 0xbd, 0xae, 0x79, 0x25, 0x2d,
-	0xa7, 0x13, 0x56, 0xa3, 0x0f, 0xb1, 0x3e, 0x00, 0x78, 0x6d, 0x98, 0x47, 0x9b, 0x7a, 0x01, 0x67,
-	0x5c, 0x12, 0x12, 0xe6, 0xd1, 0xc9, 0xb6, 0x1c, 0x6d, 0x4b, 0xd7, 0xd1, 0xe7, 0xfe, 0xcd, 0x58,
-	0xbf, 0x9a, 0x75, 0x5f, 0x77, 0xfa, 0x69, 0x20, 0x04, 0x6d, 0x3a, 0x21, 0xf7, 0xf6, 0x44, 0xdf,
-	0xdd, 0x4d, 0x38, 0xe7, 0x71, 0x26, 0xb6, 0x07, 0x2c, 0x36, 0x0a, 0x49, 0xec, 0xa1, 0xb6, 0x14,
-	0xc3, 0xd2, 0x98, 0xe3, 0xda, 0xd4, 0x16, 0x44, 0x91, 0x8a, 0x6f, 0xbb, 0x2a, 0xb1, 0x1d, 0xb0,
-	0x1d, 0xae, 0x58, 0x0a, 0x75, 0xd3, 0x1e, 0x18, 0x41, 0x3b, 0x4b, 0xf0, 0x84, 0xed, 0x70, 0xe7,
-	0xbf, 0xc4, 0x65, 0x63, 0x3e, 0x1a, 0x8a, 0x5b, 0xe7, 0x00, 0xce, 0x0f, 0x83, 0xff, 0x42, 0x29,
-	0x7a, 0x06, 0x17, 0x07, 0xc5, 0x78, 0xbc, 0xcd, 0x24, 0x6d, 0x15, 0xa7, 0x2a, 0xa0, 0x9a, 0x77,
-	0x2a, 0xbd, 0xae, 0xb9, 0x94, 0x36, 0x74, 0x2c, 0xcc, 0x6a, 0x5c, 0xcd, 0x4a, 0xd9, 0x48, 0xa3,
-	0x68, 0x13, 0x2e, 0xa8, 0x11, 0x19, 0x26, 0xcd, 0x2b, 0x52, 0xb3, 0xd7, 0x35, 0x6f, 0x64, 0x86,
-	0x6f, 0x84, 0x13, 0xa9, 0xf0, 0x00, 0xe5, 0xfa, 0xa5, 0x4f, 0x47, 0x26, 0x38, 0x3f, 0x32, 0x41,
-	0xfd, 0x6b, 0x1e, 0x4e, 0xab, 0xee, 0xa2, 0x37, 0x00, 0x16, 0x32, 0xbb, 0x80, 0x56, 0x86, 0xba,
-	0xf7, 0x87, 0x45, 0x2a, 0xdf, 0x9e, 0x88, 0x4b, 0xaf, 0xca, 0x5a, 0x7e, 0xfd, 0xed, 0xd7, 0xc7,
-	0x29, 0x03, 0x2d, 0x61, 0xba, 0x16, 0x71, 0x46, 0x3b, 0xd8, 0x25, 0x6c, 0x2f, 0x79, 0x36, 0xbc,
-	0x4c, 0xd9, 0xcf, 0x00, 0xce, 0x65, 0x7b, 0x8f, 0xc6, 0xf2, 0x8f, 0x19, 0xa5, 0x72, 0x75, 0x32,
-	0x50, 0x2b, 0xb9, 0xa7, 0x94, 0xd4, 0xd1, 0x9d, 0x0b, 0x25, 0x22, 0x24, 0x62, 0x37, 0x60, 0x7e,
-	0xa2, 0x26, 0xbd, 0x83, 0xb4, 0x8d, 0xf8, 0x30, 0x7b, 0xe7, 0xaf, 0xd0, 0x5b, 0x00, 0x67, 0x2f,
-	0x36, 0x0b, 0x2d, 0x8f, 0xab, 0x38, 0xbc, 0xc0, 0xe5, 0x5b, 0x13, 0x50, 0x5a, 0xd4, 0xaa, 0x12,
-	0xb5, 0x82, 0x96, 0x47, 0xda, 0x23, 0xfa, 0x58, 0x7c, 0xd8, 0x17, 0xe2, 0x3c, 0x3e, 0x3e, 0x35,
-	0xc0, 0xc9, 0xa9, 0x01, 0x7e, 0x9e, 0x1a, 0xe0, 0xfd, 0x99, 0x91, 0x3b, 0x39, 0x33, 0x72, 0xdf,
-	0xcf, 0x8c, 0xdc, 0xf3, 0xd5, 0xcc, 0x82, 0xf6, 0x99, 0x68, 0xb4, 0x16, 0xd2, 0xa6, 0x4f, 0x5b,
-	0xf8, 0xe5, 0xc5, 0x73, 0xad, 0x56, 0xd5, 0xfd, 0x5f, 0x3d, 0x94, 0x77, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x52, 0xe0, 0xfc, 0xc9, 0x05, 0x00, 0x00,
+	// 1404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x36, 0x75, 0xfe, 0x8c, 0xe3, 0x36, 0x9d, 0x36, 0xad, 0xe3, 0x06, 0x6f, 0x3a, 0x6d,
+	0xd3, 0xb4, 0x24, 0xbb, 0x24, 0x5c, 0x50, 0xa5, 0x42, 0xd9, 0x36, 0xad, 0x8a, 0x8a, 0xd4, 0x6e,
+	0x03, 0x88, 0x82, 0x64, 0xc6, 0xbb, 0x13, 0x67, 0xc9, 0xee, 0x8c, 0xeb, 0x59, 0xa7, 0xb5, 0x2a,
+	0x2e, 0x80, 0xb8, 0x21, 0x15, 0x21, 0x24, 0x04, 0x97, 0x9e, 0xb9, 0x70, 0x04, 0xbe, 0x41, 0x8f,
+	0x95, 0xb8, 0x20, 0x0e, 0x2e, 0x6a, 0x38, 0x54, 0x1c, 0x38, 0xf8, 0x13, 0xa0, 0x9d, 0x99, 0xb5,
+	0x77, 0xd7, 0x8e, 0x12, 0x83, 0x38, 0xc5, 0xf3, 0xde, 0x9b, 0xdf, 0xfb, 0xbd, 0x97, 0xf7, 0xde,
+	0xbc, 0x05, 0xb3, 0x24, 0x30, 0xef, 0x35, 0x49, 0xc3, 0x23, 0xdc, 0xdc, 0x5e, 0x11, 0x3f, 0x5b,
+	0x46, 0xbd, 0xc1, 0x42, 0x06, 0x0b, 0x24, 0x30, 0x94, 0xca, 0xd8, 0x5e, 0x29, 0x1d, 0xab, 0xb1,
+	0x1a, 0x13, 0x1a, 0x33, 0xfa, 0x25, 0x8d, 0x4a, 0x65, 0x87, 0xf1, 0x80, 0x71, 0xb3, 0x8a, 0x39,
+	0x31, 0xb7, 0x57, 0xaa, 0x24, 0xc4, 0x2b, 0xa6, 0xc3, 0x3c, 0xaa, 0xf4, 0x17, 0x92, 0x7a, 0x81,
+	0xde, 0xb5, 0xaa, 0xe3, 0x9a, 0x47, 0x71, 0xe8, 0xb1, 0xd8, 0x76, 0xae, 0xc6, 0x58, 0xcd, 0x27,
+	0x26, 0xae, 0x7b, 0x26, 0xa6, 0x94, 0x85, 0x42, 0xc9, 0x95, 0x56, 0x57, 0x5a, 0x71, 0xaa, 0x36,
+	0x37, 0xcc, 0xd0, 0x0b, 0x08, 0x0f, 0x71, 0x50, 0x97, 0x06, 0x68, 0x16, 0x9c, 0xb8, 0x1d, 0x39,
+	0xb8, 0xe2, 0x35, 0x9c, 0xa6, 0x8f, 0x43, 0x8f, 0xd6, 0x6c, 0x72, 0xaf, 0x49, 0x78, 0x88, 0xbe,
+	0xd4, 0x40, 0xb1, 0x5f, 0xc7, 0xeb, 0x8c, 0x72, 0x02, 0xef, 0x81, 0x5c, 0xc8, 0x42, 0xec, 0x17,
+	0xb5, 0xf9, 0xd1, 0xc5, 0xfc, 0xea, 0xac, 0x21, 0x29, 0x1b, 0x11, 0x65, 0x43, 0x91, 0x35, 0xae,
+	0x30, 0x8f, 0x5a, 0x97, 0x9f, 0xb4, 0xf5, 0x91, 0x4e, 0x5b, 0x9f, 0x6a, 0xe1, 0xc0, 0xbf, 0x88,
+	0xc4, 0x2d, 0xf4, 0xc3, 0x33, 0x7d, 0xb1, 0xe6, 0x85, 0x9b, 0xcd, 0xaa, 0xe1, 0xb0, 0xc0, 0x54,
+	0xf1, 0xca, 0x3f, 0xcb, 0xdc, 0xdd, 0x32, 0xc3, 0x56, 0x9d, 0x70, 0x01, 0xc0, 0x6d, 0xe9, 0x09,
+	0xad, 0x81, 0x19, 0x41, 0xe7, 0x4e, 0x9d, 0x50, 0x17, 0x57, 0x7d, 0xa2, 0x88, 0xc2, 0x25, 0x30,
+	0x8e, 0x5d, 0xb7, 0x41, 0x38, 0x2f, 0x6a, 0xf3, 0xda, 0xe2, 0xa4, 0x05, 0x3b, 0x6d, 0xfd, 0x90,
+	0x74, 0xa7, 0x14, 0xc8, 0x8e, 0x4d, 0xd0, 0x57, 0x1a, 0x38, 0x9e, 0xc5, 0x51, 0x41, 0xdd, 0x07,
+	0xe3, 0x55, 0xec, 0x63, 0xea, 0x90, 0xbd, 0xc3, 0xb2, 0x54, 0x58, 0xca, 0x8f, 0xba, 0x37, 0x5c,
+	0x60, 0xb1, 0x37, 0x74, 0x49, 0x65, 0xfa, 0x6d, 0x8f, 0x73, 0xe2, 0x5a, 0x3e, 0x73, 0xb6, 0x78,
+	0x1c, 0xdd, 0x29, 0x30, 0xe5, 0x30, 0xca, 0x2b, 0xa9, 0x10, 0xed, 0x7c, 0x24, 0x7b, 0x53, 0x85,
+	0x54, 0x07, 0xb3, 0x03, 0xae, 0xab, 0xa0, 0xee, 0x00, 0x18, 0x08, 0x79, 0xa5, 0x2a, 0x14, 0x15,
+	0x8f, 0x6e, 0x30, 0x81, 0x92, 0x5f, 0xd5, 0x8d, 0x54, 0xb9, 0x1a, 0x49, 0x80, 0x1b, 0x74, 0x83,
+	0x59, 0x07, 0xa3, 0x28, 0xed, 0xe9, 0x20, 0x23, 0x47, 0x2f, 0x34, 0x30, 0x9d, 0x35, 0xde, 0x07,
+	0x53, 0xb8, 0x0e, 0x66, 0xd2, 0x64, 0x1c, 0xd6, 0xa4, 0x21, 0x69, 0x14, 0x0f, 0xcc, 0x6b, 0x8b,
+	0xa3, 0xd6, 0x7c, 0xa7, 0xad, 0xcf, 0xc9, 0x84, 0x0e, 0x34, 0x43, 0xf6, 0xd1, 0x24, 0x95, 0x2b,
+	0x52, 0x0a, 0x6f, 0x83, 0x63, 0xa2, 0x44, 0xb2, 0xa0, 0xa3, 0x02, 0x54, 0xef, 0xb4, 0xf5, 0x93,
+	0x89, 0xe2, 0xeb, 0xc3, 0x84, 0x42, 0x9c, 0x82, 0xbc, 0x38, 0xf1, 0xed, 0x63, 0x5d, 0x7b, 0xf1,
+	0x58, 0xd7, 0xd0, 0x8f, 0x1a, 0x28, 0x8b, 0xec, 0xbe, 0x8b, 0x7d, 0xcf, 0xc5, 0x21, 0x6b, 0xf0,
+	0x9b, 0xde, 0x36, 0xa1, 0x84, 0x77, 0xff, 0x45, 0x6b, 0x60, 0x3a, 0xc0, 0x0f, 0x2a, 0x8a, 0xb2,
+	0x68, 0x31, 0x11, 0x7c, 0xc1, 0x3a, 0xd9, 0x69, 0xeb, 0x27, 0x54, 0x40, 0x19, 0x0b, 0x64, 0x1f,
+	0x0a, 0xf0, 0x03, 0x99, 0xc4, 0xf5, 0x48, 0x00, 0xaf, 0x01, 0xd0, 0x6b, 0x6f, 0x91, 0x91, 0xfc,
+	0xea, 0x42, 0xaa, 0x02, 0xe5, 0xa4, 0x89, 0xeb, 0xf0, 0x16, 0xae, 0xc5, 0x3d, 0x60, 0x27, 0x6e,
+	0xa2, 0x9f, 0x0e, 0x00, 0x7d, 0x57, 0xc6, 0xaa, 0x2a, 0x3e, 0x00, 0x60, 0xbb, 0xab, 0x55, 0xd5,
+	0x3e, 0x9f, 0xa9, 0x86, 0xee, 0xf5, 0xf8, 0xb6, 0x35, 0xab, 0x8a, 0xfe, 0x88, 0x0c, 0xa9, 0x87,
+	0x80, 0xec, 0x04, 0x1c, 0xa4, 0xe0, 0xd0, 0xc7, 0xd8, 0xf3, 0x2b, 0xe1, 0x66, 0x83, 0xf0, 0x4d,
+	0xe6, 0xbb, 0x22, 0x98, 0x49, 0xeb, 0x7a, 0x74, 0xfd, 0xf7, 0xb6, 0xbe, 0xb0, 0x8f, 0x0e, 0xb9,
+	0x4a, 0x9c, 0x4e, 0x5b, 0x9f, 0x91, 0x8e, 0xd2, 0x68, 0xc8, 0x2e, 0x44, 0x82, 0xf5, 0xf8, 0x0c,
+	0xaf, 0xa7, 0x12, 0x37, 0x2a, 0x12, 0x77, 0x6e, 0xcf, 0xc4, 0xc9, 0x4c, 0xa4, 0x32, 0xf7, 0x4b,
+	0x0e, 0x1c, 0xe9, 0x8b, 0x1a, 0x5e, 0x03, 0xd3, 0xac, 0x4e, 0x1a, 0x91, 0x2c, 0x5d, 0xdb, 0xc9,
+	0x7f, 0x6f, 0xd6, 0x02, 0xd9, 0x87, 0x63, 0x51, 0x5c, 0xfc, 0x17, 0x33, 0xfd, 0x21, 0x93, 0x72,
+	0xa2, 0xd3, 0xd6, 0x8f, 0x4a, 0x8c, 0xa4, 0x16, 0xa5, 0x1b, 0xe7, 0x3c, 0x18, 0x8b, 0x62, 0x26,
+	0xae, 0x08, 0x6f, 0xc2, 0x3a, 0xd2, 0x69, 0xeb, 0x85, 0x5e, 0x72, 0x88, 0x8b, 0x6c, 0x65, 0xb0,
+	0x7b, 0x8f, 0x1d, 0xfc, 0x3f, 0x7a, 0x2c, 0xf7, 0xaf, 0x7b, 0x0c, 0x6e, 0x82, 0x29, 0xc5, 0xa0,
+	0x11, 0xa5, 0xbf, 0x38, 0x26, 0xf2, 0xb1, 0x36, 0x74, 0x91, 0x1c, 0x4d, 0x45, 0x23, 0xb0, 0x90,
+	0x9d, 0x97, 0x47, 0x3b, 0x3a, 0xc1, 0x0f, 0x41, 0x31, 0x1d, 0x6b, 0x93, 0x86, 0x9e, 0x5f, 0x89,
+	0x12, 0x56, 0x1c, 0x17, 0x01, 0x9c, 0xee, 0xb4, 0x75, 0x7d, 0x50, 0x56, 0x7a, 0x96, 0xc8, 0x9e,
+	0x49, 0x26, 0xe6, 0x9d, 0x48, 0xf1, 0x16, 0xf6, 0x7c, 0xf8, 0x99, 0x06, 0x66, 0x1b, 0xc4, 0x21,
+	0x34, 0xac, 0x24, 0xef, 0xaa, 0x41, 0x30, 0x21, 0x7a, 0xab, 0x64, 0xc8, 0x97, 0xd8, 0x88, 0x5f,
+	0x62, 0x63, 0x3d, 0x7e, 0x89, 0xad, 0x25, 0xd5, 0x55, 0xf3, 0xd2, 0xff, 0xae, 0x50, 0xe8, 0xd1,
+	0x33, 0x5d, 0xb3, 0x8f, 0x4b, 0x7d, 0x62, 0xfa, 0x0a, 0x24, 0xb4, 0x06, 0x4e, 0xca, 0x67, 0xad,
+	0x59, 0xaf, 0xfb, 0x2d, 0xab, 0x41, 0xf0, 0x96, 0xcb, 0xee, 0xd3, 0x78, 0x46, 0x2d, 0x80, 0x9c,
+	0x4b, 0x28, 0x0b, 0x54, 0xe5, 0x4e, 0xf7, 0x5e, 0x64, 0x21, 0x46, 0xb6, 0x54, 0xa3, 0x9f, 0x35,
+	0x30, 0x37, 0x18, 0x47, 0x4d, 0x8e, 0x4b, 0xa0, 0xc0, 0x43, 0xbc, 0xe5, 0xd1, 0x5a, 0x25, 0x09,
+	0x58, 0xec, 0xb4, 0xf5, 0x63, 0x12, 0x30, 0xa5, 0x46, 0xf6, 0x94, 0x3a, 0x5f, 0x8d, 0x8e, 0xf0,
+	0x7d, 0x00, 0xaa, 0x31, 0x66, 0xd4, 0x02, 0x51, 0x72, 0xca, 0x99, 0xc1, 0x93, 0x71, 0x9d, 0x1d,
+	0x3b, 0xbd, 0xfb, 0xc8, 0x4e, 0x80, 0xa1, 0xbf, 0x73, 0xe0, 0x70, 0xe6, 0xea, 0x7e, 0xc3, 0x86,
+	0xeb, 0xf1, 0x3e, 0x23, 0x9b, 0xf2, 0xf5, 0x21, 0x8a, 0xf0, 0x06, 0x0d, 0xb3, 0xeb, 0x8d, 0x5a,
+	0x59, 0xe0, 0x7b, 0x60, 0xac, 0xca, 0xa8, 0xab, 0xba, 0x76, 0xd2, 0x7a, 0x63, 0x68, 0x58, 0xd5,
+	0xe3, 0x12, 0x05, 0xd9, 0x0a, 0x0e, 0x7e, 0x04, 0x26, 0x9b, 0x34, 0xfa, 0xed, 0xd1, 0x9a, 0xe8,
+	0xeb, 0x49, 0xcb, 0x1a, 0x1a, 0x7b, 0x5a, 0x62, 0x77, 0x81, 0x90, 0xdd, 0x03, 0x8d, 0x66, 0xf8,
+	0x36, 0xe1, 0xd1, 0xce, 0x57, 0x89, 0x6a, 0x8c, 0xb8, 0xc5, 0xdc, 0xd0, 0x33, 0x5c, 0xba, 0x51,
+	0x33, 0x3c, 0x8d, 0x86, 0xec, 0x82, 0x12, 0xdc, 0x14, 0x67, 0x78, 0x17, 0x8c, 0x57, 0x9b, 0xad,
+	0x2a, 0x76, 0xb6, 0xd4, 0x1c, 0xb8, 0x3c, 0xb4, 0xa3, 0x78, 0x15, 0x93, 0x30, 0xc8, 0x8e, 0x01,
+	0xa3, 0x58, 0x1c, 0x16, 0x04, 0x4d, 0xea, 0x85, 0xad, 0x4a, 0x9d, 0x31, 0xd9, 0xf4, 0xff, 0x21,
+	0x96, 0x34, 0x1a, 0xb2, 0x0b, 0x5d, 0xc1, 0x2d, 0xc6, 0x7c, 0xb8, 0x01, 0xf2, 0x4e, 0x6f, 0x67,
+	0x2e, 0x4e, 0x08, 0x67, 0x57, 0x87, 0x76, 0x06, 0x95, 0xb3, 0x1e, 0x54, 0xf4, 0x28, 0xf4, 0x4e,
+	0xab, 0x7f, 0xe5, 0x40, 0x4e, 0xf4, 0x2a, 0xfc, 0x5c, 0x03, 0xf9, 0xc4, 0x9a, 0x0e, 0x17, 0x32,
+	0x1d, 0xb5, 0xcb, 0x8e, 0x5f, 0x3a, 0xb7, 0xa7, 0x9d, 0xec, 0x7a, 0x74, 0xe6, 0xd3, 0x5f, 0xff,
+	0xfc, 0xfa, 0x40, 0x19, 0xce, 0x99, 0x64, 0x39, 0x60, 0x94, 0xb4, 0xcc, 0x2a, 0xa6, 0x5b, 0xd1,
+	0xd7, 0x4f, 0x82, 0x10, 0xfc, 0x5e, 0x03, 0x53, 0xc9, 0xb5, 0x10, 0x0e, 0xc4, 0x1f, 0xb0, 0xe5,
+	0x96, 0x16, 0xf7, 0x36, 0x54, 0x4c, 0x5e, 0x13, 0x4c, 0x56, 0xe1, 0x2b, 0x5d, 0x26, 0xdc, 0xc7,
+	0x7c, 0xd3, 0xa3, 0xb5, 0x88, 0x8d, 0x9c, 0x9a, 0x72, 0x76, 0x9b, 0x0f, 0x93, 0x0f, 0xea, 0x27,
+	0xf0, 0x0b, 0x0d, 0x4c, 0x76, 0x97, 0x7e, 0x78, 0x66, 0x90, 0xc7, 0xec, 0xb7, 0x45, 0xe9, 0xec,
+	0x1e, 0x56, 0x8a, 0xd4, 0x92, 0x20, 0xb5, 0x00, 0xcf, 0xf4, 0xa5, 0x87, 0xc7, 0xb6, 0xe6, 0xc3,
+	0x2e, 0x91, 0xef, 0x34, 0x00, 0xfb, 0x77, 0x33, 0xb8, 0x3c, 0xc8, 0xd7, 0xae, 0x5b, 0x67, 0xc9,
+	0xd8, 0xaf, 0xb9, 0xe2, 0x78, 0x56, 0x70, 0xd4, 0xe1, 0x4b, 0x03, 0x13, 0xe7, 0xc7, 0x2c, 0xbe,
+	0xd1, 0xfa, 0xa7, 0xe8, 0x85, 0x81, 0x59, 0x18, 0xf8, 0xd0, 0x94, 0x5e, 0xde, 0x97, 0xad, 0xe2,
+	0x74, 0x5e, 0x70, 0x3a, 0x0d, 0x4f, 0xf5, 0xe7, 0x4d, 0xdc, 0xa8, 0x74, 0xc7, 0xbb, 0x75, 0xed,
+	0xc9, 0xf3, 0xb2, 0xf6, 0xf4, 0x79, 0x59, 0xfb, 0xe3, 0x79, 0x59, 0x7b, 0xb4, 0x53, 0x1e, 0x79,
+	0xba, 0x53, 0x1e, 0xf9, 0x6d, 0xa7, 0x3c, 0x72, 0x77, 0x29, 0xd1, 0x51, 0x31, 0x0c, 0x09, 0x96,
+	0x7d, 0xe2, 0xd6, 0x48, 0xc3, 0x7c, 0xd0, 0xfd, 0x54, 0x17, 0xbd, 0x55, 0x1d, 0x13, 0x2f, 0xf0,
+	0xab, 0xff, 0x0c, 0x00, 0x24, 0xf6, 0xe4, 0x07, 0xc5, 0x0f, 0x00, 0x00,
 }
 
 func (this *MissedBlocksInfo) Equal(that interface{}) bool {
@@ -457,6 +874,11 @@ type QueryClient interface {
 	Circulating(ctx context.Context, in *QueryCirculatingRequest, opts ...grpc.CallOption) (*QueryCirculatingResponse, error)
 	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
 	Spendable(ctx context.Context, in *QuerySpendableRequest, opts ...grpc.CallOption) (*QuerySpendableResponse, error)
+	// Liveness of the bonded validators over the signed blocks window
+	ValidatorsLiveness(ctx context.Context, in *QueryValidatorsLivenessRequest, opts ...grpc.CallOption) (*QueryValidatorsLivenessResponse, error)
+	// Breakdown of the supply of each denomination into the amounts held out of
+	// circulation
+	SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error)
 }
 
 type queryClient struct {
@@ -494,11 +916,34 @@ func (c *queryClient) Spendable(ctx context.Context, in *QuerySpendableRequest,
 	return out, nil
 }
 
+func (c *queryClient) ValidatorsLiveness(ctx context.Context, in *QueryValidatorsLivenessRequest, opts ...grpc.CallOption) (*QueryValidatorsLivenessResponse, error) {
+	out := new(QueryValidatorsLivenessResponse)
+	err := c.cc.Invoke(ctx, "/em.queries.v1.Query/ValidatorsLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error) {
+	out := new(QuerySupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/em.queries.v1.Query/SupplyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Circulating(context.Context, *QueryCirculatingRequest) (*QueryCirculatingResponse, error)
 	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
 	Spendable(context.Context, *QuerySpendableRequest) (*QuerySpendableResponse, error)
+	// Liveness of the bonded validators over the signed blocks window
+	ValidatorsLiveness(context.Context, *QueryValidatorsLivenessRequest) (*QueryValidatorsLivenessResponse, error)
+	// Breakdown of the supply of each denomination into the amounts held out of
+	// circulation
+	SupplyBreakdown(context.Context, *QuerySupplyBreakdownRequest) (*QuerySupplyBreakdownResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -514,6 +959,12 @@ func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMis
 func (*UnimplementedQueryServer) Spendable(ctx context.Context, req *QuerySpendableRequest) (*QuerySpendableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Spendable not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorsLiveness(ctx context.Context, req *QueryValidatorsLivenessRequest) (*QueryValidatorsLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsLiveness not implemented")
+}
+func (*UnimplementedQueryServer) SupplyBreakdown(ctx context.Context, req *QuerySupplyBreakdownRequest) (*QuerySupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyBreakdown not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -573,6 +1024,42 @@ func _Query_Spendable_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorsLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.queries.v1.Query/ValidatorsLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsLiveness(ctx, req.(*QueryValidatorsLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.queries.v1.Query/SupplyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyBreakdown(ctx, req.(*QuerySupplyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.queries.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -589,6 +1076,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Spendable",
 			Handler:    _Query_Spendable_Handler,
 		},
+		{
+			MethodName: "ValidatorsLiveness",
+			Handler:    _Query_ValidatorsLiveness_Handler,
+		},
+		{
+			MethodName: "SupplyBreakdown",
+			Handler:    _Query_SupplyBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/queries/v1/query.proto",
@@ -824,147 +1319,1172 @@ func (m *MissedBlocksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorsLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCirculatingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryValidatorsLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCirculatingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorsLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.MaxMissedTimes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMissedTimes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpendableRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorsLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	{
+		size := m.JailThreshold.Size()
+		i -= size
+		if _, err := m.JailThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpendableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.RecentMissedBlockTimes) > 0 {
+		for iNdEx := len(m.RecentMissedBlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecentMissedBlockTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecentMissedBlockTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintQuery(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	return n
+	if m.MissedBlocksUntilJail != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksUntilJail))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MissedRatio.Size()
+		i -= size
+		if _, err := m.MissedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TotalBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalBlocksCounter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissedBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupplyBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissedBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupplyBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MissedBlocksInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MissedBlocksInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupplyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	if len(m.Breakdowns) > 0 {
+		for iNdEx := len(m.Breakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.TotalBlocksCounter != 0 {
-		n += 1 + sovQuery(uint64(m.TotalBlocksCounter))
+	if len(m.StakingDenom) > 0 {
+		i -= len(m.StakingDenom)
+		copy(dAtA[i:], m.StakingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SupplyBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SupplyBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryCirculatingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *SupplyBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Circulating.Size()
+		i -= size
+		if _, err := m.Circulating.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingRequest: wiretype end group for non-group")
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Buyback.Size()
+		i -= size
+		if _, err := m.Buyback.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.VestingLocked.Size()
+		i -= size
+		if _, err := m.VestingLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Unbonding.Size()
+		i -= size
+		if _, err := m.Unbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Bonded.Size()
+		i -= size
+		if _, err := m.Bonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCirculatingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpendableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MissedBlocksInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MissedBlocksInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if m.TotalBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBlocksCounter))
+	}
+	return n
+}
+
+func (m *QueryValidatorsLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMissedTimes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMissedTimes))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.JailThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if m.TotalBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBlocksCounter))
+	}
+	l = m.MissedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MissedBlocksUntilJail != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksUntilJail))
+	}
+	if len(m.RecentMissedBlockTimes) > 0 {
+		for _, e := range m.RecentMissedBlockTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupplyBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Breakdowns) > 0 {
+		for _, e := range m.Breakdowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Bonded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VestingLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Buyback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Circulating.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCirculatingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissedBlocksInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedBlocksInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedBlocksInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlocksCounter", wireType)
+			}
+			m.TotalBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -986,7 +2506,7 @@ func (m *QueryCirculatingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1009,15 +2529,34 @@ func (m *QueryCirculatingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedTimes", wireType)
+			}
+			m.MaxMissedTimes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedTimes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1044,8 +2583,10 @@ func (m *QueryCirculatingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1070,7 +2611,7 @@ func (m *QueryCirculatingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,15 +2634,49 @@ func (m *QuerySpendableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorLiveness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1129,7 +2704,45 @@ func (m *QuerySpendableRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.JailThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1152,7 +2765,7 @@ func (m *QuerySpendableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1175,15 +2788,190 @@ func (m *QuerySpendableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlocksCounter", wireType)
+			}
+			m.TotalBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksUntilJail", wireType)
+			}
+			m.MissedBlocksUntilJail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksUntilJail |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentMissedBlockTimes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1210,8 +2998,8 @@ func (m *QuerySpendableResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecentMissedBlockTimes = append(m.RecentMissedBlockTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.RecentMissedBlockTimes[len(m.RecentMissedBlockTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1236,7 +3024,7 @@ func (m *QuerySpendableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1259,15 +3047,15 @@ func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1295,7 +3083,7 @@ func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1318,7 +3106,7 @@ func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,15 +3129,47 @@ func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyBreakdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1376,7 +3196,8 @@ func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MissedBlocksInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Breakdowns = append(m.Breakdowns, SupplyBreakdown{})
+			if err := m.Breakdowns[len(m.Breakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1401,7 +3222,7 @@ func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
+func (m *SupplyBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,15 +3245,15 @@ func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MissedBlocksInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyBreakdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MissedBlocksInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1460,13 +3281,13 @@ func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1476,16 +3297,31 @@ func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlocksCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
 			}
-			m.TotalBlocksCounter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1495,11 +3331,196 @@ func (m *MissedBlocksInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalBlocksCounter |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingLocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Buyback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Circulating.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
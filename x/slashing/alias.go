@@ -7,6 +7,7 @@ package slashing
 import (
 	sdktypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/e-money/em-ledger/x/slashing/keeper"
+	"github.com/e-money/em-ledger/x/slashing/types"
 )
 
 const (
@@ -17,10 +18,13 @@ const (
 )
 
 var (
-	NewKeeper    = keeper.NewKeeper
-	BeginBlocker = keeper.BeginBlocker
+	NewKeeper      = keeper.NewKeeper
+	NewSnapshotter = keeper.NewSnapshotter
+	BeginBlocker   = keeper.BeginBlocker
 )
 
 type (
-	Keeper = keeper.Keeper
+	Keeper       = keeper.Keeper
+	Snapshotter  = keeper.Snapshotter
+	GenesisState = types.GenesisState
 )
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkslashingcli "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd extends the Cosmos SDK slashing queries with the downtime penalty queries.
+func GetQueryCmd() *cobra.Command {
+	cmd := sdkslashingcli.GetQueryCmd()
+	cmd.AddCommand(
+		GetQueryDowntimeInfoCmd(),
+		GetQueryDowntimePenaltyParamsCmd(),
+	)
+
+	return cmd
+}
+
+func GetQueryDowntimeInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "downtime-info [cons_address]",
+		Short:   "Display the downtime penalty status and offences of a validator",
+		Example: "emd query slashing downtime-info emvalcons1...",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DowntimeInfo(cmd.Context(), &types.QueryDowntimeInfoRequest{ConsAddress: consAddr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryDowntimePenaltyParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-params",
+		Short: "Display the downtime penalty parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DowntimePenaltyParams(cmd.Context(), &types.QueryDowntimePenaltyParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
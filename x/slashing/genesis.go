@@ -1,30 +1,79 @@
 package slashing
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkslashing "github.com/cosmos/cosmos-sdk/x/slashing"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/slashing/keeper"
+	"github.com/e-money/em-ledger/x/slashing/types"
 )
 
+// InitGenesis initializes the signing infos and parameters in the store and
+// restores the signed blocks window into the application database.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper sdkslashingtypes.StakingKeeper, data *types.GenesisState) error {
+	batch := apptypes.GetCurrentBatch(ctx)
+	if batch == nil {
+		return errors.New("no application database batch in context")
+	}
+
+	// Genesis states exported before the downtime penalties were introduced are imported with the default parameters
+	data.SetDefaultDowntimePenaltyParams()
+
+	sdkslashing.InitGenesis(ctx, keeper.Keeper, stakingKeeper, data.SDKGenesisState())
+
+	window := types.LivenessWindow{
+		BlockTimes:       data.BlockTimes,
+		MissedBlockTimes: data.MissedBlockTimes,
+	}
+
+	if err := keeper.SetLivenessWindow(batch, window); err != nil {
+		return sdkerrors.Wrap(err, "liveness window")
+	}
+
+	keeper.SetDowntimePenaltyParams(ctx, data.DowntimePenaltyParams)
+	for _, info := range data.DowntimeInfos {
+		consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+		if err != nil {
+			return sdkerrors.Wrap(err, "downtime info")
+		}
+
+		keeper.SetValidatorDowntimeInfo(ctx, consAddr, info)
+	}
+
+	return nil
+}
+
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisState) {
 	params := keeper.GetParams(ctx)
 
-	signingInfos := make([]types.SigningInfo, 0)
+	signingInfos := make([]sdkslashingtypes.SigningInfo, 0)
 
-	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info sdkslashingtypes.ValidatorSigningInfo) (stop bool) {
 		bechAddr := address.String()
-		signingInfos = append(signingInfos, types.SigningInfo{
+		signingInfos = append(signingInfos, sdkslashingtypes.SigningInfo{
 			Address:              bechAddr,
 			ValidatorSigningInfo: info,
 		})
 
-		// Note: Omitting missed blocks in export.
+		return false
+	})
 
+	downtimeInfos := make([]types.ValidatorDowntimeInfo, 0)
+	keeper.IterateValidatorDowntimeInfos(ctx, func(info types.ValidatorDowntimeInfo) (stop bool) {
+		downtimeInfos = append(downtimeInfos, info)
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, nil)
+	window := keeper.GetLivenessWindow()
+	return types.NewGenesisState(
+		params, signingInfos, window.MissedBlockTimes, window.BlockTimes,
+		keeper.GetDowntimePenaltyParams(ctx), downtimeInfos,
+	)
 }
@@ -343,6 +343,7 @@ func createTestComponents(t *testing.T) (sdk.Context, Keeper, banktypes.AccountK
 
 	keeper := NewKeeper(encConfig.Marshaler, slashingKey, sk, pk.Subspace(sdkslashingtypes.ModuleName), bk, db, authtypes.FeeCollectorName)
 	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetDowntimePenaltyParams(ctx, types.DefaultDowntimePenaltyParams())
 	sk.SetHooks(keeper.Hooks())
 	return ctx, keeper, ak, bk, sk, db
 }
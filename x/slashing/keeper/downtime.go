@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/e-money/em-ledger/x/slashing/types"
+)
+
+var downtimeInfoKeyPrefix = []byte{0x20}
+
+func getDowntimeInfoKey(consAddr sdk.ConsAddress) []byte {
+	return append(downtimeInfoKeyPrefix, address.MustLengthPrefix(consAddr)...)
+}
+
+func (k Keeper) GetDowntimePenaltyParams(ctx sdk.Context) (params types.DowntimePenaltyParams) {
+	k.paramspace.GetParamSet(ctx, &params)
+	return
+}
+
+func (k Keeper) SetDowntimePenaltyParams(ctx sdk.Context, params types.DowntimePenaltyParams) {
+	k.paramspace.SetParamSet(ctx, &params)
+}
+
+// GetValidatorDowntimeInfo returns the downtime penalty tier and offences of a validator.
+func (k Keeper) GetValidatorDowntimeInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (info types.ValidatorDowntimeInfo, found bool) {
+	bz := ctx.KVStore(k.StoreKey).Get(getDowntimeInfoKey(consAddr))
+	if bz == nil {
+		return types.ValidatorDowntimeInfo{Address: consAddr.String()}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+func (k Keeper) SetValidatorDowntimeInfo(ctx sdk.Context, consAddr sdk.ConsAddress, info types.ValidatorDowntimeInfo) {
+	store := ctx.KVStore(k.StoreKey)
+
+	// Only keep records that carry information
+	if info.Status == types.DowntimeStatus_OK && info.OffenceCount == 0 {
+		store.Delete(getDowntimeInfoKey(consAddr))
+		return
+	}
+
+	store.Set(getDowntimeInfoKey(consAddr), k.cdc.MustMarshal(&info))
+}
+
+func (k Keeper) IterateValidatorDowntimeInfos(ctx sdk.Context, cb func(info types.ValidatorDowntimeInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.StoreKey), downtimeInfoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorDowntimeInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// RewardFraction returns the share of its block rewards that a validator is currently eligible for.
+func (k Keeper) RewardFraction(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.Dec {
+	info, found := k.GetValidatorDowntimeInfo(ctx, consAddr)
+	if !found || info.Status != types.DowntimeStatus_ReducedRewards {
+		return sdk.OneDec()
+	}
+
+	return sdk.OneDec().Sub(k.GetDowntimePenaltyParams(ctx).RewardReduction)
+}
+
+// updateDowntimeStatus moves the validator between the penalty tiers that precede jailing.
+func (k Keeper) updateDowntimeStatus(ctx sdk.Context, consAddr sdk.ConsAddress, missedRatio, jailThreshold sdk.Dec) {
+	if !jailThreshold.IsPositive() {
+		return
+	}
+
+	params := k.GetDowntimePenaltyParams(ctx)
+	thresholdRatio := missedRatio.Quo(jailThreshold)
+
+	status := types.DowntimeStatus_OK
+	switch {
+	case params.ReducedRewardsRatio.IsPositive() && thresholdRatio.GTE(params.ReducedRewardsRatio):
+		status = types.DowntimeStatus_ReducedRewards
+	case params.WarningRatio.IsPositive() && thresholdRatio.GTE(params.WarningRatio):
+		status = types.DowntimeStatus_Warning
+	}
+
+	info, _ := k.GetValidatorDowntimeInfo(ctx, consAddr)
+	if info.Status == status {
+		return
+	}
+
+	k.Logger(ctx).Info("Validator downtime status changed", "validator", consAddr.String(), "from", info.Status, "to", status)
+
+	info.Status = status
+	k.SetValidatorDowntimeInfo(ctx, consAddr, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDowntimeStatus,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			sdk.NewAttribute(types.AttributeKeyMissedRatio, missedRatio.String()),
+		),
+	)
+}
+
+// recordDowntimeOffence registers a downtime offence and returns the slash fraction, which escalates with every prior offence
+// within the repeat offence period.
+func (k Keeper) recordDowntimeOffence(ctx sdk.Context, consAddr sdk.ConsAddress) (slashFraction sdk.Dec, offenceCount uint32) {
+	params := k.GetDowntimePenaltyParams(ctx)
+	info, _ := k.GetValidatorDowntimeInfo(ctx, consAddr)
+
+	var priorOffences uint32
+	if info.OffenceCount > 0 && !info.LastOffenceTime.Add(params.RepeatOffencePeriod).Before(ctx.BlockTime()) {
+		priorOffences = info.OffenceCount
+	}
+
+	slashFraction = k.SlashFractionDowntime(ctx)
+	if priorOffences > 0 {
+		escalated := slashFraction.Mul(params.RepeatOffenceMultiplier.Power(uint64(priorOffences)))
+		slashFraction = sdk.MaxDec(slashFraction, sdk.MinDec(escalated, params.MaxSlashFractionDowntime))
+	}
+
+	info.Status = types.DowntimeStatus_OK
+	info.OffenceCount = priorOffences + 1
+	info.LastOffenceTime = ctx.BlockTime()
+	k.SetValidatorDowntimeInfo(ctx, consAddr, info)
+
+	return slashFraction, info.OffenceCount
+}
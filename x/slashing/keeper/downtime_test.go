@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowntimeStatusTiers(t *testing.T) {
+	ctx, keeper, _, _, _, _ := createTestComponents(t)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	jailThreshold := sdk.NewDecWithPrec(5, 1)
+
+	specs := map[string]struct {
+		missedRatio sdk.Dec
+		expStatus   types.DowntimeStatus
+		expEvent    bool
+	}{
+		"below warning":        {missedRatio: sdk.NewDecWithPrec(1, 1), expStatus: types.DowntimeStatus_OK},
+		"warning":              {missedRatio: sdk.NewDecWithPrec(25, 2), expStatus: types.DowntimeStatus_Warning, expEvent: true},
+		"still warning":        {missedRatio: sdk.NewDecWithPrec(3, 1), expStatus: types.DowntimeStatus_Warning},
+		"reduced rewards":      {missedRatio: sdk.NewDecWithPrec(4, 1), expStatus: types.DowntimeStatus_ReducedRewards, expEvent: true},
+		"recovered to warning": {missedRatio: sdk.NewDecWithPrec(3, 1), expStatus: types.DowntimeStatus_Warning, expEvent: true},
+		"recovered completely": {missedRatio: sdk.ZeroDec(), expStatus: types.DowntimeStatus_OK, expEvent: true},
+	}
+
+	// The specs depend on each other, so run them in order
+	for _, name := range []string{"below warning", "warning", "still warning", "reduced rewards", "recovered to warning", "recovered completely"} {
+		spec := specs[name]
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			keeper.updateDowntimeStatus(ctx.WithEventManager(em), consAddr, spec.missedRatio, jailThreshold)
+
+			info, _ := keeper.GetValidatorDowntimeInfo(ctx, consAddr)
+			require.Equal(t, spec.expStatus, info.Status)
+
+			if spec.expEvent {
+				require.Len(t, em.Events(), 1)
+				require.Equal(t, types.EventTypeDowntimeStatus, em.Events()[0].Type)
+			} else {
+				require.Empty(t, em.Events())
+			}
+		})
+	}
+
+	// OK records without offences are not stored
+	_, found := keeper.GetValidatorDowntimeInfo(ctx, consAddr)
+	require.False(t, found)
+}
+
+func TestDowntimeStatusBeforeFullWindow(t *testing.T) {
+	ctx, keeper, _, _, _, database := createTestComponents(t)
+	keeper.SetParams(ctx, keeperTestParams())
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	ctx = ctx.WithBlockTime(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	// The validator cannot be jailed before the first window is full, but its downtime status follows its missed blocks
+	batch := database.NewBatch()
+	keeper.HandleValidatorSignature(ctx, batch, pks[0].Address(), 100, false, 1, false)
+	require.NoError(t, batch.Write())
+
+	info, found := keeper.GetValidatorDowntimeInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.DowntimeStatus_ReducedRewards, info.Status)
+	require.Zero(t, info.OffenceCount)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	batch = database.NewBatch()
+	keeper.HandleValidatorSignature(ctx, batch, pks[0].Address(), 100, true, 100, false)
+	require.NoError(t, batch.Write())
+
+	info, _ = keeper.GetValidatorDowntimeInfo(ctx, consAddr)
+	require.Equal(t, types.DowntimeStatus_OK, info.Status)
+}
+
+func TestRewardFraction(t *testing.T) {
+	ctx, keeper, _, _, _, _ := createTestComponents(t)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+
+	require.Equal(t, sdk.OneDec(), keeper.RewardFraction(ctx, consAddr))
+
+	keeper.SetValidatorDowntimeInfo(ctx, consAddr, types.ValidatorDowntimeInfo{Address: consAddr.String(), Status: types.DowntimeStatus_Warning})
+	require.Equal(t, sdk.OneDec(), keeper.RewardFraction(ctx, consAddr))
+
+	keeper.SetValidatorDowntimeInfo(ctx, consAddr, types.ValidatorDowntimeInfo{Address: consAddr.String(), Status: types.DowntimeStatus_ReducedRewards})
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), keeper.RewardFraction(ctx, consAddr))
+}
+
+func TestRepeatDowntimeOffences(t *testing.T) {
+	ctx, keeper, _, _, _, _ := createTestComponents(t)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+
+	params := keeper.GetDowntimePenaltyParams(ctx)
+	params.MaxSlashFractionDowntime = sdk.NewDecWithPrec(3, 3)
+	keeper.SetDowntimePenaltyParams(ctx, params)
+
+	keeper.SetParams(ctx, keeperTestParams())
+	base := keeper.SlashFractionDowntime(ctx)
+
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	fraction, count := keeper.recordDowntimeOffence(ctx, consAddr)
+	require.Equal(t, base, fraction)
+	require.Equal(t, uint32(1), count)
+
+	// Second offence within the repeat offence period is multiplied
+	ctx = ctx.WithBlockTime(now.Add(24 * time.Hour))
+	fraction, count = keeper.recordDowntimeOffence(ctx, consAddr)
+	require.Equal(t, base.Mul(params.RepeatOffenceMultiplier), fraction)
+	require.Equal(t, uint32(2), count)
+
+	// Further offences are capped
+	ctx = ctx.WithBlockTime(now.Add(48 * time.Hour))
+	fraction, count = keeper.recordDowntimeOffence(ctx, consAddr)
+	require.Equal(t, params.MaxSlashFractionDowntime, fraction)
+	require.Equal(t, uint32(3), count)
+
+	info, found := keeper.GetValidatorDowntimeInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.DowntimeStatus_OK, info.Status)
+	require.Equal(t, ctx.BlockTime(), info.LastOffenceTime)
+
+	// Offences outside of the repeat offence period are forgotten
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.RepeatOffencePeriod + time.Second))
+	fraction, count = keeper.recordDowntimeOffence(ctx, consAddr)
+	require.Equal(t, base, fraction)
+	require.Equal(t, uint32(1), count)
+}
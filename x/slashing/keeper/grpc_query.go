@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) DowntimeInfo(c context.Context, req *types.QueryDowntimeInfoRequest) (*types.QueryDowntimeInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator consensus address: "+err.Error())
+	}
+
+	info, _ := k.GetValidatorDowntimeInfo(ctx, consAddr)
+	return &types.QueryDowntimeInfoResponse{Info: info}, nil
+}
+
+func (k Keeper) DowntimePenaltyParams(c context.Context, req *types.QueryDowntimePenaltyParamsRequest) (*types.QueryDowntimePenaltyParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDowntimePenaltyParamsResponse{Params: k.GetDowntimePenaltyParams(ctx)}, nil
+}
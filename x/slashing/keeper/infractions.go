@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	emtypes "github.com/e-money/em-ledger/x/slashing/types"
 	"github.com/tendermint/tendermint/crypto"
 	db "github.com/tendermint/tm-db"
 )
@@ -18,6 +20,18 @@ func (k Keeper) GetMissedBlocks(ctx sdk.Context, consAddr sdk.ConsAddress) (int6
 	return int64(len(missedTimeBlocks)), int64(len(blockTimes))
 }
 
+// GetMissedBlockTimes returns the times of the blocks missed by the validator within the signed blocks window along with the
+// number of blocks in the window.
+func (k Keeper) GetMissedBlockTimes(ctx sdk.Context, consAddr sdk.ConsAddress) ([]time.Time, int64) {
+	missedTimeBlocks := k.getMissingBlocksForValidator(consAddr)
+	_, missedTimeBlocks = truncateByWindow(ctx.BlockTime(), missedTimeBlocks, k.SignedBlocksWindowDuration(ctx))
+
+	blockTimes := k.getBlockTimes()
+	blockTimes = append(blockTimes, ctx.BlockTime())
+
+	return missedTimeBlocks, int64(len(blockTimes))
+}
+
 func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr crypto.Address, power int64, signed bool, blockCount int64, slashable bool) {
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
@@ -48,17 +62,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 
 	k.setMissingBlocksForValidator(batch, consAddr, missedBlocks)
 
-	// Validator is only slashable if the signed block window is full (was truncated)
-	if !slashable {
-		return
+	missedRatio := sdk.ZeroDec()
+	if blockCount > 0 {
+		missedRatio = sdk.NewInt(int64(len(missedBlocks))).ToDec().QuoInt64(blockCount)
 	}
-
-	missedBlockCount := sdk.NewInt(int64(len(missedBlocks))).ToDec()
-	missedRatio := missedBlockCount.QuoInt64(blockCount)
 	minSignedPerWindow := k.MinSignedPerWindow(ctx)
+	jailThreshold := sdk.OneDec().Sub(minSignedPerWindow)
 
-	// if we are past the minimum height and the validator has missed too many blocks, punish them
-	if sdk.OneDec().Sub(minSignedPerWindow).LT(missedRatio) {
+	// Validator is only slashable if the signed block window is full (was truncated). If we are past the minimum height
+	// and the validator has missed too many blocks, punish them.
+	if slashable && jailThreshold.LT(missedRatio) {
 		validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
 		if validator != nil && !validator.IsJailed() {
 			// Downtime confirmed: slash and jail the validator
@@ -72,6 +85,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+			// Repeat offenders within the lookback period are slashed harder
+			slashFraction, offenceCount := k.recordDowntimeOffence(ctx, consAddr)
+
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -79,10 +95,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(emtypes.AttributeKeySlashFraction, slashFraction.String()),
+					sdk.NewAttribute(emtypes.AttributeKeyOffenceCount, fmt.Sprintf("%d", offenceCount)),
 				),
 			)
 
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
 			// fetch signing info
@@ -102,5 +120,10 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, batch db.Batch, addr c
 				fmt.Sprintf("Validator %s would have been slashed for downtime, but was either not found in store or already jailed", consAddr),
 			)
 		}
+
+		return
 	}
+
+	// Below the jail threshold, or while the first window fills, escalate through the warning and reduced rewards tiers
+	k.updateDowntimeStatus(ctx, consAddr, missedRatio, jailThreshold)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -45,6 +46,11 @@ func NewKeeper(
 	database types.ReadOnlyDB,
 	feeModuleName string,
 ) Keeper {
+	// Register the downtime penalty parameters alongside those of the Cosmos SDK
+	if !paramspace.HasKeyTable() {
+		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		Keeper:        sdkslashingkeeper.NewKeeper(cdc, key, sk, paramspace),
 		StoreKey:      key,
@@ -120,3 +126,66 @@ func (k Keeper) setBlockTimes(batch db.Batch, blockTimes []time.Time) {
 	_ = enc.Encode(blockTimes)
 	batch.Set([]byte(dbKeyBlockTimes), bz.Bytes())
 }
+
+// iterateMissingBlocks iterates over the missed block times of all validators that have been absent within the signed blocks window.
+func (k Keeper) iterateMissingBlocks(cb func(address sdk.ConsAddress, missingBlocks []time.Time) (stop bool)) {
+	prefix := []byte(sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+	suffix := fmt.Sprintf(dbKeyMissedByVal, "")
+
+	iterator, err := k.database.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := string(iterator.Key())
+		if !strings.HasSuffix(key, suffix) {
+			continue
+		}
+
+		address, err := sdk.ConsAddressFromBech32(strings.TrimSuffix(key, suffix))
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(address, k.getMissingBlocksForValidator(address)) {
+			break
+		}
+	}
+}
+
+// GetLivenessWindow returns the block times and the missed blocks of all validators within the signed blocks window.
+func (k Keeper) GetLivenessWindow() types.LivenessWindow {
+	window := types.LivenessWindow{
+		BlockTimes:       k.getBlockTimes(),
+		MissedBlockTimes: make([]types.ValidatorMissedBlockTimes, 0),
+	}
+
+	k.iterateMissingBlocks(func(address sdk.ConsAddress, missingBlocks []time.Time) bool {
+		if len(missingBlocks) > 0 {
+			window.MissedBlockTimes = append(window.MissedBlockTimes, types.ValidatorMissedBlockTimes{
+				Address:          address.String(),
+				MissedBlockTimes: missingBlocks,
+			})
+		}
+		return false
+	})
+
+	return window
+}
+
+// SetLivenessWindow restores the signed blocks window, e.g. from genesis or a state-sync snapshot.
+func (k Keeper) SetLivenessWindow(batch db.Batch, window types.LivenessWindow) error {
+	for _, missed := range window.MissedBlockTimes {
+		address, err := sdk.ConsAddressFromBech32(missed.Address)
+		if err != nil {
+			return err
+		}
+
+		k.setMissingBlocksForValidator(batch, address, missed.MissedBlockTimes)
+	}
+
+	k.setBlockTimes(batch, window.BlockTimes)
+	return nil
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+	"io"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	protoio "github.com/gogo/protobuf/io"
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	SnapshotFormat = 1
+	SnapshotName   = "emslashing"
+
+	// Checkpoints are kept for the two most recent snapshot heights, so that a snapshot can complete while the next
+	// checkpoint is taken.
+	dbKeyWindowCheckpoint = "windowcheckpoint.%020d"
+	keepCheckpoints       = 2
+)
+
+var _ snapshottypes.ExtensionSnapshotter = (*Snapshotter)(nil)
+
+// Snapshotter carries the signed blocks window through state-sync snapshots, as it is not part of the IAVL state.
+//
+// The application database is not versioned and snapshots are taken in the background after their height has been
+// committed, so the window is checkpointed at the snapshot heights while the blocks are executed.
+type Snapshotter struct {
+	keeper           Keeper
+	database         db.DB
+	snapshotInterval uint64
+}
+
+func NewSnapshotter(keeper Keeper, database db.DB, snapshotInterval uint64) *Snapshotter {
+	return &Snapshotter{
+		keeper:           keeper,
+		database:         database,
+		snapshotInterval: snapshotInterval,
+	}
+}
+
+func (s *Snapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// Checkpoint records the signed blocks window of a snapshot height. It must be called once the window of the block
+// has been written to the application database.
+func (s *Snapshotter) Checkpoint(height int64) error {
+	if s.snapshotInterval == 0 || height <= 0 || uint64(height)%s.snapshotInterval != 0 {
+		return nil
+	}
+
+	window := s.keeper.GetLivenessWindow()
+	bz, err := s.keeper.cdc.Marshal(&window)
+	if err != nil {
+		return err
+	}
+
+	batch := s.database.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(checkpointKey(uint64(height)), bz); err != nil {
+		return err
+	}
+
+	if retained := s.snapshotInterval * keepCheckpoints; uint64(height) > retained {
+		iterator, err := s.database.Iterator(checkpointKey(0), checkpointKey(uint64(height)-retained+1))
+		if err != nil {
+			return err
+		}
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			if err := batch.Delete(iterator.Key()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// Snapshot writes the signed blocks window checkpointed at the snapshot height.
+func (s *Snapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	bz, err := s.database.Get(checkpointKey(height))
+	if err != nil {
+		return err
+	}
+
+	if bz == nil {
+		return fmt.Errorf("no signed blocks window checkpoint at height %d", height)
+	}
+
+	return snapshottypes.WriteExtensionItem(protoWriter, bz)
+}
+
+func (s *Snapshotter) Restore(_ uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	if format != SnapshotFormat {
+		return snapshottypes.SnapshotItem{}, snapshottypes.ErrUnknownFormat
+	}
+
+	batch := s.database.NewBatch()
+	defer batch.Close()
+
+	var next snapshottypes.SnapshotItem
+	for {
+		item := snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			// Reached the items of the next extension
+			next = item
+			break
+		}
+
+		var window types.LivenessWindow
+		if err := s.keeper.cdc.Unmarshal(payload.Payload, &window); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+
+		if err := s.keeper.SetLivenessWindow(batch, window); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return next, nil
+}
+
+func checkpointKey(height uint64) []byte {
+	return []byte(fmt.Sprintf(dbKeyWindowCheckpoint, height))
+}
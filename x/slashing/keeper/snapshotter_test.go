@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/slashing/types"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestLivenessWindow(t *testing.T) {
+	_, keeper, _, _, _, database := createTestComponents(t)
+
+	window := keeper.GetLivenessWindow()
+	require.Empty(t, window.BlockTimes)
+	require.Empty(t, window.MissedBlockTimes)
+
+	window = testLivenessWindow()
+	batch := database.NewBatch()
+	require.NoError(t, keeper.SetLivenessWindow(batch, window))
+	require.NoError(t, batch.Write())
+
+	require.Equal(t, window, keeper.GetLivenessWindow())
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	_, keeper, _, _, _, database := createTestComponents(t)
+
+	window := testLivenessWindow()
+	batch := database.NewBatch()
+	require.NoError(t, keeper.SetLivenessWindow(batch, window))
+	require.NoError(t, batch.Write())
+
+	snapshotter := NewSnapshotter(keeper, database, 10)
+	require.NoError(t, snapshotter.Checkpoint(10))
+
+	// Blocks committed while the snapshot is taken do not affect it
+	batch = database.NewBatch()
+	require.NoError(t, keeper.SetLivenessWindow(batch, types.LivenessWindow{BlockTimes: window.BlockTimes[:1]}))
+	require.NoError(t, batch.Write())
+
+	buf := new(bytes.Buffer)
+	writer := protoio.NewDelimitedWriter(buf)
+	require.NoError(t, snapshotter.Snapshot(10, writer))
+	require.NoError(t, writer.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{Extension: &snapshottypes.SnapshotExtensionMeta{Name: "next"}},
+	}))
+
+	// Restore into an empty database
+	restoredDB := dbm.NewMemDB()
+	restoredKeeper := keeper
+	restoredKeeper.database = restoredDB
+
+	reader := protoio.NewDelimitedReader(buf, 1e6)
+	next, err := NewSnapshotter(restoredKeeper, restoredDB, 10).Restore(10, SnapshotFormat, reader)
+	require.NoError(t, err)
+	require.Equal(t, "next", next.GetExtension().Name)
+
+	require.Equal(t, window, restoredKeeper.GetLivenessWindow())
+
+	_, err = NewSnapshotter(restoredKeeper, restoredDB, 10).Restore(10, SnapshotFormat+1, reader)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
+
+func TestSnapshotCheckpoints(t *testing.T) {
+	_, keeper, _, _, _, database := createTestComponents(t)
+
+	snapshotter := NewSnapshotter(keeper, database, 10)
+	for height := int64(1); height <= 40; height++ {
+		require.NoError(t, snapshotter.Checkpoint(height))
+	}
+
+	// Only the two most recent snapshot heights are retained
+	for height, found := range map[uint64]bool{5: false, 10: false, 20: false, 30: true, 40: true} {
+		bz, err := database.Get(checkpointKey(height))
+		require.NoError(t, err)
+		require.Equal(t, found, bz != nil, "height %d", height)
+	}
+
+	require.Error(t, snapshotter.Snapshot(20, protoio.NewDelimitedWriter(new(bytes.Buffer))))
+
+	// Nothing is checkpointed without snapshots
+	snapshotter = NewSnapshotter(keeper, dbm.NewMemDB(), 0)
+	require.NoError(t, snapshotter.Checkpoint(50))
+	require.Error(t, snapshotter.Snapshot(50, protoio.NewDelimitedWriter(new(bytes.Buffer))))
+}
+
+func testLivenessWindow() types.LivenessWindow {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	return types.LivenessWindow{
+		BlockTimes: []time.Time{now, now.Add(time.Minute), now.Add(2 * time.Minute)},
+		MissedBlockTimes: []types.ValidatorMissedBlockTimes{
+			{
+				Address:          sdk.ConsAddress(pks[0].Address()).String(),
+				MissedBlockTimes: []time.Time{now.Add(time.Minute)},
+			},
+			{
+				Address:          sdk.ConsAddress(pks[1].Address()).String(),
+				MissedBlockTimes: []time.Time{now, now.Add(2 * time.Minute)},
+			},
+		},
+	}
+}
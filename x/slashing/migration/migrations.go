@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v043"
 	"github.com/e-money/em-ledger/x/slashing/keeper"
+	"github.com/e-money/em-ledger/x/slashing/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,9 @@ func NewMigrator(keeper keeper.Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.StoreKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3 by introducing the downtime penalty parameters.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetDowntimePenaltyParams(ctx, types.DefaultDowntimePenaltyParams())
+	return nil
+}
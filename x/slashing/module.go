@@ -8,6 +8,7 @@ import (
 	"github.com/e-money/em-ledger/x/slashing/migration"
 
 	sdkslashing "github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/e-money/em-ledger/x/slashing/client/cli"
 	"github.com/e-money/em-ledger/x/slashing/keeper"
 	"github.com/e-money/em-ledger/x/slashing/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -22,7 +23,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
+	sdkslashingcli "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	sdkslashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -59,22 +60,18 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 // DefaultGenesis returns default genesis state as raw bytes for the slashing
 // module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	genesis := &sdkslashingtypes.GenesisState{
-		Params:       types.DefaultParams(),
-		SigningInfos: []sdkslashingtypes.SigningInfo{},
-		MissedBlocks: []sdkslashingtypes.ValidatorMissedBlocks{},
-	}
-	return cdc.MustMarshalJSON(genesis)
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 // ValidateGenesis performs genesis state validation for the slashing module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	var data sdkslashingtypes.GenesisState
+	var data types.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", sdkslashingtypes.ModuleName, err)
 	}
 
-	return sdkslashingtypes.ValidateGenesis(data)
+	data.SetDefaultDowntimePenaltyParams()
+	return data.Validate()
 }
 
 // RegisterRESTRoutes registers the REST routes for the slashing module.
@@ -85,11 +82,12 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the slashig module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
 	sdkslashingtypes.RegisterQueryHandlerClient(context.Background(), mux, sdkslashingtypes.NewQueryClient(clientCtx))
+	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
 }
 
 // GetTxCmd returns the root tx command for the slashing module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return cli.NewTxCmd()
+	return sdkslashingcli.NewTxCmd()
 }
 
 // GetQueryCmd returns no root query command for the slashing module.
@@ -121,7 +119,7 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak sdkslashingtypes.Acc
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // Name returns the slashing module's name.
 func (AppModule) Name() string {
@@ -150,17 +148,22 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	sdkslashingtypes.RegisterMsgServer(cfg.MsgServer(), sdkslashingkeeper.NewMsgServerImpl(am.keeper.Keeper))
 	sdkslashingtypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := migration.NewMigrator(am.keeper)
 	cfg.RegisterMigration(ModuleName, 1, m.Migrate1to2)
+	cfg.RegisterMigration(ModuleName, 2, m.Migrate2to3)
 }
 
 // InitGenesis performs genesis initialization for the slashing module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState sdkslashingtypes.GenesisState
+	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
-	sdkslashing.InitGenesis(ctx, am.keeper.Keeper, am.stakingKeeper, &genesisState)
+	if err := InitGenesis(ctx, am.keeper, am.stakingKeeper, &genesisState); err != nil {
+		panic(err.Error())
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,13 @@
+package types
+
+import slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+const (
+	EventTypeDowntimeStatus = "downtime_status"
+
+	AttributeKeyAddress       = slashingtypes.AttributeKeyAddress
+	AttributeKeyStatus        = "status"
+	AttributeKeyMissedRatio   = "missed_ratio"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeKeyOffenceCount  = "offence_count"
+)
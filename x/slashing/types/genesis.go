@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func NewGenesisState(
+	params slashingtypes.Params, signingInfos []slashingtypes.SigningInfo,
+	missedBlockTimes []ValidatorMissedBlockTimes, blockTimes []time.Time,
+	downtimePenaltyParams DowntimePenaltyParams, downtimeInfos []ValidatorDowntimeInfo,
+) *GenesisState {
+	return &GenesisState{
+		Params:                params,
+		SigningInfos:          signingInfos,
+		MissedBlocks:          []slashingtypes.ValidatorMissedBlocks{},
+		MissedBlockTimes:      missedBlockTimes,
+		BlockTimes:            blockTimes,
+		DowntimePenaltyParams: downtimePenaltyParams,
+		DowntimeInfos:         downtimeInfos,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(
+		DefaultParams(), []slashingtypes.SigningInfo{}, []ValidatorMissedBlockTimes{}, []time.Time{},
+		DefaultDowntimePenaltyParams(), []ValidatorDowntimeInfo{},
+	)
+}
+
+// SDKGenesisState returns the part of the genesis state which is handled by the Cosmos SDK slashing module.
+func (gs GenesisState) SDKGenesisState() *slashingtypes.GenesisState {
+	return slashingtypes.NewGenesisState(gs.Params, gs.SigningInfos, gs.MissedBlocks)
+}
+
+// SetDefaultDowntimePenaltyParams sets the default downtime penalty parameters if the genesis state predates them and
+// has none of them set.
+func (gs *GenesisState) SetDefaultDowntimePenaltyParams() {
+	p := gs.DowntimePenaltyParams
+	if p.WarningRatio.IsNil() && p.ReducedRewardsRatio.IsNil() && p.RewardReduction.IsNil() &&
+		p.RepeatOffencePeriod == 0 && p.RepeatOffenceMultiplier.IsNil() && p.MaxSlashFractionDowntime.IsNil() {
+		gs.DowntimePenaltyParams = DefaultDowntimePenaltyParams()
+	}
+}
+
+func (gs GenesisState) Validate() error {
+	if err := slashingtypes.ValidateGenesis(*gs.SDKGenesisState()); err != nil {
+		return err
+	}
+
+	if !isSorted(gs.BlockTimes) {
+		return fmt.Errorf("block times must be sorted in ascending order")
+	}
+
+	seen := make(map[string]bool)
+	for _, missed := range gs.MissedBlockTimes {
+		if _, err := sdk.ConsAddressFromBech32(missed.Address); err != nil {
+			return fmt.Errorf("invalid validator address %q: %w", missed.Address, err)
+		}
+
+		if seen[missed.Address] {
+			return fmt.Errorf("duplicate missed block times for validator %v", missed.Address)
+		}
+		seen[missed.Address] = true
+
+		if !isSorted(missed.MissedBlockTimes) {
+			return fmt.Errorf("missed block times of validator %v must be sorted in ascending order", missed.Address)
+		}
+	}
+
+	if err := gs.DowntimePenaltyParams.Validate(); err != nil {
+		return err
+	}
+
+	seen = make(map[string]bool)
+	for _, info := range gs.DowntimeInfos {
+		if _, err := sdk.ConsAddressFromBech32(info.Address); err != nil {
+			return fmt.Errorf("invalid validator address %q: %w", info.Address, err)
+		}
+
+		if seen[info.Address] {
+			return fmt.Errorf("duplicate downtime info for validator %v", info.Address)
+		}
+		seen[info.Address] = true
+
+		if _, ok := DowntimeStatus_name[int32(info.Status)]; !ok {
+			return fmt.Errorf("invalid downtime status of validator %v: %v", info.Address, info.Status)
+		}
+	}
+
+	return nil
+}
+
+func isSorted(times []time.Time) bool {
+	return sort.SliceIsSorted(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+}
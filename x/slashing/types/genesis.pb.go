@@ -0,0 +1,1188 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/slashing/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState extends the Cosmos SDK slashing genesis with the time based
+// liveness window, which is kept outside of the IAVL store.
+type GenesisState struct {
+	Params       types.Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SigningInfos []types.SigningInfo `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos" yaml:"signing_infos"`
+	// Unused by em-ledger, which tracks missed blocks by time. Retained for
+	// compatibility with the Cosmos SDK genesis format.
+	MissedBlocks          []types.ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	MissedBlockTimes      []ValidatorMissedBlockTimes   `protobuf:"bytes,4,rep,name=missed_block_times,json=missedBlockTimes,proto3" json:"missed_block_times" yaml:"missed_block_times"`
+	BlockTimes            []time.Time                   `protobuf:"bytes,5,rep,name=block_times,json=blockTimes,proto3,stdtime" json:"block_times" yaml:"block_times"`
+	DowntimePenaltyParams DowntimePenaltyParams         `protobuf:"bytes,6,opt,name=downtime_penalty_params,json=downtimePenaltyParams,proto3" json:"downtime_penalty_params" yaml:"downtime_penalty_params"`
+	DowntimeInfos         []ValidatorDowntimeInfo       `protobuf:"bytes,7,rep,name=downtime_infos,json=downtimeInfos,proto3" json:"downtime_infos" yaml:"downtime_infos"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
+func (m *GenesisState) GetSigningInfos() []types.SigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []types.ValidatorMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlockTimes() []ValidatorMissedBlockTimes {
+	if m != nil {
+		return m.MissedBlockTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockTimes() []time.Time {
+	if m != nil {
+		return m.BlockTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDowntimePenaltyParams() DowntimePenaltyParams {
+	if m != nil {
+		return m.DowntimePenaltyParams
+	}
+	return DowntimePenaltyParams{}
+}
+
+func (m *GenesisState) GetDowntimeInfos() []ValidatorDowntimeInfo {
+	if m != nil {
+		return m.DowntimeInfos
+	}
+	return nil
+}
+
+// ValidatorMissedBlockTimes holds the times of the blocks a validator failed
+// to sign within the current signed blocks window.
+type ValidatorMissedBlockTimes struct {
+	Address          string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	MissedBlockTimes []time.Time `protobuf:"bytes,2,rep,name=missed_block_times,json=missedBlockTimes,proto3,stdtime" json:"missed_block_times" yaml:"missed_block_times"`
+}
+
+func (m *ValidatorMissedBlockTimes) Reset()         { *m = ValidatorMissedBlockTimes{} }
+func (m *ValidatorMissedBlockTimes) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlockTimes) ProtoMessage()    {}
+func (*ValidatorMissedBlockTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{1}
+}
+func (m *ValidatorMissedBlockTimes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlockTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlockTimes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlockTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlockTimes.Merge(m, src)
+}
+func (m *ValidatorMissedBlockTimes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlockTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlockTimes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlockTimes proto.InternalMessageInfo
+
+func (m *ValidatorMissedBlockTimes) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorMissedBlockTimes) GetMissedBlockTimes() []time.Time {
+	if m != nil {
+		return m.MissedBlockTimes
+	}
+	return nil
+}
+
+// LivenessWindow is the payload of the slashing state-sync snapshot extension.
+type LivenessWindow struct {
+	BlockTimes       []time.Time                 `protobuf:"bytes,1,rep,name=block_times,json=blockTimes,proto3,stdtime" json:"block_times"`
+	MissedBlockTimes []ValidatorMissedBlockTimes `protobuf:"bytes,2,rep,name=missed_block_times,json=missedBlockTimes,proto3" json:"missed_block_times"`
+}
+
+func (m *LivenessWindow) Reset()         { *m = LivenessWindow{} }
+func (m *LivenessWindow) String() string { return proto.CompactTextString(m) }
+func (*LivenessWindow) ProtoMessage()    {}
+func (*LivenessWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b433287170e3d8, []int{2}
+}
+func (m *LivenessWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessWindow.Merge(m, src)
+}
+func (m *LivenessWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessWindow proto.InternalMessageInfo
+
+func (m *LivenessWindow) GetBlockTimes() []time.Time {
+	if m != nil {
+		return m.BlockTimes
+	}
+	return nil
+}
+
+func (m *LivenessWindow) GetMissedBlockTimes() []ValidatorMissedBlockTimes {
+	if m != nil {
+		return m.MissedBlockTimes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "em.slashing.v1.GenesisState")
+	proto.RegisterType((*ValidatorMissedBlockTimes)(nil), "em.slashing.v1.ValidatorMissedBlockTimes")
+	proto.RegisterType((*LivenessWindow)(nil), "em.slashing.v1.LivenessWindow")
+}
+
+func init() { proto.RegisterFile("em/slashing/v1/genesis.proto", fileDescriptor_97b433287170e3d8) }
+
+var fileDescriptor_97b433287170e3d8 = []byte{
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0xe3, 0xfe, 0xc2, 0xe4, 0x47, 0x68, 0xd4, 0x8a, 0x34, 0x6a, 0xed, 0x60, 0x91, 0xaa,
+	0x48, 0xc4, 0x56, 0xcb, 0x0e, 0x89, 0x8d, 0x05, 0xaa, 0x90, 0x40, 0xaa, 0xdc, 0x0a, 0x24, 0x10,
+	0x8a, 0xec, 0x78, 0x32, 0x1d, 0xd5, 0xe3, 0x09, 0x19, 0x37, 0x25, 0xec, 0xd9, 0xf7, 0x69, 0x58,
+	0xf0, 0x04, 0x5d, 0x76, 0xc9, 0x2a, 0xa0, 0xe4, 0x0d, 0xba, 0x61, 0x8b, 0x3c, 0x33, 0x49, 0x1c,
+	0x27, 0x21, 0x62, 0x97, 0xe8, 0x9e, 0x7b, 0xbe, 0x7b, 0x7d, 0x8f, 0x0d, 0x76, 0x11, 0xb5, 0x79,
+	0xe8, 0xf1, 0x73, 0x12, 0x61, 0xbb, 0x7b, 0x68, 0x63, 0x14, 0x21, 0x4e, 0xb8, 0xd5, 0xee, 0xb0,
+	0x98, 0xc1, 0x12, 0xa2, 0xd6, 0xa8, 0x6a, 0x75, 0x0f, 0x2b, 0x5b, 0x98, 0x61, 0x26, 0x4a, 0x76,
+	0xf2, 0x4b, 0xaa, 0x2a, 0x06, 0x66, 0x0c, 0x87, 0xc8, 0x16, 0xff, 0xfc, 0xcb, 0x96, 0x1d, 0x13,
+	0x8a, 0x78, 0xec, 0xd1, 0xb6, 0x12, 0xd4, 0x9a, 0x8c, 0x53, 0xc6, 0xd3, 0x20, 0x1f, 0xc5, 0x5e,
+	0x86, 0x56, 0xd9, 0x5f, 0x24, 0x1b, 0x8f, 0x20, 0x75, 0x7b, 0x99, 0x99, 0xa7, 0xcb, 0xe6, 0x9f,
+	0x75, 0x50, 0x38, 0x96, 0xc6, 0xa7, 0xb1, 0x17, 0x23, 0xf8, 0x02, 0x6c, 0xb4, 0xbd, 0x8e, 0x47,
+	0x79, 0x59, 0xab, 0x6a, 0x07, 0xf9, 0x23, 0xc3, 0x92, 0xa0, 0xf4, 0x6a, 0x02, 0x64, 0x9d, 0x08,
+	0x99, 0xb3, 0x76, 0xd3, 0x37, 0x72, 0xae, 0x6a, 0x82, 0x18, 0x14, 0x39, 0xc1, 0x11, 0x89, 0x70,
+	0x83, 0x44, 0x2d, 0xc6, 0xcb, 0x2b, 0xd5, 0xd5, 0x83, 0xfc, 0xd1, 0xe3, 0x85, 0x2e, 0xa7, 0x52,
+	0xfd, 0x3a, 0x6a, 0x31, 0x67, 0x37, 0xb1, 0xba, 0xeb, 0x1b, 0x5b, 0x3d, 0x8f, 0x86, 0xcf, 0xcd,
+	0x29, 0x23, 0xd3, 0x2d, 0xf0, 0x89, 0x94, 0xc3, 0xcf, 0xa0, 0x48, 0x09, 0xe7, 0x28, 0x68, 0xf8,
+	0x21, 0x6b, 0x5e, 0xf0, 0xf2, 0xaa, 0x00, 0x59, 0x0b, 0x41, 0xef, 0xbc, 0x90, 0x04, 0x5e, 0xcc,
+	0x3a, 0x6f, 0x45, 0x9b, 0x23, 0xba, 0xb2, 0xc8, 0x29, 0x4b, 0xd3, 0x2d, 0xd0, 0x94, 0x16, 0x7e,
+	0x05, 0x30, 0x5d, 0x6f, 0x88, 0xcb, 0x95, 0xd7, 0x04, 0xf7, 0x89, 0x35, 0x7d, 0xfd, 0xb9, 0xb8,
+	0xb3, 0xa4, 0xc1, 0x79, 0xa4, 0x90, 0x3b, 0xb3, 0x48, 0x69, 0x69, 0xba, 0x0f, 0x68, 0xa6, 0x09,
+	0x7e, 0x04, 0xf9, 0x34, 0x74, 0x5d, 0x40, 0x2b, 0x96, 0x0c, 0x93, 0x35, 0x0a, 0x93, 0x75, 0x36,
+	0x0a, 0x93, 0xa3, 0x2b, 0x0a, 0x94, 0x94, 0xb4, 0xfd, 0xf5, 0x2f, 0x43, 0x73, 0x81, 0x3f, 0x31,
+	0xff, 0xa6, 0x81, 0x87, 0x01, 0xbb, 0x8a, 0x92, 0x72, 0xa3, 0x8d, 0x22, 0x2f, 0x8c, 0x7b, 0x0d,
+	0x95, 0x82, 0x0d, 0x91, 0x82, 0x5a, 0x76, 0xbd, 0x97, 0x4a, 0x7e, 0x22, 0xd5, 0x2a, 0x0b, 0xfb,
+	0x0a, 0xaa, 0x4b, 0xe8, 0x02, 0x4f, 0xd3, 0xdd, 0x0e, 0xe6, 0xb5, 0xc3, 0x0b, 0x50, 0x1a, 0xb7,
+	0xc8, 0xf4, 0x6c, 0x56, 0x57, 0xe7, 0xd1, 0xc7, 0x0f, 0x77, 0x34, 0x86, 0x88, 0xcf, 0x9e, 0xa2,
+	0x6f, 0x67, 0xe8, 0x2a, 0x3f, 0xc5, 0x20, 0x25, 0xe6, 0xe6, 0x0f, 0x0d, 0xec, 0x2c, 0x3c, 0x12,
+	0x7c, 0x0a, 0x36, 0xbd, 0x20, 0xe8, 0x20, 0x2e, 0xdf, 0x83, 0xfb, 0x0e, 0xbc, 0xeb, 0x1b, 0x25,
+	0x69, 0xac, 0x0a, 0xa6, 0x3b, 0x92, 0x40, 0x36, 0x37, 0x19, 0x2b, 0x4b, 0x8f, 0x54, 0x5b, 0x1a,
+	0x05, 0x71, 0xab, 0x99, 0x38, 0x98, 0xdf, 0x35, 0x50, 0x7a, 0x43, 0xba, 0xc9, 0x8b, 0xcb, 0xdf,
+	0x93, 0x28, 0x60, 0x57, 0xf0, 0xd5, 0x74, 0x42, 0xb4, 0xa5, 0xf0, 0x7b, 0x09, 0x7c, 0x26, 0x0b,
+	0x9f, 0xfe, 0xb1, 0xca, 0x7f, 0x84, 0x5c, 0x7e, 0x15, 0x66, 0x06, 0x77, 0x8e, 0x6f, 0x06, 0xba,
+	0x76, 0x3b, 0xd0, 0xb5, 0xdf, 0x03, 0x5d, 0xbb, 0x1e, 0xea, 0xb9, 0xdb, 0xa1, 0x9e, 0xfb, 0x39,
+	0xd4, 0x73, 0x1f, 0xea, 0x98, 0xc4, 0xe7, 0x97, 0xbe, 0xd5, 0x64, 0xd4, 0x46, 0x75, 0xca, 0x22,
+	0xd4, 0xb3, 0x11, 0xad, 0x87, 0x28, 0xc0, 0xa8, 0x63, 0x7f, 0x99, 0x7c, 0xc4, 0xe2, 0x5e, 0x1b,
+	0x71, 0x7f, 0x43, 0x6c, 0xf4, 0xec, 0xef, 0x00, 0x63, 0x2d, 0xee, 0x9e, 0x94, 0x05, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DowntimeInfos) > 0 {
+		for iNdEx := len(m.DowntimeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.DowntimePenaltyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockTimes) > 0 {
+		for iNdEx := len(m.BlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MissedBlockTimes) > 0 {
+		for iNdEx := len(m.MissedBlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlockTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedBlockTimes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlockTimes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlockTimes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlockTimes) > 0 {
+		for iNdEx := len(m.MissedBlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.MissedBlockTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.MissedBlockTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LivenessWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlockTimes) > 0 {
+		for iNdEx := len(m.MissedBlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlockTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlockTimes) > 0 {
+		for iNdEx := len(m.BlockTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlockTimes) > 0 {
+		for _, e := range m.MissedBlockTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockTimes) > 0 {
+		for _, e := range m.BlockTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DowntimePenaltyParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DowntimeInfos) > 0 {
+		for _, e := range m.DowntimeInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMissedBlockTimes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MissedBlockTimes) > 0 {
+		for _, e := range m.MissedBlockTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LivenessWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockTimes) > 0 {
+		for _, e := range m.BlockTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlockTimes) > 0 {
+		for _, e := range m.MissedBlockTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, types.SigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, types.ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlockTimes = append(m.MissedBlockTimes, ValidatorMissedBlockTimes{})
+			if err := m.MissedBlockTimes[len(m.MissedBlockTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockTimes = append(m.BlockTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.BlockTimes[len(m.BlockTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePenaltyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimePenaltyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeInfos = append(m.DowntimeInfos, ValidatorDowntimeInfo{})
+			if err := m.DowntimeInfos[len(m.DowntimeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedBlockTimes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedBlockTimes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedBlockTimes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlockTimes = append(m.MissedBlockTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.MissedBlockTimes[len(m.MissedBlockTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LivenessWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockTimes = append(m.BlockTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.BlockTimes[len(m.BlockTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlockTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlockTimes = append(m.MissedBlockTimes, ValidatorMissedBlockTimes{})
+			if err := m.MissedBlockTimes[len(m.MissedBlockTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
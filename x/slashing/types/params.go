@@ -1,9 +1,11 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -37,3 +39,110 @@ func DefaultParams() slashingtypes.Params {
 		SlashFractionDowntime:   DefaultSlashFractionDowntime,
 	}
 }
+
+var (
+	KeyDowntimeWarningRatio        = []byte("DowntimeWarningRatio")
+	KeyDowntimeReducedRewardsRatio = []byte("DowntimeReducedRewardsRatio")
+	KeyDowntimeRewardReduction     = []byte("DowntimeRewardReduction")
+	KeyRepeatOffencePeriod         = []byte("RepeatOffencePeriod")
+	KeyRepeatOffenceMultiplier     = []byte("RepeatOffenceMultiplier")
+	KeyMaxSlashFractionDowntime    = []byte("MaxSlashFractionDowntime")
+)
+
+const DefaultRepeatOffencePeriod = 30 * 24 * time.Hour
+
+var (
+	DefaultDowntimeWarningRatio        = sdk.NewDecWithPrec(5, 1)
+	DefaultDowntimeReducedRewardsRatio = sdk.NewDecWithPrec(75, 2)
+	DefaultDowntimeRewardReduction     = sdk.NewDecWithPrec(5, 1)
+	DefaultRepeatOffenceMultiplier     = sdk.NewDec(2)
+	DefaultMaxSlashFractionDowntime    = sdk.NewDecWithPrec(1, 2)
+)
+
+// ParamKeyTable extends the Cosmos SDK slashing parameters with the downtime penalty parameters.
+func ParamKeyTable() paramtypes.KeyTable {
+	return slashingtypes.ParamKeyTable().RegisterParamSet(&DowntimePenaltyParams{})
+}
+
+func DefaultDowntimePenaltyParams() DowntimePenaltyParams {
+	return DowntimePenaltyParams{
+		WarningRatio:             DefaultDowntimeWarningRatio,
+		ReducedRewardsRatio:      DefaultDowntimeReducedRewardsRatio,
+		RewardReduction:          DefaultDowntimeRewardReduction,
+		RepeatOffencePeriod:      DefaultRepeatOffencePeriod,
+		RepeatOffenceMultiplier:  DefaultRepeatOffenceMultiplier,
+		MaxSlashFractionDowntime: DefaultMaxSlashFractionDowntime,
+	}
+}
+
+func (p *DowntimePenaltyParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDowntimeWarningRatio, &p.WarningRatio, validateRatio),
+		paramtypes.NewParamSetPair(KeyDowntimeReducedRewardsRatio, &p.ReducedRewardsRatio, validateRatio),
+		paramtypes.NewParamSetPair(KeyDowntimeRewardReduction, &p.RewardReduction, validateRatio),
+		paramtypes.NewParamSetPair(KeyRepeatOffencePeriod, &p.RepeatOffencePeriod, validateRepeatOffencePeriod),
+		paramtypes.NewParamSetPair(KeyRepeatOffenceMultiplier, &p.RepeatOffenceMultiplier, validateRepeatOffenceMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxSlashFractionDowntime, &p.MaxSlashFractionDowntime, validateRatio),
+	}
+}
+
+func (p DowntimePenaltyParams) Validate() error {
+	for _, ratio := range []sdk.Dec{p.WarningRatio, p.ReducedRewardsRatio, p.RewardReduction, p.MaxSlashFractionDowntime} {
+		if err := validateRatio(ratio); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRepeatOffencePeriod(p.RepeatOffencePeriod); err != nil {
+		return err
+	}
+
+	if err := validateRepeatOffenceMultiplier(p.RepeatOffenceMultiplier); err != nil {
+		return err
+	}
+
+	if p.WarningRatio.IsPositive() && p.ReducedRewardsRatio.IsPositive() && p.ReducedRewardsRatio.LT(p.WarningRatio) {
+		return fmt.Errorf("reduced rewards ratio must not be below the warning ratio: %v", p.ReducedRewardsRatio)
+	}
+
+	return nil
+}
+
+func validateRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("value must be between 0 and 1: %v", v)
+	}
+
+	return nil
+}
+
+func validateRepeatOffencePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("repeat offence period must not be negative: %v", v)
+	}
+
+	return nil
+}
+
+func validateRepeatOffenceMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("repeat offence multiplier must be at least 1: %v", v)
+	}
+
+	return nil
+}
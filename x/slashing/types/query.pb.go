@@ -0,0 +1,922 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/slashing/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryDowntimeInfoRequest struct {
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty" yaml:"cons_address"`
+}
+
+func (m *QueryDowntimeInfoRequest) Reset()         { *m = QueryDowntimeInfoRequest{} }
+func (m *QueryDowntimeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeInfoRequest) ProtoMessage()    {}
+func (*QueryDowntimeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778476b8e01eb871, []int{0}
+}
+func (m *QueryDowntimeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeInfoRequest.Merge(m, src)
+}
+func (m *QueryDowntimeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeInfoRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeInfoRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+type QueryDowntimeInfoResponse struct {
+	Info ValidatorDowntimeInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info" yaml:"info"`
+}
+
+func (m *QueryDowntimeInfoResponse) Reset()         { *m = QueryDowntimeInfoResponse{} }
+func (m *QueryDowntimeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeInfoResponse) ProtoMessage()    {}
+func (*QueryDowntimeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778476b8e01eb871, []int{1}
+}
+func (m *QueryDowntimeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeInfoResponse.Merge(m, src)
+}
+func (m *QueryDowntimeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeInfoResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeInfoResponse) GetInfo() ValidatorDowntimeInfo {
+	if m != nil {
+		return m.Info
+	}
+	return ValidatorDowntimeInfo{}
+}
+
+type QueryDowntimePenaltyParamsRequest struct {
+}
+
+func (m *QueryDowntimePenaltyParamsRequest) Reset()         { *m = QueryDowntimePenaltyParamsRequest{} }
+func (m *QueryDowntimePenaltyParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimePenaltyParamsRequest) ProtoMessage()    {}
+func (*QueryDowntimePenaltyParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778476b8e01eb871, []int{2}
+}
+func (m *QueryDowntimePenaltyParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimePenaltyParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimePenaltyParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimePenaltyParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimePenaltyParamsRequest.Merge(m, src)
+}
+func (m *QueryDowntimePenaltyParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimePenaltyParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimePenaltyParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimePenaltyParamsRequest proto.InternalMessageInfo
+
+type QueryDowntimePenaltyParamsResponse struct {
+	Params DowntimePenaltyParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *QueryDowntimePenaltyParamsResponse) Reset()         { *m = QueryDowntimePenaltyParamsResponse{} }
+func (m *QueryDowntimePenaltyParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimePenaltyParamsResponse) ProtoMessage()    {}
+func (*QueryDowntimePenaltyParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778476b8e01eb871, []int{3}
+}
+func (m *QueryDowntimePenaltyParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimePenaltyParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimePenaltyParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimePenaltyParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimePenaltyParamsResponse.Merge(m, src)
+}
+func (m *QueryDowntimePenaltyParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimePenaltyParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimePenaltyParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimePenaltyParamsResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimePenaltyParamsResponse) GetParams() DowntimePenaltyParams {
+	if m != nil {
+		return m.Params
+	}
+	return DowntimePenaltyParams{}
+}
+
+func init() {
+	proto.RegisterType((*QueryDowntimeInfoRequest)(nil), "em.slashing.v1.QueryDowntimeInfoRequest")
+	proto.RegisterType((*QueryDowntimeInfoResponse)(nil), "em.slashing.v1.QueryDowntimeInfoResponse")
+	proto.RegisterType((*QueryDowntimePenaltyParamsRequest)(nil), "em.slashing.v1.QueryDowntimePenaltyParamsRequest")
+	proto.RegisterType((*QueryDowntimePenaltyParamsResponse)(nil), "em.slashing.v1.QueryDowntimePenaltyParamsResponse")
+}
+
+func init() { proto.RegisterFile("em/slashing/v1/query.proto", fileDescriptor_778476b8e01eb871) }
+
+var fileDescriptor_778476b8e01eb871 = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0x09, 0x26, 0xe1, 0x0e, 0x0e, 0x1e, 0x13, 0x23, 0x82, 0x14, 0xcc, 0x1f, 0x0d,
+	0x69, 0x8d, 0xd5, 0xc2, 0x69, 0x37, 0x2a, 0x24, 0xc4, 0x05, 0x8d, 0x0a, 0xed, 0xc0, 0x65, 0xf2,
+	0x96, 0x77, 0x59, 0x44, 0xec, 0x37, 0x8b, 0xdd, 0x41, 0x40, 0x5c, 0xf8, 0x04, 0x48, 0x9c, 0xf8,
+	0x0e, 0x7c, 0x90, 0x1d, 0x27, 0xb8, 0x70, 0xaa, 0x50, 0xcb, 0x27, 0xd8, 0x27, 0x40, 0x71, 0xcc,
+	0x48, 0xa7, 0xac, 0x82, 0x5b, 0x9c, 0xc7, 0xef, 0xef, 0x79, 0x1e, 0x3b, 0x21, 0x3e, 0x48, 0xae,
+	0x53, 0xa1, 0xf7, 0x13, 0x15, 0xf3, 0xc3, 0x1e, 0x3f, 0x18, 0x41, 0x5e, 0x84, 0x59, 0x8e, 0x06,
+	0xe9, 0x15, 0x90, 0xe1, 0x1f, 0x2d, 0x3c, 0xec, 0xf9, 0x57, 0x63, 0x8c, 0xd1, 0x4a, 0xbc, 0x7c,
+	0xaa, 0x76, 0xf9, 0x37, 0x62, 0xc4, 0x38, 0x05, 0x2e, 0xb2, 0x84, 0x0b, 0xa5, 0xd0, 0x08, 0x93,
+	0xa0, 0xd2, 0x4e, 0xbd, 0x79, 0x86, 0x7f, 0xca, 0xb3, 0x32, 0xdb, 0x22, 0xab, 0x2f, 0x4a, 0xc7,
+	0x27, 0xf8, 0x46, 0x99, 0x44, 0xc2, 0x33, 0xb5, 0x87, 0x43, 0x38, 0x18, 0x81, 0x36, 0x74, 0x83,
+	0x2c, 0xed, 0xa2, 0xd2, 0xdb, 0x22, 0x8a, 0x72, 0xd0, 0x7a, 0xd5, 0xbb, 0xe5, 0xad, 0x5d, 0x1a,
+	0x5c, 0x3b, 0x19, 0x77, 0x96, 0x0b, 0x21, 0xd3, 0x0d, 0x56, 0x57, 0xd9, 0xb0, 0x5d, 0x2e, 0x1f,
+	0xbb, 0xd5, 0x6b, 0x72, 0xbd, 0x81, 0xab, 0x33, 0x54, 0x1a, 0xe8, 0x73, 0x72, 0x21, 0x51, 0x7b,
+	0x68, 0x81, 0xed, 0xfe, 0xbd, 0x70, 0xb6, 0x66, 0xb8, 0x25, 0xd2, 0x24, 0x12, 0x06, 0xf3, 0xfa,
+	0xf0, 0x60, 0xf9, 0x68, 0xdc, 0x69, 0x9d, 0x8c, 0x3b, 0xed, 0xca, 0xbb, 0x04, 0xb0, 0xa1, 0xe5,
+	0xb0, 0x3b, 0xe4, 0xf6, 0x8c, 0xd9, 0x26, 0x28, 0x91, 0x9a, 0x62, 0x53, 0xe4, 0x42, 0x6a, 0xd7,
+	0x86, 0xbd, 0x23, 0x6c, 0xde, 0x26, 0x17, 0xed, 0x25, 0x59, 0xcc, 0xec, 0x9b, 0xf3, 0xc2, 0x35,
+	0x8e, 0x0f, 0x56, 0x5c, 0xb8, 0xcb, 0x55, 0xb8, 0x0a, 0xc1, 0x86, 0x8e, 0xd5, 0xff, 0xb6, 0x40,
+	0x2e, 0x5a, 0x73, 0xfa, 0xc5, 0x23, 0x4b, 0xf5, 0x5a, 0x74, 0xed, 0xac, 0xc1, 0x79, 0xd7, 0xe1,
+	0x3f, 0xf8, 0x87, 0x9d, 0x55, 0x0b, 0xf6, 0xe8, 0xe3, 0xf7, 0x5f, 0x9f, 0x17, 0x42, 0xba, 0xce,
+	0xa1, 0x2b, 0x51, 0x41, 0x31, 0xf3, 0x09, 0x44, 0x6e, 0x84, 0xbf, 0xaf, 0x5f, 0xe0, 0x07, 0xfa,
+	0xd5, 0x23, 0x2b, 0x8d, 0xf5, 0x68, 0x6f, 0xae, 0x75, 0xd3, 0x71, 0xfb, 0xfd, 0xff, 0x19, 0x71,
+	0xb1, 0xd7, 0x6d, 0xec, 0xfb, 0xf4, 0xee, 0xdc, 0xd8, 0xdb, 0xd5, 0xa1, 0x0e, 0x9e, 0x1e, 0x4d,
+	0x02, 0xef, 0x78, 0x12, 0x78, 0x3f, 0x27, 0x81, 0xf7, 0x69, 0x1a, 0xb4, 0x8e, 0xa7, 0x41, 0xeb,
+	0xc7, 0x34, 0x68, 0xbd, 0xea, 0xc6, 0x89, 0xd9, 0x1f, 0xed, 0x84, 0xbb, 0x28, 0x4f, 0x49, 0x20,
+	0xbb, 0x29, 0x44, 0x31, 0xe4, 0xfc, 0xed, 0x5f, 0xaa, 0x29, 0x32, 0xd0, 0x3b, 0x8b, 0xf6, 0x57,
+	0x78, 0xf8, 0x7b, 0x00, 0xbb, 0x2b, 0xe8, 0x03, 0x8b, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Query the downtime penalty tier and offences of a validator
+	DowntimeInfo(ctx context.Context, in *QueryDowntimeInfoRequest, opts ...grpc.CallOption) (*QueryDowntimeInfoResponse, error)
+	// Query the downtime penalty parameters
+	DowntimePenaltyParams(ctx context.Context, in *QueryDowntimePenaltyParamsRequest, opts ...grpc.CallOption) (*QueryDowntimePenaltyParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DowntimeInfo(ctx context.Context, in *QueryDowntimeInfoRequest, opts ...grpc.CallOption) (*QueryDowntimeInfoResponse, error) {
+	out := new(QueryDowntimeInfoResponse)
+	err := c.cc.Invoke(ctx, "/em.slashing.v1.Query/DowntimeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DowntimePenaltyParams(ctx context.Context, in *QueryDowntimePenaltyParamsRequest, opts ...grpc.CallOption) (*QueryDowntimePenaltyParamsResponse, error) {
+	out := new(QueryDowntimePenaltyParamsResponse)
+	err := c.cc.Invoke(ctx, "/em.slashing.v1.Query/DowntimePenaltyParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Query the downtime penalty tier and offences of a validator
+	DowntimeInfo(context.Context, *QueryDowntimeInfoRequest) (*QueryDowntimeInfoResponse, error)
+	// Query the downtime penalty parameters
+	DowntimePenaltyParams(context.Context, *QueryDowntimePenaltyParamsRequest) (*QueryDowntimePenaltyParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DowntimeInfo(ctx context.Context, req *QueryDowntimeInfoRequest) (*QueryDowntimeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeInfo not implemented")
+}
+func (*UnimplementedQueryServer) DowntimePenaltyParams(ctx context.Context, req *QueryDowntimePenaltyParamsRequest) (*QueryDowntimePenaltyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimePenaltyParams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DowntimeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.slashing.v1.Query/DowntimeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeInfo(ctx, req.(*QueryDowntimeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DowntimePenaltyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimePenaltyParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimePenaltyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.slashing.v1.Query/DowntimePenaltyParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimePenaltyParams(ctx, req.(*QueryDowntimePenaltyParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "em.slashing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DowntimeInfo",
+			Handler:    _Query_DowntimeInfo_Handler,
+		},
+		{
+			MethodName: "DowntimePenaltyParams",
+			Handler:    _Query_DowntimePenaltyParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "em/slashing/v1/query.proto",
+}
+
+func (m *QueryDowntimeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimePenaltyParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimePenaltyParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimePenaltyParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimePenaltyParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimePenaltyParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimePenaltyParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDowntimeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDowntimePenaltyParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDowntimePenaltyParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDowntimeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimePenaltyParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimePenaltyParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
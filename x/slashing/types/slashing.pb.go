@@ -0,0 +1,932 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/slashing/v1/slashing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DowntimeStatus is the penalty tier a validator has reached within the
+// current signed blocks window.
+type DowntimeStatus int32
+
+const (
+	DowntimeStatus_OK             DowntimeStatus = 0
+	DowntimeStatus_Warning        DowntimeStatus = 1
+	DowntimeStatus_ReducedRewards DowntimeStatus = 2
+)
+
+var DowntimeStatus_name = map[int32]string{
+	0: "DOWNTIME_STATUS_OK",
+	1: "DOWNTIME_STATUS_WARNING",
+	2: "DOWNTIME_STATUS_REDUCED_REWARDS",
+}
+
+var DowntimeStatus_value = map[string]int32{
+	"DOWNTIME_STATUS_OK":              0,
+	"DOWNTIME_STATUS_WARNING":         1,
+	"DOWNTIME_STATUS_REDUCED_REWARDS": 2,
+}
+
+func (x DowntimeStatus) String() string {
+	return proto.EnumName(DowntimeStatus_name, int32(x))
+}
+
+func (DowntimeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7e6e1177b659eb4c, []int{0}
+}
+
+// DowntimePenaltyParams configures the penalty tiers applied before and when a
+// validator is jailed for downtime. Ratios are relative to the jail threshold,
+// i.e. the share of missed blocks above which a validator is jailed.
+type DowntimePenaltyParams struct {
+	// warning_ratio is the share of the jail threshold at which a warning is
+	// emitted. Zero disables the tier.
+	WarningRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=warning_ratio,json=warningRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"warning_ratio" yaml:"warning_ratio"`
+	// reduced_rewards_ratio is the share of the jail threshold at which the
+	// rewards of the validator are reduced. Zero disables the tier.
+	ReducedRewardsRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reduced_rewards_ratio,json=reducedRewardsRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduced_rewards_ratio" yaml:"reduced_rewards_ratio"`
+	// reward_reduction is the share of rewards withheld from a validator in the
+	// reduced rewards tier. Withheld rewards go to the community pool.
+	RewardReduction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_reduction,json=rewardReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_reduction" yaml:"reward_reduction"`
+	// repeat_offence_period is the lookback period within which downtime
+	// offences are considered repeated.
+	RepeatOffencePeriod time.Duration `protobuf:"bytes,4,opt,name=repeat_offence_period,json=repeatOffencePeriod,proto3,stdduration" json:"repeat_offence_period" yaml:"repeat_offence_period"`
+	// repeat_offence_multiplier is applied to the downtime slash fraction once
+	// for every prior offence within the lookback period.
+	RepeatOffenceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=repeat_offence_multiplier,json=repeatOffenceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"repeat_offence_multiplier" yaml:"repeat_offence_multiplier"`
+	// max_slash_fraction_downtime caps the escalated downtime slash fraction.
+	MaxSlashFractionDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_slash_fraction_downtime,json=maxSlashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction_downtime" yaml:"max_slash_fraction_downtime"`
+}
+
+func (m *DowntimePenaltyParams) Reset()         { *m = DowntimePenaltyParams{} }
+func (m *DowntimePenaltyParams) String() string { return proto.CompactTextString(m) }
+func (*DowntimePenaltyParams) ProtoMessage()    {}
+func (*DowntimePenaltyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6e1177b659eb4c, []int{0}
+}
+func (m *DowntimePenaltyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePenaltyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePenaltyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimePenaltyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePenaltyParams.Merge(m, src)
+}
+func (m *DowntimePenaltyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePenaltyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePenaltyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePenaltyParams proto.InternalMessageInfo
+
+func (m *DowntimePenaltyParams) GetRepeatOffencePeriod() time.Duration {
+	if m != nil {
+		return m.RepeatOffencePeriod
+	}
+	return 0
+}
+
+// ValidatorDowntimeInfo tracks the downtime penalty tier and offences of a
+// validator.
+type ValidatorDowntimeInfo struct {
+	Address string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Status  DowntimeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=em.slashing.v1.DowntimeStatus" json:"status,omitempty" yaml:"status"`
+	// offence_count is the number of downtime offences within the repeat
+	// offence period ending at the last offence.
+	OffenceCount    uint32    `protobuf:"varint,3,opt,name=offence_count,json=offenceCount,proto3" json:"offence_count,omitempty" yaml:"offence_count"`
+	LastOffenceTime time.Time `protobuf:"bytes,4,opt,name=last_offence_time,json=lastOffenceTime,proto3,stdtime" json:"last_offence_time" yaml:"last_offence_time"`
+}
+
+func (m *ValidatorDowntimeInfo) Reset()         { *m = ValidatorDowntimeInfo{} }
+func (m *ValidatorDowntimeInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDowntimeInfo) ProtoMessage()    {}
+func (*ValidatorDowntimeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6e1177b659eb4c, []int{1}
+}
+func (m *ValidatorDowntimeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDowntimeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDowntimeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDowntimeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDowntimeInfo.Merge(m, src)
+}
+func (m *ValidatorDowntimeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDowntimeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDowntimeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDowntimeInfo proto.InternalMessageInfo
+
+func (m *ValidatorDowntimeInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorDowntimeInfo) GetStatus() DowntimeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DowntimeStatus_OK
+}
+
+func (m *ValidatorDowntimeInfo) GetOffenceCount() uint32 {
+	if m != nil {
+		return m.OffenceCount
+	}
+	return 0
+}
+
+func (m *ValidatorDowntimeInfo) GetLastOffenceTime() time.Time {
+	if m != nil {
+		return m.LastOffenceTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("em.slashing.v1.DowntimeStatus", DowntimeStatus_name, DowntimeStatus_value)
+	proto.RegisterType((*DowntimePenaltyParams)(nil), "em.slashing.v1.DowntimePenaltyParams")
+	proto.RegisterType((*ValidatorDowntimeInfo)(nil), "em.slashing.v1.ValidatorDowntimeInfo")
+}
+
+func init() { proto.RegisterFile("em/slashing/v1/slashing.proto", fileDescriptor_7e6e1177b659eb4c) }
+
+var fileDescriptor_7e6e1177b659eb4c = []byte{
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x73, 0xb9, 0x41, 0x77, 0x20, 0x21, 0x0c, 0x20, 0x4c, 0xee, 0xbd, 0x76, 0x64, 0x55,
+	0x55, 0x54, 0x35, 0xb6, 0xa0, 0x8b, 0x4a, 0x95, 0xba, 0x20, 0x24, 0xa0, 0x08, 0x91, 0xa0, 0x49,
+	0x68, 0xa4, 0x6e, 0xac, 0x21, 0x9e, 0x18, 0x0b, 0xdb, 0x13, 0xd9, 0x0e, 0x21, 0xdb, 0x2e, 0x59,
+	0x54, 0x48, 0xdd, 0xb4, 0x0b, 0xd4, 0x4d, 0x17, 0x7d, 0x89, 0xee, 0x59, 0xb2, 0xac, 0xba, 0x48,
+	0x2b, 0x78, 0x03, 0x9e, 0xa0, 0xf2, 0x8c, 0x4d, 0x48, 0x40, 0x95, 0xb2, 0xb2, 0xe7, 0x7c, 0x67,
+	0xbe, 0xf3, 0xf7, 0x9d, 0x01, 0xff, 0x13, 0x47, 0xf3, 0x6d, 0xec, 0x1f, 0x59, 0xae, 0xa9, 0x9d,
+	0xac, 0xdf, 0xfd, 0xab, 0x5d, 0x8f, 0x06, 0x14, 0x66, 0x88, 0xa3, 0xde, 0x99, 0x4e, 0xd6, 0x73,
+	0xcb, 0x26, 0x35, 0x29, 0x83, 0xb4, 0xf0, 0x8f, 0x7b, 0xe5, 0x24, 0x93, 0x52, 0xd3, 0x26, 0x1a,
+	0x3b, 0x1d, 0xf6, 0x3a, 0x9a, 0xd1, 0xf3, 0x70, 0x60, 0x51, 0x37, 0xc2, 0xe5, 0x49, 0x3c, 0xb0,
+	0x1c, 0xe2, 0x07, 0xd8, 0xe9, 0x72, 0x07, 0xe5, 0x73, 0x0a, 0xac, 0x94, 0x69, 0xdf, 0x0d, 0xed,
+	0xfb, 0xc4, 0xc5, 0x76, 0x30, 0xd8, 0xc7, 0x1e, 0x76, 0x7c, 0x78, 0x0c, 0xd2, 0x7d, 0xec, 0xb9,
+	0x96, 0x6b, 0xea, 0x8c, 0x52, 0x14, 0xf2, 0x42, 0xe1, 0x9f, 0xd2, 0xf6, 0xe5, 0x50, 0x4e, 0xfc,
+	0x18, 0xca, 0x4f, 0x4d, 0x2b, 0x38, 0xea, 0x1d, 0xaa, 0x6d, 0xea, 0x68, 0x6d, 0xea, 0x3b, 0xd4,
+	0x8f, 0x3e, 0x45, 0xdf, 0x38, 0xd6, 0x82, 0x41, 0x97, 0xf8, 0x6a, 0x99, 0xb4, 0x6f, 0x87, 0xf2,
+	0xf2, 0x00, 0x3b, 0xf6, 0x2b, 0x65, 0x8c, 0x4c, 0x41, 0xf3, 0xd1, 0x19, 0x85, 0x47, 0xf8, 0x4e,
+	0x00, 0x2b, 0x1e, 0x31, 0x7a, 0x6d, 0x62, 0xe8, 0x1e, 0xe9, 0x63, 0xcf, 0xf0, 0xa3, 0xa8, 0x49,
+	0x16, 0xb5, 0x36, 0x75, 0xd4, 0xff, 0x78, 0xd4, 0x47, 0x49, 0x15, 0xb4, 0x14, 0xd9, 0x11, 0x37,
+	0xf3, 0x24, 0x02, 0x90, 0xe5, 0x6e, 0x3a, 0x43, 0xc3, 0x36, 0x8a, 0x7f, 0xb1, 0xf0, 0xd5, 0xa9,
+	0xc3, 0xaf, 0xc6, 0xe1, 0xc7, 0xf9, 0x14, 0xb4, 0xc0, 0x4d, 0x28, 0xb6, 0xc0, 0x7e, 0x58, 0x79,
+	0x97, 0xe0, 0x40, 0xa7, 0x9d, 0x0e, 0x71, 0xdb, 0x44, 0xef, 0x12, 0xcf, 0xa2, 0x86, 0x38, 0x93,
+	0x17, 0x0a, 0x73, 0x1b, 0x6b, 0x2a, 0x1f, 0xa1, 0x1a, 0x8f, 0x50, 0x2d, 0x47, 0x23, 0x2e, 0x15,
+	0xc2, 0xac, 0xee, 0x97, 0xfa, 0x08, 0x8b, 0xf2, 0xf1, 0xa7, 0x2c, 0xa0, 0x25, 0x8e, 0xd5, 0x39,
+	0xb4, 0xcf, 0x10, 0xf8, 0x5e, 0x00, 0x6b, 0x13, 0x77, 0x9c, 0x9e, 0x1d, 0x58, 0x5d, 0xdb, 0x22,
+	0x9e, 0xf8, 0x37, 0x2b, 0x1c, 0x4d, 0x5d, 0x78, 0xfe, 0xd1, 0x64, 0x46, 0xc4, 0x0a, 0x5a, 0x1d,
+	0x4b, 0x66, 0xef, 0x0e, 0x81, 0x1f, 0x04, 0xf0, 0xaf, 0x83, 0x4f, 0x75, 0x26, 0x7b, 0xbd, 0xe3,
+	0x61, 0xd6, 0x20, 0xdd, 0x88, 0xe4, 0x29, 0xa6, 0x58, 0x4a, 0xcd, 0xa9, 0x53, 0x52, 0x78, 0x4a,
+	0x7f, 0xa0, 0x56, 0x90, 0xe8, 0xe0, 0xd3, 0x46, 0x08, 0x6e, 0x47, 0x58, 0xbc, 0x14, 0xca, 0xb7,
+	0x24, 0x58, 0x79, 0x83, 0x6d, 0xcb, 0xc0, 0x01, 0xf5, 0x62, 0x6b, 0xd5, 0xed, 0x50, 0xf8, 0x1c,
+	0xcc, 0x62, 0xc3, 0xf0, 0x88, 0xef, 0x47, 0xbb, 0x01, 0x6f, 0x87, 0x72, 0x86, 0x07, 0x8b, 0x00,
+	0x05, 0xc5, 0x2e, 0xb0, 0x0a, 0x52, 0x7e, 0x80, 0x83, 0x9e, 0xcf, 0x24, 0x9d, 0xd9, 0x90, 0xd4,
+	0xf1, 0x0d, 0x57, 0x63, 0xee, 0x06, 0xf3, 0x2a, 0x2d, 0xde, 0x0e, 0xe5, 0x34, 0x27, 0xe3, 0xf7,
+	0x14, 0x14, 0x11, 0xc0, 0xd7, 0x20, 0x1d, 0x37, 0xb6, 0x4d, 0x7b, 0x6e, 0xc0, 0x54, 0x9a, 0x2e,
+	0x89, 0xa3, 0x65, 0x1b, 0x83, 0x15, 0x34, 0x1f, 0x9d, 0xb7, 0xc2, 0x23, 0xb4, 0xc1, 0xa2, 0x8d,
+	0xfd, 0xd1, 0x70, 0x58, 0x73, 0xb9, 0xda, 0x72, 0x0f, 0xd4, 0xd6, 0x8c, 0x1f, 0x8c, 0xd2, 0x93,
+	0x48, 0x6e, 0x22, 0x0f, 0xf1, 0x80, 0x42, 0x39, 0x0f, 0xa5, 0xb6, 0x10, 0xda, 0xa3, 0xd9, 0x86,
+	0x77, 0x9f, 0x7d, 0x12, 0x40, 0x66, 0xbc, 0x34, 0x28, 0x01, 0x58, 0xae, 0xb7, 0x6a, 0xcd, 0xea,
+	0x5e, 0x45, 0x6f, 0x34, 0x37, 0x9b, 0x07, 0x0d, 0xbd, 0xbe, 0x9b, 0x4d, 0xe4, 0x52, 0x67, 0x17,
+	0xf9, 0x64, 0x7d, 0x17, 0x16, 0xc0, 0xea, 0x24, 0xde, 0xda, 0x44, 0xb5, 0x6a, 0x6d, 0x27, 0x2b,
+	0xe4, 0xe6, 0xce, 0x2e, 0xf2, 0xb3, 0x2d, 0xfe, 0x78, 0xc0, 0x97, 0x40, 0x9e, 0xf4, 0x44, 0x95,
+	0xf2, 0xc1, 0x56, 0xa5, 0xac, 0xa3, 0x4a, 0x6b, 0x13, 0x95, 0x1b, 0xd9, 0x64, 0x0e, 0x9e, 0x5d,
+	0xe4, 0x33, 0x68, 0x6c, 0xe1, 0x73, 0x33, 0x5f, 0xbf, 0x48, 0x42, 0x69, 0xe7, 0xf2, 0x5a, 0x12,
+	0xae, 0xae, 0x25, 0xe1, 0xd7, 0xb5, 0x24, 0x9c, 0xdf, 0x48, 0x89, 0xab, 0x1b, 0x29, 0xf1, 0xfd,
+	0x46, 0x4a, 0xbc, 0x2d, 0xde, 0x53, 0x17, 0x29, 0x3a, 0xd4, 0x25, 0x03, 0x8d, 0x38, 0x45, 0x9b,
+	0x18, 0x26, 0xf1, 0xb4, 0xd3, 0xd1, 0xcb, 0xcd, 0x84, 0x76, 0x98, 0x62, 0xfd, 0x7a, 0xf1, 0x7b,
+	0x00, 0xc4, 0xa3, 0x36, 0xde, 0xd5, 0x05, 0x00, 0x00,
+}
+
+func (m *DowntimePenaltyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePenaltyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePenaltyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.MaxSlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RepeatOffenceMultiplier.Size()
+		i -= size
+		if _, err := m.RepeatOffenceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RepeatOffencePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RepeatOffencePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RewardReduction.Size()
+		i -= size
+		if _, err := m.RewardReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReducedRewardsRatio.Size()
+		i -= size
+		if _, err := m.ReducedRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.WarningRatio.Size()
+		i -= size
+		if _, err := m.WarningRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDowntimeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDowntimeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDowntimeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastOffenceTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastOffenceTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.OffenceCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.OffenceCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DowntimePenaltyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WarningRatio.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.ReducedRewardsRatio.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.RewardReduction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RepeatOffencePeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.RepeatOffenceMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.MaxSlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *ValidatorDowntimeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	if m.OffenceCount != 0 {
+		n += 1 + sovSlashing(uint64(m.OffenceCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastOffenceTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DowntimePenaltyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePenaltyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePenaltyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarningRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReducedRewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReducedRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatOffencePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RepeatOffencePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatOffenceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepeatOffenceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDowntimeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDowntimeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDowntimeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DowntimeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceCount", wireType)
+			}
+			m.OffenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffenceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastOffenceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
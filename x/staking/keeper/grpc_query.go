@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier serves the Cosmos SDK staking queries, but answers historical info queries from the application database as
+// the HistoryKeeper keeps them out of the staking store.
+type Querier struct {
+	stakingkeeper.Querier
+	history HistoryKeeper
+}
+
+func NewQuerier(keeper stakingkeeper.Keeper, history HistoryKeeper) Querier {
+	return Querier{
+		Querier: stakingkeeper.Querier{Keeper: keeper},
+		history: history,
+	}
+}
+
+// HistoricalInfo returns the historical info of any height still retained by the node.
+func (q Querier) HistoricalInfo(_ context.Context, req *types.QueryHistoricalInfoRequest) (*types.QueryHistoricalInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	hi, found := q.history.GetRetainedHistoricalInfo(req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "historical info for height %d not found or pruned", req.Height)
+	}
+
+	return &types.QueryHistoricalInfoResponse{Hist: &hi}, nil
+}
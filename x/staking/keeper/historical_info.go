@@ -15,7 +15,13 @@ import (
 // Forked from Cosmos SDK x/staking/keeper/historical_info.go v0.42.9
 // Forking was required to prevent modifications to the App_State for every call to BeginBlock.
 
-const StoreKey = "history"
+const (
+	StoreKey = "history"
+
+	// FlagRetainHeights is the app.toml setting for the number of historical entries kept by this node beyond the
+	// HistoricalEntries staking parameter.
+	FlagRetainHeights = "history.retain-heights"
+)
 
 var historyKeyprefix = []byte("emstaking/hist")
 
@@ -24,6 +30,7 @@ type HistoryKeeper struct {
 	cdc           codec.BinaryCodec
 	stakingKeeper staking.StakingKeeper
 	database      dbm.DB
+	retainHeights uint64
 }
 
 func getHistoricalInfoKey(height int64) []byte {
@@ -33,13 +40,15 @@ func getHistoricalInfoKey(height int64) []byte {
 	return key
 }
 
-// NewKeeper creates a new staking Keeper instance
-func NewHistoryKeeper(cdc codec.Codec, key sdk.StoreKey, stakingkeeper staking.StakingKeeper, db dbm.DB) HistoryKeeper {
+// NewKeeper creates a new staking Keeper instance. At least the number of entries given by the HistoricalEntries staking
+// parameter are retained, as IBC relies on them. A larger retainHeights keeps more entries for queries.
+func NewHistoryKeeper(cdc codec.Codec, key sdk.StoreKey, stakingkeeper staking.StakingKeeper, db dbm.DB, retainHeights uint64) HistoryKeeper {
 	return HistoryKeeper{
 		StoreKey:      key,
 		cdc:           cdc,
 		stakingKeeper: stakingkeeper,
 		database:      db,
+		retainHeights: retainHeights,
 	}
 }
 
@@ -48,8 +57,18 @@ func (k HistoryKeeper) UnbondingTime(ctx sdk.Context) time.Duration {
 	return k.stakingKeeper.UnbondingTime(ctx)
 }
 
-// GetHistoricalInfo gets the historical info at a given height
+// GetHistoricalInfo gets the historical info at a given height within the HistoricalEntries staking parameter. Entries
+// retained beyond it are node-local and must not affect consensus.
 func (k HistoryKeeper) GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool) {
+	if height <= ctx.BlockHeight()-int64(k.stakingKeeper.HistoricalEntries(ctx)) {
+		return types.HistoricalInfo{}, false
+	}
+
+	return k.GetRetainedHistoricalInfo(height)
+}
+
+// GetRetainedHistoricalInfo gets the historical info at a given height if it is retained by the node.
+func (k HistoryKeeper) GetRetainedHistoricalInfo(height int64) (types.HistoricalInfo, bool) {
 	key := getHistoricalInfoKey(height)
 
 	value, _ := k.database.Get(key)
@@ -118,7 +137,11 @@ func (k HistoryKeeper) GetAllHistoricalInfo(ctx sdk.Context) []types.HistoricalI
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
 // heights that are below pruning height
 func (k HistoryKeeper) TrackHistoricalInfo(ctx sdk.Context) {
-	entryNum := k.stakingKeeper.HistoricalEntries(ctx)
+	entryNum := uint64(k.stakingKeeper.HistoricalEntries(ctx))
+	if k.retainHeights > entryNum {
+		// Node-local retention configured in app.toml
+		entryNum = k.retainHeights
+	}
 
 	// Prune store to ensure we only have parameter-defined historical entries.
 	// In most cases, this will involve removing a single historical entry.
@@ -128,7 +151,7 @@ func (k HistoryKeeper) TrackHistoricalInfo(ctx sdk.Context) {
 	// over the historical entries starting from the most recent version to be pruned
 	// and then return at the first empty entry.
 	for i := ctx.BlockHeight() - int64(entryNum); i >= 0; i-- {
-		_, found := k.GetHistoricalInfo(ctx, i)
+		_, found := k.GetRetainedHistoricalInfo(i)
 		if found {
 			k.DeleteHistoricalInfo(ctx, i)
 		} else {
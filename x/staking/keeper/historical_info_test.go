@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -20,6 +22,8 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -92,6 +96,116 @@ func TestGetAllHistoricalInfo(t *testing.T) {
 	require.Equal(t, expHistInfos, infos)
 }
 
+func TestTrackHistoricalInfoRetainHeights(t *testing.T) {
+	specs := map[string]struct {
+		historicalEntries uint32
+		retainHeights     uint64
+		expRetained       int64
+	}{
+		"retain more than historical entries":  {historicalEntries: 5, retainHeights: 10, expRetained: 10},
+		"retain fewer than historical entries": {historicalEntries: 5, retainHeights: 2, expRetained: 5},
+		"default":                              {historicalEntries: 5, retainHeights: 0, expRetained: 5},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, batch, database, _ := setup(t)
+
+			historyKeeper := newHistoryKeeper(database, spec.historicalEntries, spec.retainHeights)
+			for height := int64(1); height <= 20; height++ {
+				ctx = ctx.WithBlockHeight(height)
+				historyKeeper.TrackHistoricalInfo(ctx)
+
+				require.NoError(t, batch.WriteSync())
+				batch = database.NewBatch()
+				ctx = apptypes.WithCurrentBatch(ctx, batch)
+			}
+
+			for height := int64(1); height <= 20; height++ {
+				_, found := historyKeeper.GetRetainedHistoricalInfo(height)
+				require.Equal(t, height > 20-spec.expRetained, found, "height %v", height)
+
+				// Entries retained beyond the historical entries are not visible to consensus
+				_, found = historyKeeper.GetHistoricalInfo(ctx, height)
+				require.Equal(t, height > 20-int64(spec.historicalEntries), found, "height %v", height)
+			}
+		})
+	}
+}
+
+func TestPruneHistoricalInfo(t *testing.T) {
+	ctx, batch, database, historyKeeper := setup(t)
+
+	for height := int64(1); height <= 120; height++ {
+		hi := types.HistoricalInfo{Header: tmproto.Header{ChainID: "HelloChain", Height: height}}
+		historyKeeper.SetHistoricalInfo(ctx, height, &hi)
+	}
+	require.NoError(t, batch.WriteSync())
+
+	_, err := keeper.PruneHistoricalInfo(database, 0, 0)
+	require.Error(t, err)
+
+	// IBC relies on the heights of the historical entries staking parameter
+	_, err = keeper.PruneHistoricalInfo(database, 15, 20)
+	require.Error(t, err)
+	_, found := historyKeeper.GetRetainedHistoricalInfo(1)
+	require.True(t, found)
+
+	pruned, err := keeper.PruneHistoricalInfo(database, 15, 15)
+	require.NoError(t, err)
+	require.Equal(t, 105, pruned)
+
+	for height := int64(1); height <= 120; height++ {
+		_, found := historyKeeper.GetHistoricalInfo(ctx, height)
+		require.Equal(t, height > 105, found, "height %v", height)
+	}
+}
+
+func TestHistoricalEntries(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+
+	_, err := keeper.HistoricalEntries(stateDB)
+	require.Error(t, err)
+
+	key := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ms := store.NewCommitMultiStore(stateDB)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	subspace := paramstypes.NewSubspace(nil, codec.NewLegacyAmino(), key, tkey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	subspace.Set(ctx, types.KeyHistoricalEntries, uint32(42))
+	ms.Commit()
+
+	historicalEntries, err := keeper.HistoricalEntries(stateDB)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), historicalEntries)
+}
+
+func TestQueryHistoricalInfo(t *testing.T) {
+	ctx, batch, _, historyKeeper := setup(t)
+
+	hi := types.HistoricalInfo{Header: tmproto.Header{ChainID: "HelloChain", Height: 7}}
+	historyKeeper.SetHistoricalInfo(ctx, 7, &hi)
+	require.NoError(t, batch.WriteSync())
+
+	querier := keeper.NewQuerier(stakingkeeper.Keeper{}, historyKeeper)
+
+	res, err := querier.HistoricalInfo(sdk.WrapSDKContext(ctx), &types.QueryHistoricalInfoRequest{Height: 7})
+	require.NoError(t, err)
+	require.Equal(t, hi, *res.Hist)
+
+	_, err = querier.HistoricalInfo(sdk.WrapSDKContext(ctx), &types.QueryHistoricalInfoRequest{Height: 8})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = querier.HistoricalInfo(sdk.WrapSDKContext(ctx), &types.QueryHistoricalInfoRequest{Height: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func setup(t *testing.T) (sdk.Context, db.Batch, db.DB, keeper.HistoryKeeper) {
 	sdk.DefaultPowerReduction = sdk.OneInt()
 	ms := store.NewCommitMultiStore(dbm.NewMemDB())
@@ -104,26 +218,31 @@ func setup(t *testing.T) (sdk.Context, db.Batch, db.DB, keeper.HistoryKeeper) {
 	batch := database.NewBatch()
 	ctx = apptypes.WithCurrentBatch(ctx, batch)
 
+	return ctx, batch, database, newHistoryKeeper(database, 1000, 0)
+}
+
+func newHistoryKeeper(database db.DB, historicalEntries uint32, retainHeights uint64) keeper.HistoryKeeper {
 	key := sdk.NewKVStoreKey(keeper.StoreKey)
 
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
 	marshaler := codec.NewProtoCodec(interfaceRegistry)
-	historyKeeper := keeper.NewHistoryKeeper(marshaler, key, mockStakingKeeper{}, database)
 
-	return ctx, batch, database, historyKeeper
+	return keeper.NewHistoryKeeper(marshaler, key, mockStakingKeeper{historicalEntries: historicalEntries}, database, retainHeights)
 }
 
 var _ emtypes.StakingKeeper = mockStakingKeeper{}
 
-type mockStakingKeeper struct{}
+type mockStakingKeeper struct {
+	historicalEntries uint32
+}
 
 func (m mockStakingKeeper) GetLastValidators(ctx sdk.Context) (_ []types.Validator) {
 	return
 }
 
 func (m mockStakingKeeper) HistoricalEntries(ctx sdk.Context) uint32 {
-	return 1000
+	return m.historicalEntries
 }
 
 func (m mockStakingKeeper) UnbondingTime(ctx sdk.Context) time.Duration {
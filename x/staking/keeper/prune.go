@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// PruneHistoricalInfo deletes all historical info from the database except for the keepRecent most recent heights.
+// IBC relies on the heights given by the historicalEntries staking parameter, so keepRecent must not be lower.
+// It operates directly on the application database and is intended for offline maintenance of a stopped node.
+func PruneHistoricalInfo(database dbm.DB, keepRecent int64, historicalEntries uint32) (pruned int, err error) {
+	if keepRecent < 1 {
+		return 0, fmt.Errorf("at least one height must be retained: %v", keepRecent)
+	}
+
+	if keepRecent < int64(historicalEntries) {
+		return 0, fmt.Errorf("at least the %v heights of the historical entries staking parameter must be retained: %v", historicalEntries, keepRecent)
+	}
+
+	heights, err := historicalInfoHeights(database)
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, height := range heights {
+		if height > latest {
+			latest = height
+		}
+	}
+
+	batch := database.NewBatch()
+	defer batch.Close()
+
+	for _, height := range heights {
+		if height > latest-keepRecent {
+			continue
+		}
+
+		if err := batch.Delete(getHistoricalInfoKey(height)); err != nil {
+			return 0, err
+		}
+		pruned++
+	}
+
+	return pruned, batch.WriteSync()
+}
+
+// historicalInfoHeights returns the heights of all stored historical info. The keys contain the height in decimal
+// notation, so the heights are not returned in numerical order.
+func historicalInfoHeights(database dbm.DB) ([]int64, error) {
+	prefix := append(append([]byte{}, historyKeyprefix...), types.HistoricalInfoKey...)
+
+	iterator, err := database.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var heights []int64
+	for ; iterator.Valid(); iterator.Next() {
+		height, err := strconv.ParseInt(string(bytes.TrimPrefix(iterator.Key(), prefix)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid historical info key %X: %w", iterator.Key(), err)
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, iterator.Error()
+}
+
+// HistoricalEntries reads the historical entries staking parameter from the latest committed state of the stopped
+// node's state database.
+func HistoricalEntries(stateDB dbm.DB) (uint32, error) {
+	key := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ms := rootmulti.NewStore(stateDB, log.NewNopLogger())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return 0, err
+	}
+
+	if ms.LastCommitID().Version == 0 {
+		return 0, fmt.Errorf("no committed state found")
+	}
+
+	ctx := sdk.NewContext(ms.CacheMultiStore(), tmproto.Header{}, true, log.NewNopLogger())
+	subspace := paramstypes.NewSubspace(nil, codec.NewLegacyAmino(), key, tkey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	if !subspace.Has(ctx, types.KeyHistoricalEntries) {
+		return 0, fmt.Errorf("historical entries staking parameter not found")
+	}
+
+	var historicalEntries uint32
+	subspace.Get(ctx, types.KeyHistoricalEntries, &historicalEntries)
+	return historicalEntries, nil
+}